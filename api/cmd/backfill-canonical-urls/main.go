@@ -0,0 +1,46 @@
+// Command backfill-canonical-urls fills links.canonical_url for rows saved
+// before duplicate detection existed.
+//
+// Run it once after applying migration m8:
+//
+//	go run ./cmd/backfill-canonical-urls
+//
+// When a user already has duplicates, only the first one (in id order) gets
+// the canonical_url; the others keep NULL.
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/joho/godotenv"
+
+	"github.com/lvncer/quicklinks/api/internal/config"
+	"github.com/lvncer/quicklinks/api/internal/db"
+	"github.com/lvncer/quicklinks/api/internal/repository"
+	"github.com/lvncer/quicklinks/api/internal/service"
+)
+
+func main() {
+	// Load .env file (ignore error if not found)
+	_ = godotenv.Load()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	entClient, err := db.NewEntClient(cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("failed to create ent client: %v", err)
+	}
+	defer entClient.Close()
+
+	linkRepo := repository.NewLinkRepository(entClient)
+
+	updated, skipped, err := linkRepo.BackfillCanonicalURLs(context.Background(), service.CanonicalizeURL)
+	if err != nil {
+		log.Fatalf("backfill canonical urls: %v (updated %d, skipped %d before failing)", err, updated, skipped)
+	}
+	log.Printf("backfilled canonical_url on %d link(s), skipped %d duplicate/invalid link(s)", updated, skipped)
+}
@@ -0,0 +1,44 @@
+// Command normalize-tags rewrites the tags of existing links with the same
+// normalization rules the API applies on save (TAG_MAX_LENGTH / TAG_MAX_COUNT).
+//
+// Run it once after deploying tag normalization:
+//
+//	go run ./cmd/normalize-tags
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/joho/godotenv"
+
+	"github.com/lvncer/quicklinks/api/internal/config"
+	"github.com/lvncer/quicklinks/api/internal/db"
+	"github.com/lvncer/quicklinks/api/internal/repository"
+	"github.com/lvncer/quicklinks/api/internal/service"
+)
+
+func main() {
+	// Load .env file (ignore error if not found)
+	_ = godotenv.Load()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	entClient, err := db.NewEntClient(cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("failed to create ent client: %v", err)
+	}
+	defer entClient.Close()
+
+	normalizer := service.TagNormalizer{MaxLength: cfg.TagMaxLength, MaxCount: cfg.TagMaxCount}
+	tagRepo := repository.NewTagRepository(entClient)
+
+	n, err := tagRepo.NormalizeAllTags(context.Background(), normalizer.Normalize)
+	if err != nil {
+		log.Fatalf("normalize tags: %v (updated %d link(s) before failing)", err, n)
+	}
+	log.Printf("normalized tags on %d link(s)", n)
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/signal"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -19,6 +20,9 @@ import (
 	"github.com/lvncer/quicklinks/api/internal/handler"
 	"github.com/lvncer/quicklinks/api/internal/middleware"
 	"github.com/lvncer/quicklinks/api/internal/repository"
+	"github.com/lvncer/quicklinks/api/internal/service"
+	"github.com/lvncer/quicklinks/api/internal/storage"
+	"github.com/lvncer/quicklinks/api/internal/worker"
 )
 
 func main() {
@@ -34,6 +38,9 @@ func main() {
 	// Initialize Clerk SDK
 	middleware.InitClerk(cfg.ClerkSecretKey)
 
+	// Configure server-side fetching of user-submitted URLs.
+	service.SetFetchPolicy(fetchPolicy(cfg))
+
 	// Create database connection pool (pgx)
 	ctx := context.Background()
 	pool, err := db.NewPool(ctx, cfg.DatabaseURL)
@@ -92,11 +99,99 @@ func main() {
 		c.JSON(http.StatusOK, gin.H{"status": "ok"})
 	})
 
+	blobStore, err := storage.NewLocalBlobStore(cfg.BlobDir)
+	if err != nil {
+		log.Fatalf("failed to create blob store: %v", err)
+	}
+
 	// Register handlers with auth middleware
 	linkRepo := repository.NewLinkRepository(entClient)
-	linksHandler := handler.NewLinksHandler(linkRepo)
+	jobRepo := repository.NewJobRepository(entClient)
+	siteRepo := repository.NewSiteRepository(entClient)
+	metadataCacheRepo := repository.NewMetadataCacheRepository(entClient)
+	contentRepo := repository.NewContentRepository(entClient)
+	linkCheckRepo := repository.NewLinkCheckRepository(entClient)
+	siteResolver := service.NewSiteResolver(siteRepo, cfg.SiteRefreshInterval)
+	metadataCache := service.NewMetadataCache(metadataCacheRepo, cfg.MetadataCacheTTL, cfg.MetadataCacheNegativeTTL)
+	refresher := service.NewMetadataRefresher(linkRepo, contentRepo, siteResolver, metadataCache)
+	archiver := service.NewArchiver(linkRepo, blobStore, service.ArchivePolicy{
+		Users:    cfg.ArchiveUsers,
+		Tags:     cfg.ArchiveTags,
+		MaxBytes: cfg.ArchiveMaxBytes,
+	})
+	imageProxy := service.NewImageProxy(blobStore, cfg.ImageMaxBytes)
+	tagNormalizer := service.TagNormalizer{MaxLength: cfg.TagMaxLength, MaxCount: cfg.TagMaxCount}
+	linksHandler := handler.NewLinksHandler(linkRepo, jobRepo, refresher, metadataCache, archiver, imageProxy, tagNormalizer)
 	linksHandler.Register(r, middleware.ClerkAuth())
 
+	linkImporter := service.NewLinkImporter(linkRepo, jobRepo, archiver, tagNormalizer)
+	importsHandler := handler.NewImportsHandler(linkImporter)
+	importsHandler.Register(r, middleware.ClerkAuth())
+
+	tagRepo := repository.NewTagRepository(entClient)
+	tagsHandler := handler.NewTagsHandler(tagRepo, tagNormalizer)
+	tagsHandler.Register(r, middleware.ClerkAuth())
+
+	sitesHandler := handler.NewSitesHandler(siteRepo, linkRepo)
+	sitesHandler.Register(r, middleware.ClerkAuth())
+
+	contentsHandler := handler.NewContentsHandler(linkRepo, contentRepo)
+	contentsHandler.Register(r, middleware.ClerkAuth())
+
+	checksHandler := handler.NewChecksHandler(linkRepo, linkCheckRepo)
+	checksHandler.Register(r, middleware.ClerkAuth())
+
+	snapshotsHandler := handler.NewSnapshotsHandler(linkRepo, archiver)
+	snapshotsHandler.Register(r, middleware.ClerkAuth())
+
+	imagesHandler := handler.NewImagesHandler(linkRepo, imageProxy)
+	imagesHandler.Register(r, middleware.ClerkAuth())
+
+	// Background workers stop when the server shuts down. Shutdown waits
+	// for them (up to workerShutdownTimeout) so in-flight jobs can record
+	// their outcome before the database client is closed.
+	workerCtx, stopWorkers := context.WithCancel(ctx)
+	defer stopWorkers()
+	var workers sync.WaitGroup
+	runWorker := func(run func(context.Context)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			run(workerCtx)
+		}()
+	}
+
+	if cfg.TrashRetention > 0 {
+		sweeper := worker.NewTrashSweeper(linkRepo, archiver, imageProxy, cfg.TrashRetention, cfg.TrashSweepInterval)
+		runWorker(sweeper.Run)
+	}
+
+	// Runs even with the cache disabled, to clear out entries left from
+	// when it was enabled.
+	cacheSweeper := worker.NewMetadataCacheSweeper(metadataCacheRepo, time.Hour)
+	runWorker(cacheSweeper.Run)
+
+	if cfg.MetadataWorkers > 0 {
+		metadataWorker := worker.NewMetadataWorker(jobRepo, refresher, archiver, cfg.MetadataWorkers, cfg.MetadataJobMaxAttempts, cfg.MetadataJobPollInterval)
+		runWorker(metadataWorker.Run)
+	}
+
+	if cfg.JobRetention > 0 {
+		jobSweeper := worker.NewJobSweeper(jobRepo, cfg.JobRetention, time.Hour)
+		runWorker(jobSweeper.Run)
+	}
+
+	if cfg.MetadataRefetchInterval > 0 {
+		refetchScheduler := worker.NewRefetchScheduler(linkRepo, jobRepo, cfg.MetadataRefetchInterval)
+		runWorker(refetchScheduler.Run)
+	}
+
+	if cfg.LinkCheckInterval > 0 {
+		linkChecker := service.NewLinkChecker(linkRepo, linkCheckRepo)
+		linkCheckWorker := worker.NewLinkCheckWorker(linkCheckRepo, linkChecker, cfg.LinkCheckInterval)
+		runWorker(linkCheckWorker.Run)
+	}
+
 	// Create HTTP server
 	srv := &http.Server{
 		Addr:    ":" + cfg.Port,
@@ -116,6 +211,7 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	log.Println("Shutting down server...")
+	stopWorkers()
 
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -123,6 +219,54 @@ func main() {
 	if err := srv.Shutdown(shutdownCtx); err != nil {
 		log.Fatal("Server forced to shutdown:", err)
 	}
+	if !waitTimeout(&workers, workerShutdownTimeout) {
+		log.Println("Background workers did not stop in time")
+	}
 
 	log.Println("Server exiting")
 }
+
+// workerShutdownTimeout bounds how long shutdown waits for background
+// workers. It covers a metadata fetch; a long snapshot may be cut off and is
+// then reclaimed as a stale job after restart.
+const workerShutdownTimeout = 30 * time.Second
+
+// waitTimeout waits for wg and reports whether it finished within d.
+func waitTimeout(wg *sync.WaitGroup, d time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(d):
+		return false
+	}
+}
+
+// fetchPolicy builds the metadata fetch policy from configuration.
+func fetchPolicy(cfg *config.Config) service.FetchPolicy {
+	p := service.DefaultFetchPolicy()
+	p.RequestTimeout = cfg.FetchTimeout
+	p.TotalTimeout = cfg.FetchTotalTimeout
+	if cfg.FetchUserAgent != "" {
+		p.UserAgent = cfg.FetchUserAgent
+	}
+	p.AcceptLanguage = cfg.FetchAcceptLanguage
+	p.FallbackReaderURL = cfg.FetchFallbackReaderURL
+	p.Proxy = cfg.FetchProxyURL
+	p.ProxyPinAddress = cfg.FetchProxyPinAddress
+	p.DomainOverrides = make(map[string]service.DomainOverride, len(cfg.FetchDomainOverrides))
+	for host, o := range cfg.FetchDomainOverrides {
+		p.DomainOverrides[host] = service.DomainOverride(o)
+	}
+	p.HostRate = cfg.FetchHostRate
+	p.HostBurst = cfg.FetchHostBurst
+	p.HostConcurrency = cfg.FetchHostConcurrency
+	p.RespectRobots = cfg.FetchRespectRobots
+	p.RobotsUserAgent = cfg.FetchRobotsUserAgent
+	p.RobotsCacheTTL = cfg.FetchRobotsCacheTTL
+	return p
+}
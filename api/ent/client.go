@@ -15,7 +15,13 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/lvncer/quicklinks/api/ent/job"
 	"github.com/lvncer/quicklinks/api/ent/link"
+	"github.com/lvncer/quicklinks/api/ent/linkcheck"
+	"github.com/lvncer/quicklinks/api/ent/linkcontent"
+	"github.com/lvncer/quicklinks/api/ent/metadatacache"
+	"github.com/lvncer/quicklinks/api/ent/site"
 )
 
 // Client is the client that holds all ent builders.
@@ -23,8 +29,18 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
 	// Link is the client for interacting with the Link builders.
 	Link *LinkClient
+	// LinkCheck is the client for interacting with the LinkCheck builders.
+	LinkCheck *LinkCheckClient
+	// LinkContent is the client for interacting with the LinkContent builders.
+	LinkContent *LinkContentClient
+	// MetadataCache is the client for interacting with the MetadataCache builders.
+	MetadataCache *MetadataCacheClient
+	// Site is the client for interacting with the Site builders.
+	Site *SiteClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -36,7 +52,12 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Job = NewJobClient(c.config)
 	c.Link = NewLinkClient(c.config)
+	c.LinkCheck = NewLinkCheckClient(c.config)
+	c.LinkContent = NewLinkContentClient(c.config)
+	c.MetadataCache = NewMetadataCacheClient(c.config)
+	c.Site = NewSiteClient(c.config)
 }
 
 type (
@@ -127,9 +148,14 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Link:   NewLinkClient(cfg),
+		ctx:           ctx,
+		config:        cfg,
+		Job:           NewJobClient(cfg),
+		Link:          NewLinkClient(cfg),
+		LinkCheck:     NewLinkCheckClient(cfg),
+		LinkContent:   NewLinkContentClient(cfg),
+		MetadataCache: NewMetadataCacheClient(cfg),
+		Site:          NewSiteClient(cfg),
 	}, nil
 }
 
@@ -147,16 +173,21 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Link:   NewLinkClient(cfg),
+		ctx:           ctx,
+		config:        cfg,
+		Job:           NewJobClient(cfg),
+		Link:          NewLinkClient(cfg),
+		LinkCheck:     NewLinkCheckClient(cfg),
+		LinkContent:   NewLinkContentClient(cfg),
+		MetadataCache: NewMetadataCacheClient(cfg),
+		Site:          NewSiteClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Link.
+//		Job.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -178,25 +209,192 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Link.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Job, c.Link, c.LinkCheck, c.LinkContent, c.MetadataCache, c.Site,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Link.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Job, c.Link, c.LinkCheck, c.LinkContent, c.MetadataCache, c.Site,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *JobMutation:
+		return c.Job.mutate(ctx, m)
 	case *LinkMutation:
 		return c.Link.mutate(ctx, m)
+	case *LinkCheckMutation:
+		return c.LinkCheck.mutate(ctx, m)
+	case *LinkContentMutation:
+		return c.LinkContent.mutate(ctx, m)
+	case *MetadataCacheMutation:
+		return c.MetadataCache.mutate(ctx, m)
+	case *SiteMutation:
+		return c.Site.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// JobClient is a client for the Job schema.
+type JobClient struct {
+	config
+}
+
+// NewJobClient returns a client for the Job from the given config.
+func NewJobClient(c config) *JobClient {
+	return &JobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `job.Hooks(f(g(h())))`.
+func (c *JobClient) Use(hooks ...Hook) {
+	c.hooks.Job = append(c.hooks.Job, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `job.Intercept(f(g(h())))`.
+func (c *JobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Job = append(c.inters.Job, interceptors...)
+}
+
+// Create returns a builder for creating a Job entity.
+func (c *JobClient) Create() *JobCreate {
+	mutation := newJobMutation(c.config, OpCreate)
+	return &JobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Job entities.
+func (c *JobClient) CreateBulk(builders ...*JobCreate) *JobCreateBulk {
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JobClient) MapCreateBulk(slice any, setFunc func(*JobCreate, int)) *JobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JobCreateBulk{err: fmt.Errorf("calling to JobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Job.
+func (c *JobClient) Update() *JobUpdate {
+	mutation := newJobMutation(c.config, OpUpdate)
+	return &JobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobClient) UpdateOne(_m *Job) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJob(_m))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobClient) UpdateOneID(id uuid.UUID) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJobID(id))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Job.
+func (c *JobClient) Delete() *JobDelete {
+	mutation := newJobMutation(c.config, OpDelete)
+	return &JobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobClient) DeleteOne(_m *Job) *JobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobClient) DeleteOneID(id uuid.UUID) *JobDeleteOne {
+	builder := c.Delete().Where(job.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobDeleteOne{builder}
+}
+
+// Query returns a query builder for Job.
+func (c *JobClient) Query() *JobQuery {
+	return &JobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Job entity by its id.
+func (c *JobClient) Get(ctx context.Context, id uuid.UUID) (*Job, error) {
+	return c.Query().Where(job.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobClient) GetX(ctx context.Context, id uuid.UUID) *Job {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLink queries the link edge of a Job.
+func (c *JobClient) QueryLink(_m *Job) *LinkQuery {
+	query := (&LinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(job.Table, job.FieldID, id),
+			sqlgraph.To(link.Table, link.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, job.LinkTable, job.LinkColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *JobClient) Hooks() []Hook {
+	return c.hooks.Job
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobClient) Interceptors() []Interceptor {
+	return c.inters.Job
+}
+
+func (c *JobClient) mutate(ctx context.Context, m *JobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Job mutation op: %q", m.Op())
+	}
+}
+
 // LinkClient is a client for the Link schema.
 type LinkClient struct {
 	config
@@ -305,6 +503,70 @@ func (c *LinkClient) GetX(ctx context.Context, id uuid.UUID) *Link {
 	return obj
 }
 
+// QuerySite queries the site edge of a Link.
+func (c *LinkClient) QuerySite(_m *Link) *SiteQuery {
+	query := (&SiteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(link.Table, link.FieldID, id),
+			sqlgraph.To(site.Table, site.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, link.SiteTable, link.SiteColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryContent queries the content edge of a Link.
+func (c *LinkClient) QueryContent(_m *Link) *LinkContentQuery {
+	query := (&LinkContentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(link.Table, link.FieldID, id),
+			sqlgraph.To(linkcontent.Table, linkcontent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, link.ContentTable, link.ContentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChecks queries the checks edge of a Link.
+func (c *LinkClient) QueryChecks(_m *Link) *LinkCheckQuery {
+	query := (&LinkCheckClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(link.Table, link.FieldID, id),
+			sqlgraph.To(linkcheck.Table, linkcheck.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, link.ChecksTable, link.ChecksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryJobs queries the jobs edge of a Link.
+func (c *LinkClient) QueryJobs(_m *Link) *JobQuery {
+	query := (&JobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(link.Table, link.FieldID, id),
+			sqlgraph.To(job.Table, job.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, link.JobsTable, link.JobsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *LinkClient) Hooks() []Hook {
 	return c.hooks.Link
@@ -330,12 +592,592 @@ func (c *LinkClient) mutate(ctx context.Context, m *LinkMutation) (Value, error)
 	}
 }
 
+// LinkCheckClient is a client for the LinkCheck schema.
+type LinkCheckClient struct {
+	config
+}
+
+// NewLinkCheckClient returns a client for the LinkCheck from the given config.
+func NewLinkCheckClient(c config) *LinkCheckClient {
+	return &LinkCheckClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `linkcheck.Hooks(f(g(h())))`.
+func (c *LinkCheckClient) Use(hooks ...Hook) {
+	c.hooks.LinkCheck = append(c.hooks.LinkCheck, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `linkcheck.Intercept(f(g(h())))`.
+func (c *LinkCheckClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LinkCheck = append(c.inters.LinkCheck, interceptors...)
+}
+
+// Create returns a builder for creating a LinkCheck entity.
+func (c *LinkCheckClient) Create() *LinkCheckCreate {
+	mutation := newLinkCheckMutation(c.config, OpCreate)
+	return &LinkCheckCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LinkCheck entities.
+func (c *LinkCheckClient) CreateBulk(builders ...*LinkCheckCreate) *LinkCheckCreateBulk {
+	return &LinkCheckCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LinkCheckClient) MapCreateBulk(slice any, setFunc func(*LinkCheckCreate, int)) *LinkCheckCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LinkCheckCreateBulk{err: fmt.Errorf("calling to LinkCheckClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LinkCheckCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LinkCheckCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LinkCheck.
+func (c *LinkCheckClient) Update() *LinkCheckUpdate {
+	mutation := newLinkCheckMutation(c.config, OpUpdate)
+	return &LinkCheckUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LinkCheckClient) UpdateOne(_m *LinkCheck) *LinkCheckUpdateOne {
+	mutation := newLinkCheckMutation(c.config, OpUpdateOne, withLinkCheck(_m))
+	return &LinkCheckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LinkCheckClient) UpdateOneID(id uuid.UUID) *LinkCheckUpdateOne {
+	mutation := newLinkCheckMutation(c.config, OpUpdateOne, withLinkCheckID(id))
+	return &LinkCheckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LinkCheck.
+func (c *LinkCheckClient) Delete() *LinkCheckDelete {
+	mutation := newLinkCheckMutation(c.config, OpDelete)
+	return &LinkCheckDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LinkCheckClient) DeleteOne(_m *LinkCheck) *LinkCheckDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LinkCheckClient) DeleteOneID(id uuid.UUID) *LinkCheckDeleteOne {
+	builder := c.Delete().Where(linkcheck.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LinkCheckDeleteOne{builder}
+}
+
+// Query returns a query builder for LinkCheck.
+func (c *LinkCheckClient) Query() *LinkCheckQuery {
+	return &LinkCheckQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLinkCheck},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LinkCheck entity by its id.
+func (c *LinkCheckClient) Get(ctx context.Context, id uuid.UUID) (*LinkCheck, error) {
+	return c.Query().Where(linkcheck.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LinkCheckClient) GetX(ctx context.Context, id uuid.UUID) *LinkCheck {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLink queries the link edge of a LinkCheck.
+func (c *LinkCheckClient) QueryLink(_m *LinkCheck) *LinkQuery {
+	query := (&LinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(linkcheck.Table, linkcheck.FieldID, id),
+			sqlgraph.To(link.Table, link.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, linkcheck.LinkTable, linkcheck.LinkColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LinkCheckClient) Hooks() []Hook {
+	return c.hooks.LinkCheck
+}
+
+// Interceptors returns the client interceptors.
+func (c *LinkCheckClient) Interceptors() []Interceptor {
+	return c.inters.LinkCheck
+}
+
+func (c *LinkCheckClient) mutate(ctx context.Context, m *LinkCheckMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LinkCheckCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LinkCheckUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LinkCheckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LinkCheckDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LinkCheck mutation op: %q", m.Op())
+	}
+}
+
+// LinkContentClient is a client for the LinkContent schema.
+type LinkContentClient struct {
+	config
+}
+
+// NewLinkContentClient returns a client for the LinkContent from the given config.
+func NewLinkContentClient(c config) *LinkContentClient {
+	return &LinkContentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `linkcontent.Hooks(f(g(h())))`.
+func (c *LinkContentClient) Use(hooks ...Hook) {
+	c.hooks.LinkContent = append(c.hooks.LinkContent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `linkcontent.Intercept(f(g(h())))`.
+func (c *LinkContentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LinkContent = append(c.inters.LinkContent, interceptors...)
+}
+
+// Create returns a builder for creating a LinkContent entity.
+func (c *LinkContentClient) Create() *LinkContentCreate {
+	mutation := newLinkContentMutation(c.config, OpCreate)
+	return &LinkContentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LinkContent entities.
+func (c *LinkContentClient) CreateBulk(builders ...*LinkContentCreate) *LinkContentCreateBulk {
+	return &LinkContentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LinkContentClient) MapCreateBulk(slice any, setFunc func(*LinkContentCreate, int)) *LinkContentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LinkContentCreateBulk{err: fmt.Errorf("calling to LinkContentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LinkContentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LinkContentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LinkContent.
+func (c *LinkContentClient) Update() *LinkContentUpdate {
+	mutation := newLinkContentMutation(c.config, OpUpdate)
+	return &LinkContentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LinkContentClient) UpdateOne(_m *LinkContent) *LinkContentUpdateOne {
+	mutation := newLinkContentMutation(c.config, OpUpdateOne, withLinkContent(_m))
+	return &LinkContentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LinkContentClient) UpdateOneID(id uuid.UUID) *LinkContentUpdateOne {
+	mutation := newLinkContentMutation(c.config, OpUpdateOne, withLinkContentID(id))
+	return &LinkContentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LinkContent.
+func (c *LinkContentClient) Delete() *LinkContentDelete {
+	mutation := newLinkContentMutation(c.config, OpDelete)
+	return &LinkContentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LinkContentClient) DeleteOne(_m *LinkContent) *LinkContentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LinkContentClient) DeleteOneID(id uuid.UUID) *LinkContentDeleteOne {
+	builder := c.Delete().Where(linkcontent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LinkContentDeleteOne{builder}
+}
+
+// Query returns a query builder for LinkContent.
+func (c *LinkContentClient) Query() *LinkContentQuery {
+	return &LinkContentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLinkContent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LinkContent entity by its id.
+func (c *LinkContentClient) Get(ctx context.Context, id uuid.UUID) (*LinkContent, error) {
+	return c.Query().Where(linkcontent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LinkContentClient) GetX(ctx context.Context, id uuid.UUID) *LinkContent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLink queries the link edge of a LinkContent.
+func (c *LinkContentClient) QueryLink(_m *LinkContent) *LinkQuery {
+	query := (&LinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(linkcontent.Table, linkcontent.FieldID, id),
+			sqlgraph.To(link.Table, link.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, linkcontent.LinkTable, linkcontent.LinkColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LinkContentClient) Hooks() []Hook {
+	return c.hooks.LinkContent
+}
+
+// Interceptors returns the client interceptors.
+func (c *LinkContentClient) Interceptors() []Interceptor {
+	return c.inters.LinkContent
+}
+
+func (c *LinkContentClient) mutate(ctx context.Context, m *LinkContentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LinkContentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LinkContentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LinkContentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LinkContentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LinkContent mutation op: %q", m.Op())
+	}
+}
+
+// MetadataCacheClient is a client for the MetadataCache schema.
+type MetadataCacheClient struct {
+	config
+}
+
+// NewMetadataCacheClient returns a client for the MetadataCache from the given config.
+func NewMetadataCacheClient(c config) *MetadataCacheClient {
+	return &MetadataCacheClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `metadatacache.Hooks(f(g(h())))`.
+func (c *MetadataCacheClient) Use(hooks ...Hook) {
+	c.hooks.MetadataCache = append(c.hooks.MetadataCache, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `metadatacache.Intercept(f(g(h())))`.
+func (c *MetadataCacheClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MetadataCache = append(c.inters.MetadataCache, interceptors...)
+}
+
+// Create returns a builder for creating a MetadataCache entity.
+func (c *MetadataCacheClient) Create() *MetadataCacheCreate {
+	mutation := newMetadataCacheMutation(c.config, OpCreate)
+	return &MetadataCacheCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MetadataCache entities.
+func (c *MetadataCacheClient) CreateBulk(builders ...*MetadataCacheCreate) *MetadataCacheCreateBulk {
+	return &MetadataCacheCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MetadataCacheClient) MapCreateBulk(slice any, setFunc func(*MetadataCacheCreate, int)) *MetadataCacheCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MetadataCacheCreateBulk{err: fmt.Errorf("calling to MetadataCacheClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MetadataCacheCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MetadataCacheCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MetadataCache.
+func (c *MetadataCacheClient) Update() *MetadataCacheUpdate {
+	mutation := newMetadataCacheMutation(c.config, OpUpdate)
+	return &MetadataCacheUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MetadataCacheClient) UpdateOne(_m *MetadataCache) *MetadataCacheUpdateOne {
+	mutation := newMetadataCacheMutation(c.config, OpUpdateOne, withMetadataCache(_m))
+	return &MetadataCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MetadataCacheClient) UpdateOneID(id uuid.UUID) *MetadataCacheUpdateOne {
+	mutation := newMetadataCacheMutation(c.config, OpUpdateOne, withMetadataCacheID(id))
+	return &MetadataCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MetadataCache.
+func (c *MetadataCacheClient) Delete() *MetadataCacheDelete {
+	mutation := newMetadataCacheMutation(c.config, OpDelete)
+	return &MetadataCacheDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MetadataCacheClient) DeleteOne(_m *MetadataCache) *MetadataCacheDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MetadataCacheClient) DeleteOneID(id uuid.UUID) *MetadataCacheDeleteOne {
+	builder := c.Delete().Where(metadatacache.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MetadataCacheDeleteOne{builder}
+}
+
+// Query returns a query builder for MetadataCache.
+func (c *MetadataCacheClient) Query() *MetadataCacheQuery {
+	return &MetadataCacheQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMetadataCache},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MetadataCache entity by its id.
+func (c *MetadataCacheClient) Get(ctx context.Context, id uuid.UUID) (*MetadataCache, error) {
+	return c.Query().Where(metadatacache.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MetadataCacheClient) GetX(ctx context.Context, id uuid.UUID) *MetadataCache {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MetadataCacheClient) Hooks() []Hook {
+	return c.hooks.MetadataCache
+}
+
+// Interceptors returns the client interceptors.
+func (c *MetadataCacheClient) Interceptors() []Interceptor {
+	return c.inters.MetadataCache
+}
+
+func (c *MetadataCacheClient) mutate(ctx context.Context, m *MetadataCacheMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MetadataCacheCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MetadataCacheUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MetadataCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MetadataCacheDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MetadataCache mutation op: %q", m.Op())
+	}
+}
+
+// SiteClient is a client for the Site schema.
+type SiteClient struct {
+	config
+}
+
+// NewSiteClient returns a client for the Site from the given config.
+func NewSiteClient(c config) *SiteClient {
+	return &SiteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `site.Hooks(f(g(h())))`.
+func (c *SiteClient) Use(hooks ...Hook) {
+	c.hooks.Site = append(c.hooks.Site, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `site.Intercept(f(g(h())))`.
+func (c *SiteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Site = append(c.inters.Site, interceptors...)
+}
+
+// Create returns a builder for creating a Site entity.
+func (c *SiteClient) Create() *SiteCreate {
+	mutation := newSiteMutation(c.config, OpCreate)
+	return &SiteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Site entities.
+func (c *SiteClient) CreateBulk(builders ...*SiteCreate) *SiteCreateBulk {
+	return &SiteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SiteClient) MapCreateBulk(slice any, setFunc func(*SiteCreate, int)) *SiteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SiteCreateBulk{err: fmt.Errorf("calling to SiteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SiteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SiteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Site.
+func (c *SiteClient) Update() *SiteUpdate {
+	mutation := newSiteMutation(c.config, OpUpdate)
+	return &SiteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SiteClient) UpdateOne(_m *Site) *SiteUpdateOne {
+	mutation := newSiteMutation(c.config, OpUpdateOne, withSite(_m))
+	return &SiteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SiteClient) UpdateOneID(id uuid.UUID) *SiteUpdateOne {
+	mutation := newSiteMutation(c.config, OpUpdateOne, withSiteID(id))
+	return &SiteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Site.
+func (c *SiteClient) Delete() *SiteDelete {
+	mutation := newSiteMutation(c.config, OpDelete)
+	return &SiteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SiteClient) DeleteOne(_m *Site) *SiteDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SiteClient) DeleteOneID(id uuid.UUID) *SiteDeleteOne {
+	builder := c.Delete().Where(site.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SiteDeleteOne{builder}
+}
+
+// Query returns a query builder for Site.
+func (c *SiteClient) Query() *SiteQuery {
+	return &SiteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSite},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Site entity by its id.
+func (c *SiteClient) Get(ctx context.Context, id uuid.UUID) (*Site, error) {
+	return c.Query().Where(site.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SiteClient) GetX(ctx context.Context, id uuid.UUID) *Site {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLinks queries the links edge of a Site.
+func (c *SiteClient) QueryLinks(_m *Site) *LinkQuery {
+	query := (&LinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(site.Table, site.FieldID, id),
+			sqlgraph.To(link.Table, link.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, site.LinksTable, site.LinksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SiteClient) Hooks() []Hook {
+	return c.hooks.Site
+}
+
+// Interceptors returns the client interceptors.
+func (c *SiteClient) Interceptors() []Interceptor {
+	return c.inters.Site
+}
+
+func (c *SiteClient) mutate(ctx context.Context, m *SiteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SiteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SiteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SiteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SiteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Site mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Link []ent.Hook
+		Job, Link, LinkCheck, LinkContent, MetadataCache, Site []ent.Hook
 	}
 	inters struct {
-		Link []ent.Interceptor
+		Job, Link, LinkCheck, LinkContent, MetadataCache, Site []ent.Interceptor
 	}
 )
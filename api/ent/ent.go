@@ -12,7 +12,12 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/lvncer/quicklinks/api/ent/job"
 	"github.com/lvncer/quicklinks/api/ent/link"
+	"github.com/lvncer/quicklinks/api/ent/linkcheck"
+	"github.com/lvncer/quicklinks/api/ent/linkcontent"
+	"github.com/lvncer/quicklinks/api/ent/metadatacache"
+	"github.com/lvncer/quicklinks/api/ent/site"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -73,7 +78,12 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			link.Table: link.ValidColumn,
+			job.Table:           job.ValidColumn,
+			link.Table:          link.ValidColumn,
+			linkcheck.Table:     linkcheck.ValidColumn,
+			linkcontent.Table:   linkcontent.ValidColumn,
+			metadatacache.Table: metadatacache.ValidColumn,
+			site.Table:          site.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
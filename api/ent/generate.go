@@ -1,4 +1,4 @@
 package ent
 
 // NOTE: Pin the Ent codegen version to keep `go generate` reproducible.
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent@v0.14.5 generate ./schema
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent@v0.14.5 generate --feature sql/lock,sql/upsert ./schema
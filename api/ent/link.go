@@ -12,6 +12,8 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/lvncer/quicklinks/api/ent/link"
+	"github.com/lvncer/quicklinks/api/ent/linkcontent"
+	"github.com/lvncer/quicklinks/api/ent/site"
 )
 
 // Link is the model entity for the Link schema.
@@ -23,6 +25,8 @@ type Link struct {
 	UserID *string `json:"user_id,omitempty"`
 	// URL holds the value of the "url" field.
 	URL string `json:"url,omitempty"`
+	// CanonicalURL holds the value of the "canonical_url" field.
+	CanonicalURL *string `json:"canonical_url,omitempty"`
 	// Title holds the value of the "title" field.
 	Title *string `json:"title,omitempty"`
 	// Description holds the value of the "description" field.
@@ -42,20 +46,88 @@ type Link struct {
 	// SavedAt holds the value of the "saved_at" field.
 	SavedAt time.Time `json:"saved_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt    time.Time `json:"created_at,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// SearchVector holds the value of the "search_vector" field.
+	SearchVector *string `json:"search_vector,omitempty"`
+	// SearchText holds the value of the "search_text" field.
+	SearchText *string `json:"search_text,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// SiteID holds the value of the "site_id" field.
+	SiteID *uuid.UUID `json:"site_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LinkQuery when eager-loading is set.
+	Edges        LinkEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// LinkEdges holds the relations/edges for other nodes in the graph.
+type LinkEdges struct {
+	// Site holds the value of the site edge.
+	Site *Site `json:"site,omitempty"`
+	// Content holds the value of the content edge.
+	Content *LinkContent `json:"content,omitempty"`
+	// Checks holds the value of the checks edge.
+	Checks []*LinkCheck `json:"checks,omitempty"`
+	// Jobs holds the value of the jobs edge.
+	Jobs []*Job `json:"jobs,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// SiteOrErr returns the Site value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LinkEdges) SiteOrErr() (*Site, error) {
+	if e.Site != nil {
+		return e.Site, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: site.Label}
+	}
+	return nil, &NotLoadedError{edge: "site"}
+}
+
+// ContentOrErr returns the Content value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LinkEdges) ContentOrErr() (*LinkContent, error) {
+	if e.Content != nil {
+		return e.Content, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: linkcontent.Label}
+	}
+	return nil, &NotLoadedError{edge: "content"}
+}
+
+// ChecksOrErr returns the Checks value or an error if the edge
+// was not loaded in eager-loading.
+func (e LinkEdges) ChecksOrErr() ([]*LinkCheck, error) {
+	if e.loadedTypes[2] {
+		return e.Checks, nil
+	}
+	return nil, &NotLoadedError{edge: "checks"}
+}
+
+// JobsOrErr returns the Jobs value or an error if the edge
+// was not loaded in eager-loading.
+func (e LinkEdges) JobsOrErr() ([]*Job, error) {
+	if e.loadedTypes[3] {
+		return e.Jobs, nil
+	}
+	return nil, &NotLoadedError{edge: "jobs"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Link) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case link.FieldSiteID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case link.FieldTags, link.FieldMetadata:
 			values[i] = new([]byte)
-		case link.FieldUserID, link.FieldURL, link.FieldTitle, link.FieldDescription, link.FieldDomain, link.FieldOgImage, link.FieldPageURL, link.FieldNote:
+		case link.FieldUserID, link.FieldURL, link.FieldCanonicalURL, link.FieldTitle, link.FieldDescription, link.FieldDomain, link.FieldOgImage, link.FieldPageURL, link.FieldNote, link.FieldSearchVector, link.FieldSearchText:
 			values[i] = new(sql.NullString)
-		case link.FieldSavedAt, link.FieldCreatedAt:
+		case link.FieldSavedAt, link.FieldCreatedAt, link.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case link.FieldID:
 			values[i] = new(uuid.UUID)
@@ -93,6 +165,13 @@ func (_m *Link) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.URL = value.String
 			}
+		case link.FieldCanonicalURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field canonical_url", values[i])
+			} else if value.Valid {
+				_m.CanonicalURL = new(string)
+				*_m.CanonicalURL = value.String
+			}
 		case link.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
@@ -163,6 +242,34 @@ func (_m *Link) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CreatedAt = value.Time
 			}
+		case link.FieldSearchVector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field search_vector", values[i])
+			} else if value.Valid {
+				_m.SearchVector = new(string)
+				*_m.SearchVector = value.String
+			}
+		case link.FieldSearchText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field search_text", values[i])
+			} else if value.Valid {
+				_m.SearchText = new(string)
+				*_m.SearchText = value.String
+			}
+		case link.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case link.FieldSiteID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field site_id", values[i])
+			} else if value.Valid {
+				_m.SiteID = new(uuid.UUID)
+				*_m.SiteID = *value.S.(*uuid.UUID)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -176,6 +283,26 @@ func (_m *Link) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QuerySite queries the "site" edge of the Link entity.
+func (_m *Link) QuerySite() *SiteQuery {
+	return NewLinkClient(_m.config).QuerySite(_m)
+}
+
+// QueryContent queries the "content" edge of the Link entity.
+func (_m *Link) QueryContent() *LinkContentQuery {
+	return NewLinkClient(_m.config).QueryContent(_m)
+}
+
+// QueryChecks queries the "checks" edge of the Link entity.
+func (_m *Link) QueryChecks() *LinkCheckQuery {
+	return NewLinkClient(_m.config).QueryChecks(_m)
+}
+
+// QueryJobs queries the "jobs" edge of the Link entity.
+func (_m *Link) QueryJobs() *JobQuery {
+	return NewLinkClient(_m.config).QueryJobs(_m)
+}
+
 // Update returns a builder for updating this Link.
 // Note that you need to call Link.Unwrap() before calling this method if this Link
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -207,6 +334,11 @@ func (_m *Link) String() string {
 	builder.WriteString("url=")
 	builder.WriteString(_m.URL)
 	builder.WriteString(", ")
+	if v := _m.CanonicalURL; v != nil {
+		builder.WriteString("canonical_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := _m.Title; v != nil {
 		builder.WriteString("title=")
 		builder.WriteString(*v)
@@ -248,6 +380,26 @@ func (_m *Link) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.SearchVector; v != nil {
+		builder.WriteString("search_vector=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.SearchText; v != nil {
+		builder.WriteString("search_text=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.SiteID; v != nil {
+		builder.WriteString("site_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
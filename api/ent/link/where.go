@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 	"github.com/lvncer/quicklinks/api/ent/predicate"
 )
@@ -65,6 +66,11 @@ func URL(v string) predicate.Link {
 	return predicate.Link(sql.FieldEQ(FieldURL, v))
 }
 
+// CanonicalURL applies equality check predicate on the "canonical_url" field. It's identical to CanonicalURLEQ.
+func CanonicalURL(v string) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldCanonicalURL, v))
+}
+
 // Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
 func Title(v string) predicate.Link {
 	return predicate.Link(sql.FieldEQ(FieldTitle, v))
@@ -105,6 +111,26 @@ func CreatedAt(v time.Time) predicate.Link {
 	return predicate.Link(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// SearchVector applies equality check predicate on the "search_vector" field. It's identical to SearchVectorEQ.
+func SearchVector(v string) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldSearchVector, v))
+}
+
+// SearchText applies equality check predicate on the "search_text" field. It's identical to SearchTextEQ.
+func SearchText(v string) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldSearchText, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// SiteID applies equality check predicate on the "site_id" field. It's identical to SiteIDEQ.
+func SiteID(v uuid.UUID) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldSiteID, v))
+}
+
 // UserIDEQ applies the EQ predicate on the "user_id" field.
 func UserIDEQ(v string) predicate.Link {
 	return predicate.Link(sql.FieldEQ(FieldUserID, v))
@@ -245,6 +271,81 @@ func URLContainsFold(v string) predicate.Link {
 	return predicate.Link(sql.FieldContainsFold(FieldURL, v))
 }
 
+// CanonicalURLEQ applies the EQ predicate on the "canonical_url" field.
+func CanonicalURLEQ(v string) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldCanonicalURL, v))
+}
+
+// CanonicalURLNEQ applies the NEQ predicate on the "canonical_url" field.
+func CanonicalURLNEQ(v string) predicate.Link {
+	return predicate.Link(sql.FieldNEQ(FieldCanonicalURL, v))
+}
+
+// CanonicalURLIn applies the In predicate on the "canonical_url" field.
+func CanonicalURLIn(vs ...string) predicate.Link {
+	return predicate.Link(sql.FieldIn(FieldCanonicalURL, vs...))
+}
+
+// CanonicalURLNotIn applies the NotIn predicate on the "canonical_url" field.
+func CanonicalURLNotIn(vs ...string) predicate.Link {
+	return predicate.Link(sql.FieldNotIn(FieldCanonicalURL, vs...))
+}
+
+// CanonicalURLGT applies the GT predicate on the "canonical_url" field.
+func CanonicalURLGT(v string) predicate.Link {
+	return predicate.Link(sql.FieldGT(FieldCanonicalURL, v))
+}
+
+// CanonicalURLGTE applies the GTE predicate on the "canonical_url" field.
+func CanonicalURLGTE(v string) predicate.Link {
+	return predicate.Link(sql.FieldGTE(FieldCanonicalURL, v))
+}
+
+// CanonicalURLLT applies the LT predicate on the "canonical_url" field.
+func CanonicalURLLT(v string) predicate.Link {
+	return predicate.Link(sql.FieldLT(FieldCanonicalURL, v))
+}
+
+// CanonicalURLLTE applies the LTE predicate on the "canonical_url" field.
+func CanonicalURLLTE(v string) predicate.Link {
+	return predicate.Link(sql.FieldLTE(FieldCanonicalURL, v))
+}
+
+// CanonicalURLContains applies the Contains predicate on the "canonical_url" field.
+func CanonicalURLContains(v string) predicate.Link {
+	return predicate.Link(sql.FieldContains(FieldCanonicalURL, v))
+}
+
+// CanonicalURLHasPrefix applies the HasPrefix predicate on the "canonical_url" field.
+func CanonicalURLHasPrefix(v string) predicate.Link {
+	return predicate.Link(sql.FieldHasPrefix(FieldCanonicalURL, v))
+}
+
+// CanonicalURLHasSuffix applies the HasSuffix predicate on the "canonical_url" field.
+func CanonicalURLHasSuffix(v string) predicate.Link {
+	return predicate.Link(sql.FieldHasSuffix(FieldCanonicalURL, v))
+}
+
+// CanonicalURLIsNil applies the IsNil predicate on the "canonical_url" field.
+func CanonicalURLIsNil() predicate.Link {
+	return predicate.Link(sql.FieldIsNull(FieldCanonicalURL))
+}
+
+// CanonicalURLNotNil applies the NotNil predicate on the "canonical_url" field.
+func CanonicalURLNotNil() predicate.Link {
+	return predicate.Link(sql.FieldNotNull(FieldCanonicalURL))
+}
+
+// CanonicalURLEqualFold applies the EqualFold predicate on the "canonical_url" field.
+func CanonicalURLEqualFold(v string) predicate.Link {
+	return predicate.Link(sql.FieldEqualFold(FieldCanonicalURL, v))
+}
+
+// CanonicalURLContainsFold applies the ContainsFold predicate on the "canonical_url" field.
+func CanonicalURLContainsFold(v string) predicate.Link {
+	return predicate.Link(sql.FieldContainsFold(FieldCanonicalURL, v))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Link {
 	return predicate.Link(sql.FieldEQ(FieldTitle, v))
@@ -785,6 +886,328 @@ func CreatedAtLTE(v time.Time) predicate.Link {
 	return predicate.Link(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// SearchVectorEQ applies the EQ predicate on the "search_vector" field.
+func SearchVectorEQ(v string) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldSearchVector, v))
+}
+
+// SearchVectorNEQ applies the NEQ predicate on the "search_vector" field.
+func SearchVectorNEQ(v string) predicate.Link {
+	return predicate.Link(sql.FieldNEQ(FieldSearchVector, v))
+}
+
+// SearchVectorIn applies the In predicate on the "search_vector" field.
+func SearchVectorIn(vs ...string) predicate.Link {
+	return predicate.Link(sql.FieldIn(FieldSearchVector, vs...))
+}
+
+// SearchVectorNotIn applies the NotIn predicate on the "search_vector" field.
+func SearchVectorNotIn(vs ...string) predicate.Link {
+	return predicate.Link(sql.FieldNotIn(FieldSearchVector, vs...))
+}
+
+// SearchVectorGT applies the GT predicate on the "search_vector" field.
+func SearchVectorGT(v string) predicate.Link {
+	return predicate.Link(sql.FieldGT(FieldSearchVector, v))
+}
+
+// SearchVectorGTE applies the GTE predicate on the "search_vector" field.
+func SearchVectorGTE(v string) predicate.Link {
+	return predicate.Link(sql.FieldGTE(FieldSearchVector, v))
+}
+
+// SearchVectorLT applies the LT predicate on the "search_vector" field.
+func SearchVectorLT(v string) predicate.Link {
+	return predicate.Link(sql.FieldLT(FieldSearchVector, v))
+}
+
+// SearchVectorLTE applies the LTE predicate on the "search_vector" field.
+func SearchVectorLTE(v string) predicate.Link {
+	return predicate.Link(sql.FieldLTE(FieldSearchVector, v))
+}
+
+// SearchVectorContains applies the Contains predicate on the "search_vector" field.
+func SearchVectorContains(v string) predicate.Link {
+	return predicate.Link(sql.FieldContains(FieldSearchVector, v))
+}
+
+// SearchVectorHasPrefix applies the HasPrefix predicate on the "search_vector" field.
+func SearchVectorHasPrefix(v string) predicate.Link {
+	return predicate.Link(sql.FieldHasPrefix(FieldSearchVector, v))
+}
+
+// SearchVectorHasSuffix applies the HasSuffix predicate on the "search_vector" field.
+func SearchVectorHasSuffix(v string) predicate.Link {
+	return predicate.Link(sql.FieldHasSuffix(FieldSearchVector, v))
+}
+
+// SearchVectorIsNil applies the IsNil predicate on the "search_vector" field.
+func SearchVectorIsNil() predicate.Link {
+	return predicate.Link(sql.FieldIsNull(FieldSearchVector))
+}
+
+// SearchVectorNotNil applies the NotNil predicate on the "search_vector" field.
+func SearchVectorNotNil() predicate.Link {
+	return predicate.Link(sql.FieldNotNull(FieldSearchVector))
+}
+
+// SearchVectorEqualFold applies the EqualFold predicate on the "search_vector" field.
+func SearchVectorEqualFold(v string) predicate.Link {
+	return predicate.Link(sql.FieldEqualFold(FieldSearchVector, v))
+}
+
+// SearchVectorContainsFold applies the ContainsFold predicate on the "search_vector" field.
+func SearchVectorContainsFold(v string) predicate.Link {
+	return predicate.Link(sql.FieldContainsFold(FieldSearchVector, v))
+}
+
+// SearchTextEQ applies the EQ predicate on the "search_text" field.
+func SearchTextEQ(v string) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldSearchText, v))
+}
+
+// SearchTextNEQ applies the NEQ predicate on the "search_text" field.
+func SearchTextNEQ(v string) predicate.Link {
+	return predicate.Link(sql.FieldNEQ(FieldSearchText, v))
+}
+
+// SearchTextIn applies the In predicate on the "search_text" field.
+func SearchTextIn(vs ...string) predicate.Link {
+	return predicate.Link(sql.FieldIn(FieldSearchText, vs...))
+}
+
+// SearchTextNotIn applies the NotIn predicate on the "search_text" field.
+func SearchTextNotIn(vs ...string) predicate.Link {
+	return predicate.Link(sql.FieldNotIn(FieldSearchText, vs...))
+}
+
+// SearchTextGT applies the GT predicate on the "search_text" field.
+func SearchTextGT(v string) predicate.Link {
+	return predicate.Link(sql.FieldGT(FieldSearchText, v))
+}
+
+// SearchTextGTE applies the GTE predicate on the "search_text" field.
+func SearchTextGTE(v string) predicate.Link {
+	return predicate.Link(sql.FieldGTE(FieldSearchText, v))
+}
+
+// SearchTextLT applies the LT predicate on the "search_text" field.
+func SearchTextLT(v string) predicate.Link {
+	return predicate.Link(sql.FieldLT(FieldSearchText, v))
+}
+
+// SearchTextLTE applies the LTE predicate on the "search_text" field.
+func SearchTextLTE(v string) predicate.Link {
+	return predicate.Link(sql.FieldLTE(FieldSearchText, v))
+}
+
+// SearchTextContains applies the Contains predicate on the "search_text" field.
+func SearchTextContains(v string) predicate.Link {
+	return predicate.Link(sql.FieldContains(FieldSearchText, v))
+}
+
+// SearchTextHasPrefix applies the HasPrefix predicate on the "search_text" field.
+func SearchTextHasPrefix(v string) predicate.Link {
+	return predicate.Link(sql.FieldHasPrefix(FieldSearchText, v))
+}
+
+// SearchTextHasSuffix applies the HasSuffix predicate on the "search_text" field.
+func SearchTextHasSuffix(v string) predicate.Link {
+	return predicate.Link(sql.FieldHasSuffix(FieldSearchText, v))
+}
+
+// SearchTextIsNil applies the IsNil predicate on the "search_text" field.
+func SearchTextIsNil() predicate.Link {
+	return predicate.Link(sql.FieldIsNull(FieldSearchText))
+}
+
+// SearchTextNotNil applies the NotNil predicate on the "search_text" field.
+func SearchTextNotNil() predicate.Link {
+	return predicate.Link(sql.FieldNotNull(FieldSearchText))
+}
+
+// SearchTextEqualFold applies the EqualFold predicate on the "search_text" field.
+func SearchTextEqualFold(v string) predicate.Link {
+	return predicate.Link(sql.FieldEqualFold(FieldSearchText, v))
+}
+
+// SearchTextContainsFold applies the ContainsFold predicate on the "search_text" field.
+func SearchTextContainsFold(v string) predicate.Link {
+	return predicate.Link(sql.FieldContainsFold(FieldSearchText, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Link {
+	return predicate.Link(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Link {
+	return predicate.Link(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Link {
+	return predicate.Link(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Link {
+	return predicate.Link(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// SiteIDEQ applies the EQ predicate on the "site_id" field.
+func SiteIDEQ(v uuid.UUID) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldSiteID, v))
+}
+
+// SiteIDNEQ applies the NEQ predicate on the "site_id" field.
+func SiteIDNEQ(v uuid.UUID) predicate.Link {
+	return predicate.Link(sql.FieldNEQ(FieldSiteID, v))
+}
+
+// SiteIDIn applies the In predicate on the "site_id" field.
+func SiteIDIn(vs ...uuid.UUID) predicate.Link {
+	return predicate.Link(sql.FieldIn(FieldSiteID, vs...))
+}
+
+// SiteIDNotIn applies the NotIn predicate on the "site_id" field.
+func SiteIDNotIn(vs ...uuid.UUID) predicate.Link {
+	return predicate.Link(sql.FieldNotIn(FieldSiteID, vs...))
+}
+
+// SiteIDIsNil applies the IsNil predicate on the "site_id" field.
+func SiteIDIsNil() predicate.Link {
+	return predicate.Link(sql.FieldIsNull(FieldSiteID))
+}
+
+// SiteIDNotNil applies the NotNil predicate on the "site_id" field.
+func SiteIDNotNil() predicate.Link {
+	return predicate.Link(sql.FieldNotNull(FieldSiteID))
+}
+
+// HasSite applies the HasEdge predicate on the "site" edge.
+func HasSite() predicate.Link {
+	return predicate.Link(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SiteTable, SiteColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSiteWith applies the HasEdge predicate on the "site" edge with a given conditions (other predicates).
+func HasSiteWith(preds ...predicate.Site) predicate.Link {
+	return predicate.Link(func(s *sql.Selector) {
+		step := newSiteStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasContent applies the HasEdge predicate on the "content" edge.
+func HasContent() predicate.Link {
+	return predicate.Link(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, ContentTable, ContentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContentWith applies the HasEdge predicate on the "content" edge with a given conditions (other predicates).
+func HasContentWith(preds ...predicate.LinkContent) predicate.Link {
+	return predicate.Link(func(s *sql.Selector) {
+		step := newContentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChecks applies the HasEdge predicate on the "checks" edge.
+func HasChecks() predicate.Link {
+	return predicate.Link(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChecksTable, ChecksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChecksWith applies the HasEdge predicate on the "checks" edge with a given conditions (other predicates).
+func HasChecksWith(preds ...predicate.LinkCheck) predicate.Link {
+	return predicate.Link(func(s *sql.Selector) {
+		step := newChecksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasJobs applies the HasEdge predicate on the "jobs" edge.
+func HasJobs() predicate.Link {
+	return predicate.Link(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, JobsTable, JobsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasJobsWith applies the HasEdge predicate on the "jobs" edge with a given conditions (other predicates).
+func HasJobsWith(preds ...predicate.Job) predicate.Link {
+	return predicate.Link(func(s *sql.Selector) {
+		step := newJobsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Link) predicate.Link {
 	return predicate.Link(sql.AndPredicates(predicates...))
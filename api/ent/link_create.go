@@ -8,10 +8,16 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/lvncer/quicklinks/api/ent/job"
 	"github.com/lvncer/quicklinks/api/ent/link"
+	"github.com/lvncer/quicklinks/api/ent/linkcheck"
+	"github.com/lvncer/quicklinks/api/ent/linkcontent"
+	"github.com/lvncer/quicklinks/api/ent/site"
 )
 
 // LinkCreate is the builder for creating a Link entity.
@@ -19,6 +25,7 @@ type LinkCreate struct {
 	config
 	mutation *LinkMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUserID sets the "user_id" field.
@@ -41,6 +48,20 @@ func (_c *LinkCreate) SetURL(v string) *LinkCreate {
 	return _c
 }
 
+// SetCanonicalURL sets the "canonical_url" field.
+func (_c *LinkCreate) SetCanonicalURL(v string) *LinkCreate {
+	_c.mutation.SetCanonicalURL(v)
+	return _c
+}
+
+// SetNillableCanonicalURL sets the "canonical_url" field if the given value is not nil.
+func (_c *LinkCreate) SetNillableCanonicalURL(v *string) *LinkCreate {
+	if v != nil {
+		_c.SetCanonicalURL(*v)
+	}
+	return _c
+}
+
 // SetTitle sets the "title" field.
 func (_c *LinkCreate) SetTitle(v string) *LinkCreate {
 	_c.mutation.SetTitle(v)
@@ -165,6 +186,62 @@ func (_c *LinkCreate) SetNillableCreatedAt(v *time.Time) *LinkCreate {
 	return _c
 }
 
+// SetSearchVector sets the "search_vector" field.
+func (_c *LinkCreate) SetSearchVector(v string) *LinkCreate {
+	_c.mutation.SetSearchVector(v)
+	return _c
+}
+
+// SetNillableSearchVector sets the "search_vector" field if the given value is not nil.
+func (_c *LinkCreate) SetNillableSearchVector(v *string) *LinkCreate {
+	if v != nil {
+		_c.SetSearchVector(*v)
+	}
+	return _c
+}
+
+// SetSearchText sets the "search_text" field.
+func (_c *LinkCreate) SetSearchText(v string) *LinkCreate {
+	_c.mutation.SetSearchText(v)
+	return _c
+}
+
+// SetNillableSearchText sets the "search_text" field if the given value is not nil.
+func (_c *LinkCreate) SetNillableSearchText(v *string) *LinkCreate {
+	if v != nil {
+		_c.SetSearchText(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *LinkCreate) SetDeletedAt(v time.Time) *LinkCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *LinkCreate) SetNillableDeletedAt(v *time.Time) *LinkCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetSiteID sets the "site_id" field.
+func (_c *LinkCreate) SetSiteID(v uuid.UUID) *LinkCreate {
+	_c.mutation.SetSiteID(v)
+	return _c
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_c *LinkCreate) SetNillableSiteID(v *uuid.UUID) *LinkCreate {
+	if v != nil {
+		_c.SetSiteID(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *LinkCreate) SetID(v uuid.UUID) *LinkCreate {
 	_c.mutation.SetID(v)
@@ -179,6 +256,60 @@ func (_c *LinkCreate) SetNillableID(v *uuid.UUID) *LinkCreate {
 	return _c
 }
 
+// SetSite sets the "site" edge to the Site entity.
+func (_c *LinkCreate) SetSite(v *Site) *LinkCreate {
+	return _c.SetSiteID(v.ID)
+}
+
+// SetContentID sets the "content" edge to the LinkContent entity by ID.
+func (_c *LinkCreate) SetContentID(id uuid.UUID) *LinkCreate {
+	_c.mutation.SetContentID(id)
+	return _c
+}
+
+// SetNillableContentID sets the "content" edge to the LinkContent entity by ID if the given value is not nil.
+func (_c *LinkCreate) SetNillableContentID(id *uuid.UUID) *LinkCreate {
+	if id != nil {
+		_c = _c.SetContentID(*id)
+	}
+	return _c
+}
+
+// SetContent sets the "content" edge to the LinkContent entity.
+func (_c *LinkCreate) SetContent(v *LinkContent) *LinkCreate {
+	return _c.SetContentID(v.ID)
+}
+
+// AddCheckIDs adds the "checks" edge to the LinkCheck entity by IDs.
+func (_c *LinkCreate) AddCheckIDs(ids ...uuid.UUID) *LinkCreate {
+	_c.mutation.AddCheckIDs(ids...)
+	return _c
+}
+
+// AddChecks adds the "checks" edges to the LinkCheck entity.
+func (_c *LinkCreate) AddChecks(v ...*LinkCheck) *LinkCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCheckIDs(ids...)
+}
+
+// AddJobIDs adds the "jobs" edge to the Job entity by IDs.
+func (_c *LinkCreate) AddJobIDs(ids ...uuid.UUID) *LinkCreate {
+	_c.mutation.AddJobIDs(ids...)
+	return _c
+}
+
+// AddJobs adds the "jobs" edges to the Job entity.
+func (_c *LinkCreate) AddJobs(v ...*Job) *LinkCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddJobIDs(ids...)
+}
+
 // Mutation returns the LinkMutation object of the builder.
 func (_c *LinkCreate) Mutation() *LinkMutation {
 	return _c.mutation
@@ -273,6 +404,7 @@ func (_c *LinkCreate) createSpec() (*Link, *sqlgraph.CreateSpec) {
 		_node = &Link{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(link.Table, sqlgraph.NewFieldSpec(link.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -285,6 +417,10 @@ func (_c *LinkCreate) createSpec() (*Link, *sqlgraph.CreateSpec) {
 		_spec.SetField(link.FieldURL, field.TypeString, value)
 		_node.URL = value
 	}
+	if value, ok := _c.mutation.CanonicalURL(); ok {
+		_spec.SetField(link.FieldCanonicalURL, field.TypeString, value)
+		_node.CanonicalURL = &value
+	}
 	if value, ok := _c.mutation.Title(); ok {
 		_spec.SetField(link.FieldTitle, field.TypeString, value)
 		_node.Title = &value
@@ -325,14 +461,838 @@ func (_c *LinkCreate) createSpec() (*Link, *sqlgraph.CreateSpec) {
 		_spec.SetField(link.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := _c.mutation.SearchVector(); ok {
+		_spec.SetField(link.FieldSearchVector, field.TypeString, value)
+		_node.SearchVector = &value
+	}
+	if value, ok := _c.mutation.SearchText(); ok {
+		_spec.SetField(link.FieldSearchText, field.TypeString, value)
+		_node.SearchText = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(link.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if nodes := _c.mutation.SiteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   link.SiteTable,
+			Columns: []string{link.SiteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(site.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SiteID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ContentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   link.ContentTable,
+			Columns: []string{link.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkcontent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChecksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   link.ChecksTable,
+			Columns: []string{link.ChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkcheck.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   link.JobsTable,
+			Columns: []string{link.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Link.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LinkUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LinkCreate) OnConflict(opts ...sql.ConflictOption) *LinkUpsertOne {
+	_c.conflict = opts
+	return &LinkUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Link.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LinkCreate) OnConflictColumns(columns ...string) *LinkUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LinkUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LinkUpsertOne is the builder for "upsert"-ing
+	//  one Link node.
+	LinkUpsertOne struct {
+		create *LinkCreate
+	}
+
+	// LinkUpsert is the "OnConflict" setter.
+	LinkUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *LinkUpsert) SetUserID(v string) *LinkUpsert {
+	u.Set(link.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateUserID() *LinkUpsert {
+	u.SetExcluded(link.FieldUserID)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *LinkUpsert) ClearUserID() *LinkUpsert {
+	u.SetNull(link.FieldUserID)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *LinkUpsert) SetURL(v string) *LinkUpsert {
+	u.Set(link.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateURL() *LinkUpsert {
+	u.SetExcluded(link.FieldURL)
+	return u
+}
+
+// SetCanonicalURL sets the "canonical_url" field.
+func (u *LinkUpsert) SetCanonicalURL(v string) *LinkUpsert {
+	u.Set(link.FieldCanonicalURL, v)
+	return u
+}
+
+// UpdateCanonicalURL sets the "canonical_url" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateCanonicalURL() *LinkUpsert {
+	u.SetExcluded(link.FieldCanonicalURL)
+	return u
+}
+
+// ClearCanonicalURL clears the value of the "canonical_url" field.
+func (u *LinkUpsert) ClearCanonicalURL() *LinkUpsert {
+	u.SetNull(link.FieldCanonicalURL)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *LinkUpsert) SetTitle(v string) *LinkUpsert {
+	u.Set(link.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateTitle() *LinkUpsert {
+	u.SetExcluded(link.FieldTitle)
+	return u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *LinkUpsert) ClearTitle() *LinkUpsert {
+	u.SetNull(link.FieldTitle)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *LinkUpsert) SetDescription(v string) *LinkUpsert {
+	u.Set(link.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateDescription() *LinkUpsert {
+	u.SetExcluded(link.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *LinkUpsert) ClearDescription() *LinkUpsert {
+	u.SetNull(link.FieldDescription)
+	return u
+}
+
+// SetDomain sets the "domain" field.
+func (u *LinkUpsert) SetDomain(v string) *LinkUpsert {
+	u.Set(link.FieldDomain, v)
+	return u
+}
+
+// UpdateDomain sets the "domain" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateDomain() *LinkUpsert {
+	u.SetExcluded(link.FieldDomain)
+	return u
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (u *LinkUpsert) ClearDomain() *LinkUpsert {
+	u.SetNull(link.FieldDomain)
+	return u
+}
+
+// SetOgImage sets the "og_image" field.
+func (u *LinkUpsert) SetOgImage(v string) *LinkUpsert {
+	u.Set(link.FieldOgImage, v)
+	return u
+}
+
+// UpdateOgImage sets the "og_image" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateOgImage() *LinkUpsert {
+	u.SetExcluded(link.FieldOgImage)
+	return u
+}
+
+// ClearOgImage clears the value of the "og_image" field.
+func (u *LinkUpsert) ClearOgImage() *LinkUpsert {
+	u.SetNull(link.FieldOgImage)
+	return u
+}
+
+// SetPageURL sets the "page_url" field.
+func (u *LinkUpsert) SetPageURL(v string) *LinkUpsert {
+	u.Set(link.FieldPageURL, v)
+	return u
+}
+
+// UpdatePageURL sets the "page_url" field to the value that was provided on create.
+func (u *LinkUpsert) UpdatePageURL() *LinkUpsert {
+	u.SetExcluded(link.FieldPageURL)
+	return u
+}
+
+// ClearPageURL clears the value of the "page_url" field.
+func (u *LinkUpsert) ClearPageURL() *LinkUpsert {
+	u.SetNull(link.FieldPageURL)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *LinkUpsert) SetNote(v string) *LinkUpsert {
+	u.Set(link.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateNote() *LinkUpsert {
+	u.SetExcluded(link.FieldNote)
+	return u
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *LinkUpsert) ClearNote() *LinkUpsert {
+	u.SetNull(link.FieldNote)
+	return u
+}
+
+// SetTags sets the "tags" field.
+func (u *LinkUpsert) SetTags(v []string) *LinkUpsert {
+	u.Set(link.FieldTags, v)
+	return u
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateTags() *LinkUpsert {
+	u.SetExcluded(link.FieldTags)
+	return u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *LinkUpsert) ClearTags() *LinkUpsert {
+	u.SetNull(link.FieldTags)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *LinkUpsert) SetMetadata(v map[string]interface{}) *LinkUpsert {
+	u.Set(link.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateMetadata() *LinkUpsert {
+	u.SetExcluded(link.FieldMetadata)
+	return u
+}
+
+// SetSavedAt sets the "saved_at" field.
+func (u *LinkUpsert) SetSavedAt(v time.Time) *LinkUpsert {
+	u.Set(link.FieldSavedAt, v)
+	return u
+}
+
+// UpdateSavedAt sets the "saved_at" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateSavedAt() *LinkUpsert {
+	u.SetExcluded(link.FieldSavedAt)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *LinkUpsert) SetCreatedAt(v time.Time) *LinkUpsert {
+	u.Set(link.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateCreatedAt() *LinkUpsert {
+	u.SetExcluded(link.FieldCreatedAt)
+	return u
+}
+
+// SetSearchVector sets the "search_vector" field.
+func (u *LinkUpsert) SetSearchVector(v string) *LinkUpsert {
+	u.Set(link.FieldSearchVector, v)
+	return u
+}
+
+// UpdateSearchVector sets the "search_vector" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateSearchVector() *LinkUpsert {
+	u.SetExcluded(link.FieldSearchVector)
+	return u
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (u *LinkUpsert) ClearSearchVector() *LinkUpsert {
+	u.SetNull(link.FieldSearchVector)
+	return u
+}
+
+// SetSearchText sets the "search_text" field.
+func (u *LinkUpsert) SetSearchText(v string) *LinkUpsert {
+	u.Set(link.FieldSearchText, v)
+	return u
+}
+
+// UpdateSearchText sets the "search_text" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateSearchText() *LinkUpsert {
+	u.SetExcluded(link.FieldSearchText)
+	return u
+}
+
+// ClearSearchText clears the value of the "search_text" field.
+func (u *LinkUpsert) ClearSearchText() *LinkUpsert {
+	u.SetNull(link.FieldSearchText)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *LinkUpsert) SetDeletedAt(v time.Time) *LinkUpsert {
+	u.Set(link.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateDeletedAt() *LinkUpsert {
+	u.SetExcluded(link.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *LinkUpsert) ClearDeletedAt() *LinkUpsert {
+	u.SetNull(link.FieldDeletedAt)
+	return u
+}
+
+// SetSiteID sets the "site_id" field.
+func (u *LinkUpsert) SetSiteID(v uuid.UUID) *LinkUpsert {
+	u.Set(link.FieldSiteID, v)
+	return u
+}
+
+// UpdateSiteID sets the "site_id" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateSiteID() *LinkUpsert {
+	u.SetExcluded(link.FieldSiteID)
+	return u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (u *LinkUpsert) ClearSiteID() *LinkUpsert {
+	u.SetNull(link.FieldSiteID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Link.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(link.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LinkUpsertOne) UpdateNewValues() *LinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(link.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Link.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LinkUpsertOne) Ignore() *LinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LinkUpsertOne) DoNothing() *LinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LinkCreate.OnConflict
+// documentation for more info.
+func (u *LinkUpsertOne) Update(set func(*LinkUpsert)) *LinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LinkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *LinkUpsertOne) SetUserID(v string) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateUserID() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *LinkUpsertOne) ClearUserID() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *LinkUpsertOne) SetURL(v string) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateURL() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetCanonicalURL sets the "canonical_url" field.
+func (u *LinkUpsertOne) SetCanonicalURL(v string) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetCanonicalURL(v)
+	})
+}
+
+// UpdateCanonicalURL sets the "canonical_url" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateCanonicalURL() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateCanonicalURL()
+	})
+}
+
+// ClearCanonicalURL clears the value of the "canonical_url" field.
+func (u *LinkUpsertOne) ClearCanonicalURL() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearCanonicalURL()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *LinkUpsertOne) SetTitle(v string) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateTitle() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *LinkUpsertOne) ClearTitle() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearTitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *LinkUpsertOne) SetDescription(v string) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateDescription() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *LinkUpsertOne) ClearDescription() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetDomain sets the "domain" field.
+func (u *LinkUpsertOne) SetDomain(v string) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetDomain(v)
+	})
+}
+
+// UpdateDomain sets the "domain" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateDomain() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateDomain()
+	})
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (u *LinkUpsertOne) ClearDomain() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearDomain()
+	})
+}
+
+// SetOgImage sets the "og_image" field.
+func (u *LinkUpsertOne) SetOgImage(v string) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetOgImage(v)
+	})
+}
+
+// UpdateOgImage sets the "og_image" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateOgImage() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateOgImage()
+	})
+}
+
+// ClearOgImage clears the value of the "og_image" field.
+func (u *LinkUpsertOne) ClearOgImage() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearOgImage()
+	})
+}
+
+// SetPageURL sets the "page_url" field.
+func (u *LinkUpsertOne) SetPageURL(v string) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetPageURL(v)
+	})
+}
+
+// UpdatePageURL sets the "page_url" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdatePageURL() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdatePageURL()
+	})
+}
+
+// ClearPageURL clears the value of the "page_url" field.
+func (u *LinkUpsertOne) ClearPageURL() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearPageURL()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *LinkUpsertOne) SetNote(v string) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateNote() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *LinkUpsertOne) ClearNote() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *LinkUpsertOne) SetTags(v []string) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateTags() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *LinkUpsertOne) ClearTags() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearTags()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *LinkUpsertOne) SetMetadata(v map[string]interface{}) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateMetadata() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// SetSavedAt sets the "saved_at" field.
+func (u *LinkUpsertOne) SetSavedAt(v time.Time) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetSavedAt(v)
+	})
+}
+
+// UpdateSavedAt sets the "saved_at" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateSavedAt() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateSavedAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *LinkUpsertOne) SetCreatedAt(v time.Time) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateCreatedAt() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetSearchVector sets the "search_vector" field.
+func (u *LinkUpsertOne) SetSearchVector(v string) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetSearchVector(v)
+	})
+}
+
+// UpdateSearchVector sets the "search_vector" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateSearchVector() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateSearchVector()
+	})
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (u *LinkUpsertOne) ClearSearchVector() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearSearchVector()
+	})
+}
+
+// SetSearchText sets the "search_text" field.
+func (u *LinkUpsertOne) SetSearchText(v string) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetSearchText(v)
+	})
+}
+
+// UpdateSearchText sets the "search_text" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateSearchText() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateSearchText()
+	})
+}
+
+// ClearSearchText clears the value of the "search_text" field.
+func (u *LinkUpsertOne) ClearSearchText() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearSearchText()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *LinkUpsertOne) SetDeletedAt(v time.Time) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateDeletedAt() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *LinkUpsertOne) ClearDeletedAt() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetSiteID sets the "site_id" field.
+func (u *LinkUpsertOne) SetSiteID(v uuid.UUID) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetSiteID(v)
+	})
+}
+
+// UpdateSiteID sets the "site_id" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateSiteID() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateSiteID()
+	})
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (u *LinkUpsertOne) ClearSiteID() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearSiteID()
+	})
+}
+
+// Exec executes the query.
+func (u *LinkUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LinkCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LinkUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LinkUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LinkUpsertOne.ID is not supported by MySQL driver. Use LinkUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LinkUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // LinkCreateBulk is the builder for creating many Link entities in bulk.
 type LinkCreateBulk struct {
 	config
 	err      error
 	builders []*LinkCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Link entities in the database.
@@ -362,6 +1322,7 @@ func (_c *LinkCreateBulk) Save(ctx context.Context) ([]*Link, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -411,3 +1372,446 @@ func (_c *LinkCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Link.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LinkUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LinkCreateBulk) OnConflict(opts ...sql.ConflictOption) *LinkUpsertBulk {
+	_c.conflict = opts
+	return &LinkUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Link.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LinkCreateBulk) OnConflictColumns(columns ...string) *LinkUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LinkUpsertBulk{
+		create: _c,
+	}
+}
+
+// LinkUpsertBulk is the builder for "upsert"-ing
+// a bulk of Link nodes.
+type LinkUpsertBulk struct {
+	create *LinkCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Link.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(link.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LinkUpsertBulk) UpdateNewValues() *LinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(link.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Link.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LinkUpsertBulk) Ignore() *LinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LinkUpsertBulk) DoNothing() *LinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LinkCreateBulk.OnConflict
+// documentation for more info.
+func (u *LinkUpsertBulk) Update(set func(*LinkUpsert)) *LinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LinkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *LinkUpsertBulk) SetUserID(v string) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateUserID() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *LinkUpsertBulk) ClearUserID() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *LinkUpsertBulk) SetURL(v string) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateURL() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetCanonicalURL sets the "canonical_url" field.
+func (u *LinkUpsertBulk) SetCanonicalURL(v string) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetCanonicalURL(v)
+	})
+}
+
+// UpdateCanonicalURL sets the "canonical_url" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateCanonicalURL() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateCanonicalURL()
+	})
+}
+
+// ClearCanonicalURL clears the value of the "canonical_url" field.
+func (u *LinkUpsertBulk) ClearCanonicalURL() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearCanonicalURL()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *LinkUpsertBulk) SetTitle(v string) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateTitle() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *LinkUpsertBulk) ClearTitle() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearTitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *LinkUpsertBulk) SetDescription(v string) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateDescription() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *LinkUpsertBulk) ClearDescription() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetDomain sets the "domain" field.
+func (u *LinkUpsertBulk) SetDomain(v string) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetDomain(v)
+	})
+}
+
+// UpdateDomain sets the "domain" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateDomain() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateDomain()
+	})
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (u *LinkUpsertBulk) ClearDomain() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearDomain()
+	})
+}
+
+// SetOgImage sets the "og_image" field.
+func (u *LinkUpsertBulk) SetOgImage(v string) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetOgImage(v)
+	})
+}
+
+// UpdateOgImage sets the "og_image" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateOgImage() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateOgImage()
+	})
+}
+
+// ClearOgImage clears the value of the "og_image" field.
+func (u *LinkUpsertBulk) ClearOgImage() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearOgImage()
+	})
+}
+
+// SetPageURL sets the "page_url" field.
+func (u *LinkUpsertBulk) SetPageURL(v string) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetPageURL(v)
+	})
+}
+
+// UpdatePageURL sets the "page_url" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdatePageURL() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdatePageURL()
+	})
+}
+
+// ClearPageURL clears the value of the "page_url" field.
+func (u *LinkUpsertBulk) ClearPageURL() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearPageURL()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *LinkUpsertBulk) SetNote(v string) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateNote() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *LinkUpsertBulk) ClearNote() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *LinkUpsertBulk) SetTags(v []string) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateTags() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *LinkUpsertBulk) ClearTags() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearTags()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *LinkUpsertBulk) SetMetadata(v map[string]interface{}) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateMetadata() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// SetSavedAt sets the "saved_at" field.
+func (u *LinkUpsertBulk) SetSavedAt(v time.Time) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetSavedAt(v)
+	})
+}
+
+// UpdateSavedAt sets the "saved_at" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateSavedAt() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateSavedAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *LinkUpsertBulk) SetCreatedAt(v time.Time) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateCreatedAt() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetSearchVector sets the "search_vector" field.
+func (u *LinkUpsertBulk) SetSearchVector(v string) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetSearchVector(v)
+	})
+}
+
+// UpdateSearchVector sets the "search_vector" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateSearchVector() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateSearchVector()
+	})
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (u *LinkUpsertBulk) ClearSearchVector() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearSearchVector()
+	})
+}
+
+// SetSearchText sets the "search_text" field.
+func (u *LinkUpsertBulk) SetSearchText(v string) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetSearchText(v)
+	})
+}
+
+// UpdateSearchText sets the "search_text" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateSearchText() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateSearchText()
+	})
+}
+
+// ClearSearchText clears the value of the "search_text" field.
+func (u *LinkUpsertBulk) ClearSearchText() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearSearchText()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *LinkUpsertBulk) SetDeletedAt(v time.Time) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateDeletedAt() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *LinkUpsertBulk) ClearDeletedAt() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetSiteID sets the "site_id" field.
+func (u *LinkUpsertBulk) SetSiteID(v uuid.UUID) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetSiteID(v)
+	})
+}
+
+// UpdateSiteID sets the "site_id" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateSiteID() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateSiteID()
+	})
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (u *LinkUpsertBulk) ClearSiteID() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearSiteID()
+	})
+}
+
+// Exec executes the query.
+func (u *LinkUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LinkCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LinkCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LinkUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
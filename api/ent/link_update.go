@@ -12,8 +12,13 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lvncer/quicklinks/api/ent/job"
 	"github.com/lvncer/quicklinks/api/ent/link"
+	"github.com/lvncer/quicklinks/api/ent/linkcheck"
+	"github.com/lvncer/quicklinks/api/ent/linkcontent"
 	"github.com/lvncer/quicklinks/api/ent/predicate"
+	"github.com/lvncer/quicklinks/api/ent/site"
 )
 
 // LinkUpdate is the builder for updating Link entities.
@@ -63,6 +68,26 @@ func (_u *LinkUpdate) SetNillableURL(v *string) *LinkUpdate {
 	return _u
 }
 
+// SetCanonicalURL sets the "canonical_url" field.
+func (_u *LinkUpdate) SetCanonicalURL(v string) *LinkUpdate {
+	_u.mutation.SetCanonicalURL(v)
+	return _u
+}
+
+// SetNillableCanonicalURL sets the "canonical_url" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableCanonicalURL(v *string) *LinkUpdate {
+	if v != nil {
+		_u.SetCanonicalURL(*v)
+	}
+	return _u
+}
+
+// ClearCanonicalURL clears the value of the "canonical_url" field.
+func (_u *LinkUpdate) ClearCanonicalURL() *LinkUpdate {
+	_u.mutation.ClearCanonicalURL()
+	return _u
+}
+
 // SetTitle sets the "title" field.
 func (_u *LinkUpdate) SetTitle(v string) *LinkUpdate {
 	_u.mutation.SetTitle(v)
@@ -235,11 +260,199 @@ func (_u *LinkUpdate) SetNillableCreatedAt(v *time.Time) *LinkUpdate {
 	return _u
 }
 
+// SetSearchVector sets the "search_vector" field.
+func (_u *LinkUpdate) SetSearchVector(v string) *LinkUpdate {
+	_u.mutation.SetSearchVector(v)
+	return _u
+}
+
+// SetNillableSearchVector sets the "search_vector" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableSearchVector(v *string) *LinkUpdate {
+	if v != nil {
+		_u.SetSearchVector(*v)
+	}
+	return _u
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (_u *LinkUpdate) ClearSearchVector() *LinkUpdate {
+	_u.mutation.ClearSearchVector()
+	return _u
+}
+
+// SetSearchText sets the "search_text" field.
+func (_u *LinkUpdate) SetSearchText(v string) *LinkUpdate {
+	_u.mutation.SetSearchText(v)
+	return _u
+}
+
+// SetNillableSearchText sets the "search_text" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableSearchText(v *string) *LinkUpdate {
+	if v != nil {
+		_u.SetSearchText(*v)
+	}
+	return _u
+}
+
+// ClearSearchText clears the value of the "search_text" field.
+func (_u *LinkUpdate) ClearSearchText() *LinkUpdate {
+	_u.mutation.ClearSearchText()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *LinkUpdate) SetDeletedAt(v time.Time) *LinkUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableDeletedAt(v *time.Time) *LinkUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *LinkUpdate) ClearDeletedAt() *LinkUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetSiteID sets the "site_id" field.
+func (_u *LinkUpdate) SetSiteID(v uuid.UUID) *LinkUpdate {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableSiteID(v *uuid.UUID) *LinkUpdate {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (_u *LinkUpdate) ClearSiteID() *LinkUpdate {
+	_u.mutation.ClearSiteID()
+	return _u
+}
+
+// SetSite sets the "site" edge to the Site entity.
+func (_u *LinkUpdate) SetSite(v *Site) *LinkUpdate {
+	return _u.SetSiteID(v.ID)
+}
+
+// SetContentID sets the "content" edge to the LinkContent entity by ID.
+func (_u *LinkUpdate) SetContentID(id uuid.UUID) *LinkUpdate {
+	_u.mutation.SetContentID(id)
+	return _u
+}
+
+// SetNillableContentID sets the "content" edge to the LinkContent entity by ID if the given value is not nil.
+func (_u *LinkUpdate) SetNillableContentID(id *uuid.UUID) *LinkUpdate {
+	if id != nil {
+		_u = _u.SetContentID(*id)
+	}
+	return _u
+}
+
+// SetContent sets the "content" edge to the LinkContent entity.
+func (_u *LinkUpdate) SetContent(v *LinkContent) *LinkUpdate {
+	return _u.SetContentID(v.ID)
+}
+
+// AddCheckIDs adds the "checks" edge to the LinkCheck entity by IDs.
+func (_u *LinkUpdate) AddCheckIDs(ids ...uuid.UUID) *LinkUpdate {
+	_u.mutation.AddCheckIDs(ids...)
+	return _u
+}
+
+// AddChecks adds the "checks" edges to the LinkCheck entity.
+func (_u *LinkUpdate) AddChecks(v ...*LinkCheck) *LinkUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCheckIDs(ids...)
+}
+
+// AddJobIDs adds the "jobs" edge to the Job entity by IDs.
+func (_u *LinkUpdate) AddJobIDs(ids ...uuid.UUID) *LinkUpdate {
+	_u.mutation.AddJobIDs(ids...)
+	return _u
+}
+
+// AddJobs adds the "jobs" edges to the Job entity.
+func (_u *LinkUpdate) AddJobs(v ...*Job) *LinkUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddJobIDs(ids...)
+}
+
 // Mutation returns the LinkMutation object of the builder.
 func (_u *LinkUpdate) Mutation() *LinkMutation {
 	return _u.mutation
 }
 
+// ClearSite clears the "site" edge to the Site entity.
+func (_u *LinkUpdate) ClearSite() *LinkUpdate {
+	_u.mutation.ClearSite()
+	return _u
+}
+
+// ClearContent clears the "content" edge to the LinkContent entity.
+func (_u *LinkUpdate) ClearContent() *LinkUpdate {
+	_u.mutation.ClearContent()
+	return _u
+}
+
+// ClearChecks clears all "checks" edges to the LinkCheck entity.
+func (_u *LinkUpdate) ClearChecks() *LinkUpdate {
+	_u.mutation.ClearChecks()
+	return _u
+}
+
+// RemoveCheckIDs removes the "checks" edge to LinkCheck entities by IDs.
+func (_u *LinkUpdate) RemoveCheckIDs(ids ...uuid.UUID) *LinkUpdate {
+	_u.mutation.RemoveCheckIDs(ids...)
+	return _u
+}
+
+// RemoveChecks removes "checks" edges to LinkCheck entities.
+func (_u *LinkUpdate) RemoveChecks(v ...*LinkCheck) *LinkUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCheckIDs(ids...)
+}
+
+// ClearJobs clears all "jobs" edges to the Job entity.
+func (_u *LinkUpdate) ClearJobs() *LinkUpdate {
+	_u.mutation.ClearJobs()
+	return _u
+}
+
+// RemoveJobIDs removes the "jobs" edge to Job entities by IDs.
+func (_u *LinkUpdate) RemoveJobIDs(ids ...uuid.UUID) *LinkUpdate {
+	_u.mutation.RemoveJobIDs(ids...)
+	return _u
+}
+
+// RemoveJobs removes "jobs" edges to Job entities.
+func (_u *LinkUpdate) RemoveJobs(v ...*Job) *LinkUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveJobIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *LinkUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -298,6 +511,12 @@ func (_u *LinkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.URL(); ok {
 		_spec.SetField(link.FieldURL, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.CanonicalURL(); ok {
+		_spec.SetField(link.FieldCanonicalURL, field.TypeString, value)
+	}
+	if _u.mutation.CanonicalURLCleared() {
+		_spec.ClearField(link.FieldCanonicalURL, field.TypeString)
+	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(link.FieldTitle, field.TypeString, value)
 	}
@@ -354,6 +573,172 @@ func (_u *LinkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(link.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.SearchVector(); ok {
+		_spec.SetField(link.FieldSearchVector, field.TypeString, value)
+	}
+	if _u.mutation.SearchVectorCleared() {
+		_spec.ClearField(link.FieldSearchVector, field.TypeString)
+	}
+	if value, ok := _u.mutation.SearchText(); ok {
+		_spec.SetField(link.FieldSearchText, field.TypeString, value)
+	}
+	if _u.mutation.SearchTextCleared() {
+		_spec.ClearField(link.FieldSearchText, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(link.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(link.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.SiteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   link.SiteTable,
+			Columns: []string{link.SiteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(site.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SiteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   link.SiteTable,
+			Columns: []string{link.SiteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(site.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ContentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   link.ContentTable,
+			Columns: []string{link.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkcontent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   link.ContentTable,
+			Columns: []string{link.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkcontent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChecksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   link.ChecksTable,
+			Columns: []string{link.ChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkcheck.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChecksIDs(); len(nodes) > 0 && !_u.mutation.ChecksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   link.ChecksTable,
+			Columns: []string{link.ChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkcheck.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChecksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   link.ChecksTable,
+			Columns: []string{link.ChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkcheck.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   link.JobsTable,
+			Columns: []string{link.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedJobsIDs(); len(nodes) > 0 && !_u.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   link.JobsTable,
+			Columns: []string{link.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   link.JobsTable,
+			Columns: []string{link.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{link.Label}
@@ -408,6 +793,26 @@ func (_u *LinkUpdateOne) SetNillableURL(v *string) *LinkUpdateOne {
 	return _u
 }
 
+// SetCanonicalURL sets the "canonical_url" field.
+func (_u *LinkUpdateOne) SetCanonicalURL(v string) *LinkUpdateOne {
+	_u.mutation.SetCanonicalURL(v)
+	return _u
+}
+
+// SetNillableCanonicalURL sets the "canonical_url" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableCanonicalURL(v *string) *LinkUpdateOne {
+	if v != nil {
+		_u.SetCanonicalURL(*v)
+	}
+	return _u
+}
+
+// ClearCanonicalURL clears the value of the "canonical_url" field.
+func (_u *LinkUpdateOne) ClearCanonicalURL() *LinkUpdateOne {
+	_u.mutation.ClearCanonicalURL()
+	return _u
+}
+
 // SetTitle sets the "title" field.
 func (_u *LinkUpdateOne) SetTitle(v string) *LinkUpdateOne {
 	_u.mutation.SetTitle(v)
@@ -580,11 +985,199 @@ func (_u *LinkUpdateOne) SetNillableCreatedAt(v *time.Time) *LinkUpdateOne {
 	return _u
 }
 
+// SetSearchVector sets the "search_vector" field.
+func (_u *LinkUpdateOne) SetSearchVector(v string) *LinkUpdateOne {
+	_u.mutation.SetSearchVector(v)
+	return _u
+}
+
+// SetNillableSearchVector sets the "search_vector" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableSearchVector(v *string) *LinkUpdateOne {
+	if v != nil {
+		_u.SetSearchVector(*v)
+	}
+	return _u
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (_u *LinkUpdateOne) ClearSearchVector() *LinkUpdateOne {
+	_u.mutation.ClearSearchVector()
+	return _u
+}
+
+// SetSearchText sets the "search_text" field.
+func (_u *LinkUpdateOne) SetSearchText(v string) *LinkUpdateOne {
+	_u.mutation.SetSearchText(v)
+	return _u
+}
+
+// SetNillableSearchText sets the "search_text" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableSearchText(v *string) *LinkUpdateOne {
+	if v != nil {
+		_u.SetSearchText(*v)
+	}
+	return _u
+}
+
+// ClearSearchText clears the value of the "search_text" field.
+func (_u *LinkUpdateOne) ClearSearchText() *LinkUpdateOne {
+	_u.mutation.ClearSearchText()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *LinkUpdateOne) SetDeletedAt(v time.Time) *LinkUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableDeletedAt(v *time.Time) *LinkUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *LinkUpdateOne) ClearDeletedAt() *LinkUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetSiteID sets the "site_id" field.
+func (_u *LinkUpdateOne) SetSiteID(v uuid.UUID) *LinkUpdateOne {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableSiteID(v *uuid.UUID) *LinkUpdateOne {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (_u *LinkUpdateOne) ClearSiteID() *LinkUpdateOne {
+	_u.mutation.ClearSiteID()
+	return _u
+}
+
+// SetSite sets the "site" edge to the Site entity.
+func (_u *LinkUpdateOne) SetSite(v *Site) *LinkUpdateOne {
+	return _u.SetSiteID(v.ID)
+}
+
+// SetContentID sets the "content" edge to the LinkContent entity by ID.
+func (_u *LinkUpdateOne) SetContentID(id uuid.UUID) *LinkUpdateOne {
+	_u.mutation.SetContentID(id)
+	return _u
+}
+
+// SetNillableContentID sets the "content" edge to the LinkContent entity by ID if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableContentID(id *uuid.UUID) *LinkUpdateOne {
+	if id != nil {
+		_u = _u.SetContentID(*id)
+	}
+	return _u
+}
+
+// SetContent sets the "content" edge to the LinkContent entity.
+func (_u *LinkUpdateOne) SetContent(v *LinkContent) *LinkUpdateOne {
+	return _u.SetContentID(v.ID)
+}
+
+// AddCheckIDs adds the "checks" edge to the LinkCheck entity by IDs.
+func (_u *LinkUpdateOne) AddCheckIDs(ids ...uuid.UUID) *LinkUpdateOne {
+	_u.mutation.AddCheckIDs(ids...)
+	return _u
+}
+
+// AddChecks adds the "checks" edges to the LinkCheck entity.
+func (_u *LinkUpdateOne) AddChecks(v ...*LinkCheck) *LinkUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCheckIDs(ids...)
+}
+
+// AddJobIDs adds the "jobs" edge to the Job entity by IDs.
+func (_u *LinkUpdateOne) AddJobIDs(ids ...uuid.UUID) *LinkUpdateOne {
+	_u.mutation.AddJobIDs(ids...)
+	return _u
+}
+
+// AddJobs adds the "jobs" edges to the Job entity.
+func (_u *LinkUpdateOne) AddJobs(v ...*Job) *LinkUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddJobIDs(ids...)
+}
+
 // Mutation returns the LinkMutation object of the builder.
 func (_u *LinkUpdateOne) Mutation() *LinkMutation {
 	return _u.mutation
 }
 
+// ClearSite clears the "site" edge to the Site entity.
+func (_u *LinkUpdateOne) ClearSite() *LinkUpdateOne {
+	_u.mutation.ClearSite()
+	return _u
+}
+
+// ClearContent clears the "content" edge to the LinkContent entity.
+func (_u *LinkUpdateOne) ClearContent() *LinkUpdateOne {
+	_u.mutation.ClearContent()
+	return _u
+}
+
+// ClearChecks clears all "checks" edges to the LinkCheck entity.
+func (_u *LinkUpdateOne) ClearChecks() *LinkUpdateOne {
+	_u.mutation.ClearChecks()
+	return _u
+}
+
+// RemoveCheckIDs removes the "checks" edge to LinkCheck entities by IDs.
+func (_u *LinkUpdateOne) RemoveCheckIDs(ids ...uuid.UUID) *LinkUpdateOne {
+	_u.mutation.RemoveCheckIDs(ids...)
+	return _u
+}
+
+// RemoveChecks removes "checks" edges to LinkCheck entities.
+func (_u *LinkUpdateOne) RemoveChecks(v ...*LinkCheck) *LinkUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCheckIDs(ids...)
+}
+
+// ClearJobs clears all "jobs" edges to the Job entity.
+func (_u *LinkUpdateOne) ClearJobs() *LinkUpdateOne {
+	_u.mutation.ClearJobs()
+	return _u
+}
+
+// RemoveJobIDs removes the "jobs" edge to Job entities by IDs.
+func (_u *LinkUpdateOne) RemoveJobIDs(ids ...uuid.UUID) *LinkUpdateOne {
+	_u.mutation.RemoveJobIDs(ids...)
+	return _u
+}
+
+// RemoveJobs removes "jobs" edges to Job entities.
+func (_u *LinkUpdateOne) RemoveJobs(v ...*Job) *LinkUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveJobIDs(ids...)
+}
+
 // Where appends a list predicates to the LinkUpdate builder.
 func (_u *LinkUpdateOne) Where(ps ...predicate.Link) *LinkUpdateOne {
 	_u.mutation.Where(ps...)
@@ -673,6 +1266,12 @@ func (_u *LinkUpdateOne) sqlSave(ctx context.Context) (_node *Link, err error) {
 	if value, ok := _u.mutation.URL(); ok {
 		_spec.SetField(link.FieldURL, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.CanonicalURL(); ok {
+		_spec.SetField(link.FieldCanonicalURL, field.TypeString, value)
+	}
+	if _u.mutation.CanonicalURLCleared() {
+		_spec.ClearField(link.FieldCanonicalURL, field.TypeString)
+	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(link.FieldTitle, field.TypeString, value)
 	}
@@ -729,6 +1328,172 @@ func (_u *LinkUpdateOne) sqlSave(ctx context.Context) (_node *Link, err error) {
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(link.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.SearchVector(); ok {
+		_spec.SetField(link.FieldSearchVector, field.TypeString, value)
+	}
+	if _u.mutation.SearchVectorCleared() {
+		_spec.ClearField(link.FieldSearchVector, field.TypeString)
+	}
+	if value, ok := _u.mutation.SearchText(); ok {
+		_spec.SetField(link.FieldSearchText, field.TypeString, value)
+	}
+	if _u.mutation.SearchTextCleared() {
+		_spec.ClearField(link.FieldSearchText, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(link.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(link.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.SiteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   link.SiteTable,
+			Columns: []string{link.SiteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(site.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SiteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   link.SiteTable,
+			Columns: []string{link.SiteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(site.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ContentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   link.ContentTable,
+			Columns: []string{link.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkcontent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   link.ContentTable,
+			Columns: []string{link.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkcontent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChecksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   link.ChecksTable,
+			Columns: []string{link.ChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkcheck.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChecksIDs(); len(nodes) > 0 && !_u.mutation.ChecksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   link.ChecksTable,
+			Columns: []string{link.ChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkcheck.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChecksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   link.ChecksTable,
+			Columns: []string{link.ChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkcheck.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   link.JobsTable,
+			Columns: []string{link.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedJobsIDs(); len(nodes) > 0 && !_u.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   link.JobsTable,
+			Columns: []string{link.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   link.JobsTable,
+			Columns: []string{link.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Link{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lvncer/quicklinks/api/ent/link"
+	"github.com/lvncer/quicklinks/api/ent/linkcheck"
+)
+
+// LinkCheck is the model entity for the LinkCheck schema.
+type LinkCheck struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// LinkID holds the value of the "link_id" field.
+	LinkID uuid.UUID `json:"link_id,omitempty"`
+	// StatusCode holds the value of the "status_code" field.
+	StatusCode *int `json:"status_code,omitempty"`
+	// FinalURL holds the value of the "final_url" field.
+	FinalURL *string `json:"final_url,omitempty"`
+	// Health holds the value of the "health" field.
+	Health linkcheck.Health `json:"health,omitempty"`
+	// Error holds the value of the "error" field.
+	Error *string `json:"error,omitempty"`
+	// CheckedAt holds the value of the "checked_at" field.
+	CheckedAt time.Time `json:"checked_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LinkCheckQuery when eager-loading is set.
+	Edges        LinkCheckEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LinkCheckEdges holds the relations/edges for other nodes in the graph.
+type LinkCheckEdges struct {
+	// Link holds the value of the link edge.
+	Link *Link `json:"link,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LinkOrErr returns the Link value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LinkCheckEdges) LinkOrErr() (*Link, error) {
+	if e.Link != nil {
+		return e.Link, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: link.Label}
+	}
+	return nil, &NotLoadedError{edge: "link"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LinkCheck) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case linkcheck.FieldStatusCode:
+			values[i] = new(sql.NullInt64)
+		case linkcheck.FieldFinalURL, linkcheck.FieldHealth, linkcheck.FieldError:
+			values[i] = new(sql.NullString)
+		case linkcheck.FieldCheckedAt:
+			values[i] = new(sql.NullTime)
+		case linkcheck.FieldID, linkcheck.FieldLinkID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LinkCheck fields.
+func (_m *LinkCheck) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case linkcheck.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case linkcheck.FieldLinkID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field link_id", values[i])
+			} else if value != nil {
+				_m.LinkID = *value
+			}
+		case linkcheck.FieldStatusCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status_code", values[i])
+			} else if value.Valid {
+				_m.StatusCode = new(int)
+				*_m.StatusCode = int(value.Int64)
+			}
+		case linkcheck.FieldFinalURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field final_url", values[i])
+			} else if value.Valid {
+				_m.FinalURL = new(string)
+				*_m.FinalURL = value.String
+			}
+		case linkcheck.FieldHealth:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field health", values[i])
+			} else if value.Valid {
+				_m.Health = linkcheck.Health(value.String)
+			}
+		case linkcheck.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = new(string)
+				*_m.Error = value.String
+			}
+		case linkcheck.FieldCheckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field checked_at", values[i])
+			} else if value.Valid {
+				_m.CheckedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LinkCheck.
+// This includes values selected through modifiers, order, etc.
+func (_m *LinkCheck) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLink queries the "link" edge of the LinkCheck entity.
+func (_m *LinkCheck) QueryLink() *LinkQuery {
+	return NewLinkCheckClient(_m.config).QueryLink(_m)
+}
+
+// Update returns a builder for updating this LinkCheck.
+// Note that you need to call LinkCheck.Unwrap() before calling this method if this LinkCheck
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LinkCheck) Update() *LinkCheckUpdateOne {
+	return NewLinkCheckClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LinkCheck entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LinkCheck) Unwrap() *LinkCheck {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LinkCheck is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LinkCheck) String() string {
+	var builder strings.Builder
+	builder.WriteString("LinkCheck(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("link_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LinkID))
+	builder.WriteString(", ")
+	if v := _m.StatusCode; v != nil {
+		builder.WriteString("status_code=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.FinalURL; v != nil {
+		builder.WriteString("final_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("health=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Health))
+	builder.WriteString(", ")
+	if v := _m.Error; v != nil {
+		builder.WriteString("error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("checked_at=")
+	builder.WriteString(_m.CheckedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LinkChecks is a parsable slice of LinkCheck.
+type LinkChecks []*LinkCheck
@@ -0,0 +1,147 @@
+// Code generated by ent, DO NOT EDIT.
+
+package linkcheck
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the linkcheck type in the database.
+	Label = "link_check"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldLinkID holds the string denoting the link_id field in the database.
+	FieldLinkID = "link_id"
+	// FieldStatusCode holds the string denoting the status_code field in the database.
+	FieldStatusCode = "status_code"
+	// FieldFinalURL holds the string denoting the final_url field in the database.
+	FieldFinalURL = "final_url"
+	// FieldHealth holds the string denoting the health field in the database.
+	FieldHealth = "health"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldCheckedAt holds the string denoting the checked_at field in the database.
+	FieldCheckedAt = "checked_at"
+	// EdgeLink holds the string denoting the link edge name in mutations.
+	EdgeLink = "link"
+	// Table holds the table name of the linkcheck in the database.
+	Table = "link_checks"
+	// LinkTable is the table that holds the link relation/edge.
+	LinkTable = "link_checks"
+	// LinkInverseTable is the table name for the Link entity.
+	// It exists in this package in order to avoid circular dependency with the "link" package.
+	LinkInverseTable = "links"
+	// LinkColumn is the table column denoting the link relation/edge.
+	LinkColumn = "link_id"
+)
+
+// Columns holds all SQL columns for linkcheck fields.
+var Columns = []string{
+	FieldID,
+	FieldLinkID,
+	FieldStatusCode,
+	FieldFinalURL,
+	FieldHealth,
+	FieldError,
+	FieldCheckedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCheckedAt holds the default value on creation for the "checked_at" field.
+	DefaultCheckedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Health defines the type for the "health" enum field.
+type Health string
+
+// Health values.
+const (
+	HealthOk         Health = "ok"
+	HealthBroken     Health = "broken"
+	HealthRedirected Health = "redirected"
+	HealthUnknown    Health = "unknown"
+)
+
+func (h Health) String() string {
+	return string(h)
+}
+
+// HealthValidator is a validator for the "health" field enum values. It is called by the builders before save.
+func HealthValidator(h Health) error {
+	switch h {
+	case HealthOk, HealthBroken, HealthRedirected, HealthUnknown:
+		return nil
+	default:
+		return fmt.Errorf("linkcheck: invalid enum value for health field: %q", h)
+	}
+}
+
+// OrderOption defines the ordering options for the LinkCheck queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByLinkID orders the results by the link_id field.
+func ByLinkID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLinkID, opts...).ToFunc()
+}
+
+// ByStatusCode orders the results by the status_code field.
+func ByStatusCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusCode, opts...).ToFunc()
+}
+
+// ByFinalURL orders the results by the final_url field.
+func ByFinalURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinalURL, opts...).ToFunc()
+}
+
+// ByHealth orders the results by the health field.
+func ByHealth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHealth, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByCheckedAt orders the results by the checked_at field.
+func ByCheckedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckedAt, opts...).ToFunc()
+}
+
+// ByLinkField orders the results by link field.
+func ByLinkField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLinkStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newLinkStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LinkInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LinkTable, LinkColumn),
+	)
+}
@@ -0,0 +1,400 @@
+// Code generated by ent, DO NOT EDIT.
+
+package linkcheck
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/lvncer/quicklinks/api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldLTE(FieldID, id))
+}
+
+// LinkID applies equality check predicate on the "link_id" field. It's identical to LinkIDEQ.
+func LinkID(v uuid.UUID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldLinkID, v))
+}
+
+// StatusCode applies equality check predicate on the "status_code" field. It's identical to StatusCodeEQ.
+func StatusCode(v int) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldStatusCode, v))
+}
+
+// FinalURL applies equality check predicate on the "final_url" field. It's identical to FinalURLEQ.
+func FinalURL(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldFinalURL, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldError, v))
+}
+
+// CheckedAt applies equality check predicate on the "checked_at" field. It's identical to CheckedAtEQ.
+func CheckedAt(v time.Time) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldCheckedAt, v))
+}
+
+// LinkIDEQ applies the EQ predicate on the "link_id" field.
+func LinkIDEQ(v uuid.UUID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldLinkID, v))
+}
+
+// LinkIDNEQ applies the NEQ predicate on the "link_id" field.
+func LinkIDNEQ(v uuid.UUID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNEQ(FieldLinkID, v))
+}
+
+// LinkIDIn applies the In predicate on the "link_id" field.
+func LinkIDIn(vs ...uuid.UUID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldIn(FieldLinkID, vs...))
+}
+
+// LinkIDNotIn applies the NotIn predicate on the "link_id" field.
+func LinkIDNotIn(vs ...uuid.UUID) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNotIn(FieldLinkID, vs...))
+}
+
+// StatusCodeEQ applies the EQ predicate on the "status_code" field.
+func StatusCodeEQ(v int) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldStatusCode, v))
+}
+
+// StatusCodeNEQ applies the NEQ predicate on the "status_code" field.
+func StatusCodeNEQ(v int) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNEQ(FieldStatusCode, v))
+}
+
+// StatusCodeIn applies the In predicate on the "status_code" field.
+func StatusCodeIn(vs ...int) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldIn(FieldStatusCode, vs...))
+}
+
+// StatusCodeNotIn applies the NotIn predicate on the "status_code" field.
+func StatusCodeNotIn(vs ...int) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNotIn(FieldStatusCode, vs...))
+}
+
+// StatusCodeGT applies the GT predicate on the "status_code" field.
+func StatusCodeGT(v int) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldGT(FieldStatusCode, v))
+}
+
+// StatusCodeGTE applies the GTE predicate on the "status_code" field.
+func StatusCodeGTE(v int) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldGTE(FieldStatusCode, v))
+}
+
+// StatusCodeLT applies the LT predicate on the "status_code" field.
+func StatusCodeLT(v int) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldLT(FieldStatusCode, v))
+}
+
+// StatusCodeLTE applies the LTE predicate on the "status_code" field.
+func StatusCodeLTE(v int) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldLTE(FieldStatusCode, v))
+}
+
+// StatusCodeIsNil applies the IsNil predicate on the "status_code" field.
+func StatusCodeIsNil() predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldIsNull(FieldStatusCode))
+}
+
+// StatusCodeNotNil applies the NotNil predicate on the "status_code" field.
+func StatusCodeNotNil() predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNotNull(FieldStatusCode))
+}
+
+// FinalURLEQ applies the EQ predicate on the "final_url" field.
+func FinalURLEQ(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldFinalURL, v))
+}
+
+// FinalURLNEQ applies the NEQ predicate on the "final_url" field.
+func FinalURLNEQ(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNEQ(FieldFinalURL, v))
+}
+
+// FinalURLIn applies the In predicate on the "final_url" field.
+func FinalURLIn(vs ...string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldIn(FieldFinalURL, vs...))
+}
+
+// FinalURLNotIn applies the NotIn predicate on the "final_url" field.
+func FinalURLNotIn(vs ...string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNotIn(FieldFinalURL, vs...))
+}
+
+// FinalURLGT applies the GT predicate on the "final_url" field.
+func FinalURLGT(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldGT(FieldFinalURL, v))
+}
+
+// FinalURLGTE applies the GTE predicate on the "final_url" field.
+func FinalURLGTE(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldGTE(FieldFinalURL, v))
+}
+
+// FinalURLLT applies the LT predicate on the "final_url" field.
+func FinalURLLT(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldLT(FieldFinalURL, v))
+}
+
+// FinalURLLTE applies the LTE predicate on the "final_url" field.
+func FinalURLLTE(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldLTE(FieldFinalURL, v))
+}
+
+// FinalURLContains applies the Contains predicate on the "final_url" field.
+func FinalURLContains(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldContains(FieldFinalURL, v))
+}
+
+// FinalURLHasPrefix applies the HasPrefix predicate on the "final_url" field.
+func FinalURLHasPrefix(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldHasPrefix(FieldFinalURL, v))
+}
+
+// FinalURLHasSuffix applies the HasSuffix predicate on the "final_url" field.
+func FinalURLHasSuffix(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldHasSuffix(FieldFinalURL, v))
+}
+
+// FinalURLIsNil applies the IsNil predicate on the "final_url" field.
+func FinalURLIsNil() predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldIsNull(FieldFinalURL))
+}
+
+// FinalURLNotNil applies the NotNil predicate on the "final_url" field.
+func FinalURLNotNil() predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNotNull(FieldFinalURL))
+}
+
+// FinalURLEqualFold applies the EqualFold predicate on the "final_url" field.
+func FinalURLEqualFold(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEqualFold(FieldFinalURL, v))
+}
+
+// FinalURLContainsFold applies the ContainsFold predicate on the "final_url" field.
+func FinalURLContainsFold(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldContainsFold(FieldFinalURL, v))
+}
+
+// HealthEQ applies the EQ predicate on the "health" field.
+func HealthEQ(v Health) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldHealth, v))
+}
+
+// HealthNEQ applies the NEQ predicate on the "health" field.
+func HealthNEQ(v Health) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNEQ(FieldHealth, v))
+}
+
+// HealthIn applies the In predicate on the "health" field.
+func HealthIn(vs ...Health) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldIn(FieldHealth, vs...))
+}
+
+// HealthNotIn applies the NotIn predicate on the "health" field.
+func HealthNotIn(vs ...Health) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNotIn(FieldHealth, vs...))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldContainsFold(FieldError, v))
+}
+
+// CheckedAtEQ applies the EQ predicate on the "checked_at" field.
+func CheckedAtEQ(v time.Time) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldEQ(FieldCheckedAt, v))
+}
+
+// CheckedAtNEQ applies the NEQ predicate on the "checked_at" field.
+func CheckedAtNEQ(v time.Time) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNEQ(FieldCheckedAt, v))
+}
+
+// CheckedAtIn applies the In predicate on the "checked_at" field.
+func CheckedAtIn(vs ...time.Time) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldIn(FieldCheckedAt, vs...))
+}
+
+// CheckedAtNotIn applies the NotIn predicate on the "checked_at" field.
+func CheckedAtNotIn(vs ...time.Time) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldNotIn(FieldCheckedAt, vs...))
+}
+
+// CheckedAtGT applies the GT predicate on the "checked_at" field.
+func CheckedAtGT(v time.Time) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldGT(FieldCheckedAt, v))
+}
+
+// CheckedAtGTE applies the GTE predicate on the "checked_at" field.
+func CheckedAtGTE(v time.Time) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldGTE(FieldCheckedAt, v))
+}
+
+// CheckedAtLT applies the LT predicate on the "checked_at" field.
+func CheckedAtLT(v time.Time) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldLT(FieldCheckedAt, v))
+}
+
+// CheckedAtLTE applies the LTE predicate on the "checked_at" field.
+func CheckedAtLTE(v time.Time) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.FieldLTE(FieldCheckedAt, v))
+}
+
+// HasLink applies the HasEdge predicate on the "link" edge.
+func HasLink() predicate.LinkCheck {
+	return predicate.LinkCheck(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LinkTable, LinkColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLinkWith applies the HasEdge predicate on the "link" edge with a given conditions (other predicates).
+func HasLinkWith(preds ...predicate.Link) predicate.LinkCheck {
+	return predicate.LinkCheck(func(s *sql.Selector) {
+		step := newLinkStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LinkCheck) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LinkCheck) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LinkCheck) predicate.LinkCheck {
+	return predicate.LinkCheck(sql.NotPredicates(p))
+}
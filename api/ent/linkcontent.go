@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lvncer/quicklinks/api/ent/link"
+	"github.com/lvncer/quicklinks/api/ent/linkcontent"
+)
+
+// LinkContent is the model entity for the LinkContent schema.
+type LinkContent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// LinkID holds the value of the "link_id" field.
+	LinkID uuid.UUID `json:"link_id,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// WordCount holds the value of the "word_count" field.
+	WordCount int `json:"word_count,omitempty"`
+	// ReadingMinutes holds the value of the "reading_minutes" field.
+	ReadingMinutes int `json:"reading_minutes,omitempty"`
+	// ExtractedAt holds the value of the "extracted_at" field.
+	ExtractedAt time.Time `json:"extracted_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LinkContentQuery when eager-loading is set.
+	Edges        LinkContentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LinkContentEdges holds the relations/edges for other nodes in the graph.
+type LinkContentEdges struct {
+	// Link holds the value of the link edge.
+	Link *Link `json:"link,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LinkOrErr returns the Link value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LinkContentEdges) LinkOrErr() (*Link, error) {
+	if e.Link != nil {
+		return e.Link, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: link.Label}
+	}
+	return nil, &NotLoadedError{edge: "link"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LinkContent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case linkcontent.FieldWordCount, linkcontent.FieldReadingMinutes:
+			values[i] = new(sql.NullInt64)
+		case linkcontent.FieldText:
+			values[i] = new(sql.NullString)
+		case linkcontent.FieldExtractedAt, linkcontent.FieldCreatedAt, linkcontent.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case linkcontent.FieldID, linkcontent.FieldLinkID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LinkContent fields.
+func (_m *LinkContent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case linkcontent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case linkcontent.FieldLinkID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field link_id", values[i])
+			} else if value != nil {
+				_m.LinkID = *value
+			}
+		case linkcontent.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				_m.Text = value.String
+			}
+		case linkcontent.FieldWordCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field word_count", values[i])
+			} else if value.Valid {
+				_m.WordCount = int(value.Int64)
+			}
+		case linkcontent.FieldReadingMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reading_minutes", values[i])
+			} else if value.Valid {
+				_m.ReadingMinutes = int(value.Int64)
+			}
+		case linkcontent.FieldExtractedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field extracted_at", values[i])
+			} else if value.Valid {
+				_m.ExtractedAt = value.Time
+			}
+		case linkcontent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case linkcontent.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LinkContent.
+// This includes values selected through modifiers, order, etc.
+func (_m *LinkContent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLink queries the "link" edge of the LinkContent entity.
+func (_m *LinkContent) QueryLink() *LinkQuery {
+	return NewLinkContentClient(_m.config).QueryLink(_m)
+}
+
+// Update returns a builder for updating this LinkContent.
+// Note that you need to call LinkContent.Unwrap() before calling this method if this LinkContent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LinkContent) Update() *LinkContentUpdateOne {
+	return NewLinkContentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LinkContent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LinkContent) Unwrap() *LinkContent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LinkContent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LinkContent) String() string {
+	var builder strings.Builder
+	builder.WriteString("LinkContent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("link_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LinkID))
+	builder.WriteString(", ")
+	builder.WriteString("text=")
+	builder.WriteString(_m.Text)
+	builder.WriteString(", ")
+	builder.WriteString("word_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WordCount))
+	builder.WriteString(", ")
+	builder.WriteString("reading_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReadingMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("extracted_at=")
+	builder.WriteString(_m.ExtractedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LinkContents is a parsable slice of LinkContent.
+type LinkContents []*LinkContent
@@ -0,0 +1,415 @@
+// Code generated by ent, DO NOT EDIT.
+
+package linkcontent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/lvncer/quicklinks/api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldLTE(FieldID, id))
+}
+
+// LinkID applies equality check predicate on the "link_id" field. It's identical to LinkIDEQ.
+func LinkID(v uuid.UUID) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldEQ(FieldLinkID, v))
+}
+
+// Text applies equality check predicate on the "text" field. It's identical to TextEQ.
+func Text(v string) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldEQ(FieldText, v))
+}
+
+// WordCount applies equality check predicate on the "word_count" field. It's identical to WordCountEQ.
+func WordCount(v int) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldEQ(FieldWordCount, v))
+}
+
+// ReadingMinutes applies equality check predicate on the "reading_minutes" field. It's identical to ReadingMinutesEQ.
+func ReadingMinutes(v int) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldEQ(FieldReadingMinutes, v))
+}
+
+// ExtractedAt applies equality check predicate on the "extracted_at" field. It's identical to ExtractedAtEQ.
+func ExtractedAt(v time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldEQ(FieldExtractedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// LinkIDEQ applies the EQ predicate on the "link_id" field.
+func LinkIDEQ(v uuid.UUID) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldEQ(FieldLinkID, v))
+}
+
+// LinkIDNEQ applies the NEQ predicate on the "link_id" field.
+func LinkIDNEQ(v uuid.UUID) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldNEQ(FieldLinkID, v))
+}
+
+// LinkIDIn applies the In predicate on the "link_id" field.
+func LinkIDIn(vs ...uuid.UUID) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldIn(FieldLinkID, vs...))
+}
+
+// LinkIDNotIn applies the NotIn predicate on the "link_id" field.
+func LinkIDNotIn(vs ...uuid.UUID) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldNotIn(FieldLinkID, vs...))
+}
+
+// TextEQ applies the EQ predicate on the "text" field.
+func TextEQ(v string) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldEQ(FieldText, v))
+}
+
+// TextNEQ applies the NEQ predicate on the "text" field.
+func TextNEQ(v string) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldNEQ(FieldText, v))
+}
+
+// TextIn applies the In predicate on the "text" field.
+func TextIn(vs ...string) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldIn(FieldText, vs...))
+}
+
+// TextNotIn applies the NotIn predicate on the "text" field.
+func TextNotIn(vs ...string) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldNotIn(FieldText, vs...))
+}
+
+// TextGT applies the GT predicate on the "text" field.
+func TextGT(v string) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldGT(FieldText, v))
+}
+
+// TextGTE applies the GTE predicate on the "text" field.
+func TextGTE(v string) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldGTE(FieldText, v))
+}
+
+// TextLT applies the LT predicate on the "text" field.
+func TextLT(v string) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldLT(FieldText, v))
+}
+
+// TextLTE applies the LTE predicate on the "text" field.
+func TextLTE(v string) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldLTE(FieldText, v))
+}
+
+// TextContains applies the Contains predicate on the "text" field.
+func TextContains(v string) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldContains(FieldText, v))
+}
+
+// TextHasPrefix applies the HasPrefix predicate on the "text" field.
+func TextHasPrefix(v string) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldHasPrefix(FieldText, v))
+}
+
+// TextHasSuffix applies the HasSuffix predicate on the "text" field.
+func TextHasSuffix(v string) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldHasSuffix(FieldText, v))
+}
+
+// TextEqualFold applies the EqualFold predicate on the "text" field.
+func TextEqualFold(v string) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldEqualFold(FieldText, v))
+}
+
+// TextContainsFold applies the ContainsFold predicate on the "text" field.
+func TextContainsFold(v string) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldContainsFold(FieldText, v))
+}
+
+// WordCountEQ applies the EQ predicate on the "word_count" field.
+func WordCountEQ(v int) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldEQ(FieldWordCount, v))
+}
+
+// WordCountNEQ applies the NEQ predicate on the "word_count" field.
+func WordCountNEQ(v int) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldNEQ(FieldWordCount, v))
+}
+
+// WordCountIn applies the In predicate on the "word_count" field.
+func WordCountIn(vs ...int) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldIn(FieldWordCount, vs...))
+}
+
+// WordCountNotIn applies the NotIn predicate on the "word_count" field.
+func WordCountNotIn(vs ...int) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldNotIn(FieldWordCount, vs...))
+}
+
+// WordCountGT applies the GT predicate on the "word_count" field.
+func WordCountGT(v int) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldGT(FieldWordCount, v))
+}
+
+// WordCountGTE applies the GTE predicate on the "word_count" field.
+func WordCountGTE(v int) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldGTE(FieldWordCount, v))
+}
+
+// WordCountLT applies the LT predicate on the "word_count" field.
+func WordCountLT(v int) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldLT(FieldWordCount, v))
+}
+
+// WordCountLTE applies the LTE predicate on the "word_count" field.
+func WordCountLTE(v int) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldLTE(FieldWordCount, v))
+}
+
+// ReadingMinutesEQ applies the EQ predicate on the "reading_minutes" field.
+func ReadingMinutesEQ(v int) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldEQ(FieldReadingMinutes, v))
+}
+
+// ReadingMinutesNEQ applies the NEQ predicate on the "reading_minutes" field.
+func ReadingMinutesNEQ(v int) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldNEQ(FieldReadingMinutes, v))
+}
+
+// ReadingMinutesIn applies the In predicate on the "reading_minutes" field.
+func ReadingMinutesIn(vs ...int) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldIn(FieldReadingMinutes, vs...))
+}
+
+// ReadingMinutesNotIn applies the NotIn predicate on the "reading_minutes" field.
+func ReadingMinutesNotIn(vs ...int) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldNotIn(FieldReadingMinutes, vs...))
+}
+
+// ReadingMinutesGT applies the GT predicate on the "reading_minutes" field.
+func ReadingMinutesGT(v int) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldGT(FieldReadingMinutes, v))
+}
+
+// ReadingMinutesGTE applies the GTE predicate on the "reading_minutes" field.
+func ReadingMinutesGTE(v int) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldGTE(FieldReadingMinutes, v))
+}
+
+// ReadingMinutesLT applies the LT predicate on the "reading_minutes" field.
+func ReadingMinutesLT(v int) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldLT(FieldReadingMinutes, v))
+}
+
+// ReadingMinutesLTE applies the LTE predicate on the "reading_minutes" field.
+func ReadingMinutesLTE(v int) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldLTE(FieldReadingMinutes, v))
+}
+
+// ExtractedAtEQ applies the EQ predicate on the "extracted_at" field.
+func ExtractedAtEQ(v time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldEQ(FieldExtractedAt, v))
+}
+
+// ExtractedAtNEQ applies the NEQ predicate on the "extracted_at" field.
+func ExtractedAtNEQ(v time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldNEQ(FieldExtractedAt, v))
+}
+
+// ExtractedAtIn applies the In predicate on the "extracted_at" field.
+func ExtractedAtIn(vs ...time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldIn(FieldExtractedAt, vs...))
+}
+
+// ExtractedAtNotIn applies the NotIn predicate on the "extracted_at" field.
+func ExtractedAtNotIn(vs ...time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldNotIn(FieldExtractedAt, vs...))
+}
+
+// ExtractedAtGT applies the GT predicate on the "extracted_at" field.
+func ExtractedAtGT(v time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldGT(FieldExtractedAt, v))
+}
+
+// ExtractedAtGTE applies the GTE predicate on the "extracted_at" field.
+func ExtractedAtGTE(v time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldGTE(FieldExtractedAt, v))
+}
+
+// ExtractedAtLT applies the LT predicate on the "extracted_at" field.
+func ExtractedAtLT(v time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldLT(FieldExtractedAt, v))
+}
+
+// ExtractedAtLTE applies the LTE predicate on the "extracted_at" field.
+func ExtractedAtLTE(v time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldLTE(FieldExtractedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LinkContent {
+	return predicate.LinkContent(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasLink applies the HasEdge predicate on the "link" edge.
+func HasLink() predicate.LinkContent {
+	return predicate.LinkContent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, LinkTable, LinkColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLinkWith applies the HasEdge predicate on the "link" edge with a given conditions (other predicates).
+func HasLinkWith(preds ...predicate.Link) predicate.LinkContent {
+	return predicate.LinkContent(func(s *sql.Selector) {
+		step := newLinkStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LinkContent) predicate.LinkContent {
+	return predicate.LinkContent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LinkContent) predicate.LinkContent {
+	return predicate.LinkContent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LinkContent) predicate.LinkContent {
+	return predicate.LinkContent(sql.NotPredicates(p))
+}
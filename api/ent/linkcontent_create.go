@@ -0,0 +1,931 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lvncer/quicklinks/api/ent/link"
+	"github.com/lvncer/quicklinks/api/ent/linkcontent"
+)
+
+// LinkContentCreate is the builder for creating a LinkContent entity.
+type LinkContentCreate struct {
+	config
+	mutation *LinkContentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetLinkID sets the "link_id" field.
+func (_c *LinkContentCreate) SetLinkID(v uuid.UUID) *LinkContentCreate {
+	_c.mutation.SetLinkID(v)
+	return _c
+}
+
+// SetText sets the "text" field.
+func (_c *LinkContentCreate) SetText(v string) *LinkContentCreate {
+	_c.mutation.SetText(v)
+	return _c
+}
+
+// SetWordCount sets the "word_count" field.
+func (_c *LinkContentCreate) SetWordCount(v int) *LinkContentCreate {
+	_c.mutation.SetWordCount(v)
+	return _c
+}
+
+// SetNillableWordCount sets the "word_count" field if the given value is not nil.
+func (_c *LinkContentCreate) SetNillableWordCount(v *int) *LinkContentCreate {
+	if v != nil {
+		_c.SetWordCount(*v)
+	}
+	return _c
+}
+
+// SetReadingMinutes sets the "reading_minutes" field.
+func (_c *LinkContentCreate) SetReadingMinutes(v int) *LinkContentCreate {
+	_c.mutation.SetReadingMinutes(v)
+	return _c
+}
+
+// SetNillableReadingMinutes sets the "reading_minutes" field if the given value is not nil.
+func (_c *LinkContentCreate) SetNillableReadingMinutes(v *int) *LinkContentCreate {
+	if v != nil {
+		_c.SetReadingMinutes(*v)
+	}
+	return _c
+}
+
+// SetExtractedAt sets the "extracted_at" field.
+func (_c *LinkContentCreate) SetExtractedAt(v time.Time) *LinkContentCreate {
+	_c.mutation.SetExtractedAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LinkContentCreate) SetCreatedAt(v time.Time) *LinkContentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LinkContentCreate) SetNillableCreatedAt(v *time.Time) *LinkContentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LinkContentCreate) SetUpdatedAt(v time.Time) *LinkContentCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LinkContentCreate) SetNillableUpdatedAt(v *time.Time) *LinkContentCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LinkContentCreate) SetID(v uuid.UUID) *LinkContentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LinkContentCreate) SetNillableID(v *uuid.UUID) *LinkContentCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLink sets the "link" edge to the Link entity.
+func (_c *LinkContentCreate) SetLink(v *Link) *LinkContentCreate {
+	return _c.SetLinkID(v.ID)
+}
+
+// Mutation returns the LinkContentMutation object of the builder.
+func (_c *LinkContentCreate) Mutation() *LinkContentMutation {
+	return _c.mutation
+}
+
+// Save creates the LinkContent in the database.
+func (_c *LinkContentCreate) Save(ctx context.Context) (*LinkContent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LinkContentCreate) SaveX(ctx context.Context) *LinkContent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LinkContentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LinkContentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LinkContentCreate) defaults() {
+	if _, ok := _c.mutation.WordCount(); !ok {
+		v := linkcontent.DefaultWordCount
+		_c.mutation.SetWordCount(v)
+	}
+	if _, ok := _c.mutation.ReadingMinutes(); !ok {
+		v := linkcontent.DefaultReadingMinutes
+		_c.mutation.SetReadingMinutes(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := linkcontent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := linkcontent.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := linkcontent.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LinkContentCreate) check() error {
+	if _, ok := _c.mutation.LinkID(); !ok {
+		return &ValidationError{Name: "link_id", err: errors.New(`ent: missing required field "LinkContent.link_id"`)}
+	}
+	if _, ok := _c.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "LinkContent.text"`)}
+	}
+	if _, ok := _c.mutation.WordCount(); !ok {
+		return &ValidationError{Name: "word_count", err: errors.New(`ent: missing required field "LinkContent.word_count"`)}
+	}
+	if _, ok := _c.mutation.ReadingMinutes(); !ok {
+		return &ValidationError{Name: "reading_minutes", err: errors.New(`ent: missing required field "LinkContent.reading_minutes"`)}
+	}
+	if _, ok := _c.mutation.ExtractedAt(); !ok {
+		return &ValidationError{Name: "extracted_at", err: errors.New(`ent: missing required field "LinkContent.extracted_at"`)}
+	}
+	if len(_c.mutation.LinkIDs()) == 0 {
+		return &ValidationError{Name: "link", err: errors.New(`ent: missing required edge "LinkContent.link"`)}
+	}
+	return nil
+}
+
+func (_c *LinkContentCreate) sqlSave(ctx context.Context) (*LinkContent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LinkContentCreate) createSpec() (*LinkContent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LinkContent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(linkcontent.Table, sqlgraph.NewFieldSpec(linkcontent.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Text(); ok {
+		_spec.SetField(linkcontent.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := _c.mutation.WordCount(); ok {
+		_spec.SetField(linkcontent.FieldWordCount, field.TypeInt, value)
+		_node.WordCount = value
+	}
+	if value, ok := _c.mutation.ReadingMinutes(); ok {
+		_spec.SetField(linkcontent.FieldReadingMinutes, field.TypeInt, value)
+		_node.ReadingMinutes = value
+	}
+	if value, ok := _c.mutation.ExtractedAt(); ok {
+		_spec.SetField(linkcontent.FieldExtractedAt, field.TypeTime, value)
+		_node.ExtractedAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(linkcontent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(linkcontent.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.LinkIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   linkcontent.LinkTable,
+			Columns: []string{linkcontent.LinkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(link.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LinkID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LinkContent.Create().
+//		SetLinkID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LinkContentUpsert) {
+//			SetLinkID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LinkContentCreate) OnConflict(opts ...sql.ConflictOption) *LinkContentUpsertOne {
+	_c.conflict = opts
+	return &LinkContentUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LinkContent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LinkContentCreate) OnConflictColumns(columns ...string) *LinkContentUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LinkContentUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LinkContentUpsertOne is the builder for "upsert"-ing
+	//  one LinkContent node.
+	LinkContentUpsertOne struct {
+		create *LinkContentCreate
+	}
+
+	// LinkContentUpsert is the "OnConflict" setter.
+	LinkContentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetLinkID sets the "link_id" field.
+func (u *LinkContentUpsert) SetLinkID(v uuid.UUID) *LinkContentUpsert {
+	u.Set(linkcontent.FieldLinkID, v)
+	return u
+}
+
+// UpdateLinkID sets the "link_id" field to the value that was provided on create.
+func (u *LinkContentUpsert) UpdateLinkID() *LinkContentUpsert {
+	u.SetExcluded(linkcontent.FieldLinkID)
+	return u
+}
+
+// SetText sets the "text" field.
+func (u *LinkContentUpsert) SetText(v string) *LinkContentUpsert {
+	u.Set(linkcontent.FieldText, v)
+	return u
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *LinkContentUpsert) UpdateText() *LinkContentUpsert {
+	u.SetExcluded(linkcontent.FieldText)
+	return u
+}
+
+// SetWordCount sets the "word_count" field.
+func (u *LinkContentUpsert) SetWordCount(v int) *LinkContentUpsert {
+	u.Set(linkcontent.FieldWordCount, v)
+	return u
+}
+
+// UpdateWordCount sets the "word_count" field to the value that was provided on create.
+func (u *LinkContentUpsert) UpdateWordCount() *LinkContentUpsert {
+	u.SetExcluded(linkcontent.FieldWordCount)
+	return u
+}
+
+// AddWordCount adds v to the "word_count" field.
+func (u *LinkContentUpsert) AddWordCount(v int) *LinkContentUpsert {
+	u.Add(linkcontent.FieldWordCount, v)
+	return u
+}
+
+// SetReadingMinutes sets the "reading_minutes" field.
+func (u *LinkContentUpsert) SetReadingMinutes(v int) *LinkContentUpsert {
+	u.Set(linkcontent.FieldReadingMinutes, v)
+	return u
+}
+
+// UpdateReadingMinutes sets the "reading_minutes" field to the value that was provided on create.
+func (u *LinkContentUpsert) UpdateReadingMinutes() *LinkContentUpsert {
+	u.SetExcluded(linkcontent.FieldReadingMinutes)
+	return u
+}
+
+// AddReadingMinutes adds v to the "reading_minutes" field.
+func (u *LinkContentUpsert) AddReadingMinutes(v int) *LinkContentUpsert {
+	u.Add(linkcontent.FieldReadingMinutes, v)
+	return u
+}
+
+// SetExtractedAt sets the "extracted_at" field.
+func (u *LinkContentUpsert) SetExtractedAt(v time.Time) *LinkContentUpsert {
+	u.Set(linkcontent.FieldExtractedAt, v)
+	return u
+}
+
+// UpdateExtractedAt sets the "extracted_at" field to the value that was provided on create.
+func (u *LinkContentUpsert) UpdateExtractedAt() *LinkContentUpsert {
+	u.SetExcluded(linkcontent.FieldExtractedAt)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *LinkContentUpsert) SetCreatedAt(v time.Time) *LinkContentUpsert {
+	u.Set(linkcontent.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *LinkContentUpsert) UpdateCreatedAt() *LinkContentUpsert {
+	u.SetExcluded(linkcontent.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LinkContentUpsert) SetUpdatedAt(v time.Time) *LinkContentUpsert {
+	u.Set(linkcontent.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LinkContentUpsert) UpdateUpdatedAt() *LinkContentUpsert {
+	u.SetExcluded(linkcontent.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LinkContent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(linkcontent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LinkContentUpsertOne) UpdateNewValues() *LinkContentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(linkcontent.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LinkContent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LinkContentUpsertOne) Ignore() *LinkContentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LinkContentUpsertOne) DoNothing() *LinkContentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LinkContentCreate.OnConflict
+// documentation for more info.
+func (u *LinkContentUpsertOne) Update(set func(*LinkContentUpsert)) *LinkContentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LinkContentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetLinkID sets the "link_id" field.
+func (u *LinkContentUpsertOne) SetLinkID(v uuid.UUID) *LinkContentUpsertOne {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.SetLinkID(v)
+	})
+}
+
+// UpdateLinkID sets the "link_id" field to the value that was provided on create.
+func (u *LinkContentUpsertOne) UpdateLinkID() *LinkContentUpsertOne {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.UpdateLinkID()
+	})
+}
+
+// SetText sets the "text" field.
+func (u *LinkContentUpsertOne) SetText(v string) *LinkContentUpsertOne {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *LinkContentUpsertOne) UpdateText() *LinkContentUpsertOne {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.UpdateText()
+	})
+}
+
+// SetWordCount sets the "word_count" field.
+func (u *LinkContentUpsertOne) SetWordCount(v int) *LinkContentUpsertOne {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.SetWordCount(v)
+	})
+}
+
+// AddWordCount adds v to the "word_count" field.
+func (u *LinkContentUpsertOne) AddWordCount(v int) *LinkContentUpsertOne {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.AddWordCount(v)
+	})
+}
+
+// UpdateWordCount sets the "word_count" field to the value that was provided on create.
+func (u *LinkContentUpsertOne) UpdateWordCount() *LinkContentUpsertOne {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.UpdateWordCount()
+	})
+}
+
+// SetReadingMinutes sets the "reading_minutes" field.
+func (u *LinkContentUpsertOne) SetReadingMinutes(v int) *LinkContentUpsertOne {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.SetReadingMinutes(v)
+	})
+}
+
+// AddReadingMinutes adds v to the "reading_minutes" field.
+func (u *LinkContentUpsertOne) AddReadingMinutes(v int) *LinkContentUpsertOne {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.AddReadingMinutes(v)
+	})
+}
+
+// UpdateReadingMinutes sets the "reading_minutes" field to the value that was provided on create.
+func (u *LinkContentUpsertOne) UpdateReadingMinutes() *LinkContentUpsertOne {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.UpdateReadingMinutes()
+	})
+}
+
+// SetExtractedAt sets the "extracted_at" field.
+func (u *LinkContentUpsertOne) SetExtractedAt(v time.Time) *LinkContentUpsertOne {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.SetExtractedAt(v)
+	})
+}
+
+// UpdateExtractedAt sets the "extracted_at" field to the value that was provided on create.
+func (u *LinkContentUpsertOne) UpdateExtractedAt() *LinkContentUpsertOne {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.UpdateExtractedAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *LinkContentUpsertOne) SetCreatedAt(v time.Time) *LinkContentUpsertOne {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *LinkContentUpsertOne) UpdateCreatedAt() *LinkContentUpsertOne {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LinkContentUpsertOne) SetUpdatedAt(v time.Time) *LinkContentUpsertOne {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LinkContentUpsertOne) UpdateUpdatedAt() *LinkContentUpsertOne {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LinkContentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LinkContentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LinkContentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LinkContentUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LinkContentUpsertOne.ID is not supported by MySQL driver. Use LinkContentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LinkContentUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LinkContentCreateBulk is the builder for creating many LinkContent entities in bulk.
+type LinkContentCreateBulk struct {
+	config
+	err      error
+	builders []*LinkContentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LinkContent entities in the database.
+func (_c *LinkContentCreateBulk) Save(ctx context.Context) ([]*LinkContent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LinkContent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LinkContentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LinkContentCreateBulk) SaveX(ctx context.Context) []*LinkContent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LinkContentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LinkContentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LinkContent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LinkContentUpsert) {
+//			SetLinkID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LinkContentCreateBulk) OnConflict(opts ...sql.ConflictOption) *LinkContentUpsertBulk {
+	_c.conflict = opts
+	return &LinkContentUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LinkContent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LinkContentCreateBulk) OnConflictColumns(columns ...string) *LinkContentUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LinkContentUpsertBulk{
+		create: _c,
+	}
+}
+
+// LinkContentUpsertBulk is the builder for "upsert"-ing
+// a bulk of LinkContent nodes.
+type LinkContentUpsertBulk struct {
+	create *LinkContentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LinkContent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(linkcontent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LinkContentUpsertBulk) UpdateNewValues() *LinkContentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(linkcontent.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LinkContent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LinkContentUpsertBulk) Ignore() *LinkContentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LinkContentUpsertBulk) DoNothing() *LinkContentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LinkContentCreateBulk.OnConflict
+// documentation for more info.
+func (u *LinkContentUpsertBulk) Update(set func(*LinkContentUpsert)) *LinkContentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LinkContentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetLinkID sets the "link_id" field.
+func (u *LinkContentUpsertBulk) SetLinkID(v uuid.UUID) *LinkContentUpsertBulk {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.SetLinkID(v)
+	})
+}
+
+// UpdateLinkID sets the "link_id" field to the value that was provided on create.
+func (u *LinkContentUpsertBulk) UpdateLinkID() *LinkContentUpsertBulk {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.UpdateLinkID()
+	})
+}
+
+// SetText sets the "text" field.
+func (u *LinkContentUpsertBulk) SetText(v string) *LinkContentUpsertBulk {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *LinkContentUpsertBulk) UpdateText() *LinkContentUpsertBulk {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.UpdateText()
+	})
+}
+
+// SetWordCount sets the "word_count" field.
+func (u *LinkContentUpsertBulk) SetWordCount(v int) *LinkContentUpsertBulk {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.SetWordCount(v)
+	})
+}
+
+// AddWordCount adds v to the "word_count" field.
+func (u *LinkContentUpsertBulk) AddWordCount(v int) *LinkContentUpsertBulk {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.AddWordCount(v)
+	})
+}
+
+// UpdateWordCount sets the "word_count" field to the value that was provided on create.
+func (u *LinkContentUpsertBulk) UpdateWordCount() *LinkContentUpsertBulk {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.UpdateWordCount()
+	})
+}
+
+// SetReadingMinutes sets the "reading_minutes" field.
+func (u *LinkContentUpsertBulk) SetReadingMinutes(v int) *LinkContentUpsertBulk {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.SetReadingMinutes(v)
+	})
+}
+
+// AddReadingMinutes adds v to the "reading_minutes" field.
+func (u *LinkContentUpsertBulk) AddReadingMinutes(v int) *LinkContentUpsertBulk {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.AddReadingMinutes(v)
+	})
+}
+
+// UpdateReadingMinutes sets the "reading_minutes" field to the value that was provided on create.
+func (u *LinkContentUpsertBulk) UpdateReadingMinutes() *LinkContentUpsertBulk {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.UpdateReadingMinutes()
+	})
+}
+
+// SetExtractedAt sets the "extracted_at" field.
+func (u *LinkContentUpsertBulk) SetExtractedAt(v time.Time) *LinkContentUpsertBulk {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.SetExtractedAt(v)
+	})
+}
+
+// UpdateExtractedAt sets the "extracted_at" field to the value that was provided on create.
+func (u *LinkContentUpsertBulk) UpdateExtractedAt() *LinkContentUpsertBulk {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.UpdateExtractedAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *LinkContentUpsertBulk) SetCreatedAt(v time.Time) *LinkContentUpsertBulk {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *LinkContentUpsertBulk) UpdateCreatedAt() *LinkContentUpsertBulk {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LinkContentUpsertBulk) SetUpdatedAt(v time.Time) *LinkContentUpsertBulk {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LinkContentUpsertBulk) UpdateUpdatedAt() *LinkContentUpsertBulk {
+	return u.Update(func(s *LinkContentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LinkContentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LinkContentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LinkContentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LinkContentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,554 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lvncer/quicklinks/api/ent/link"
+	"github.com/lvncer/quicklinks/api/ent/linkcontent"
+	"github.com/lvncer/quicklinks/api/ent/predicate"
+)
+
+// LinkContentUpdate is the builder for updating LinkContent entities.
+type LinkContentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LinkContentMutation
+}
+
+// Where appends a list predicates to the LinkContentUpdate builder.
+func (_u *LinkContentUpdate) Where(ps ...predicate.LinkContent) *LinkContentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetLinkID sets the "link_id" field.
+func (_u *LinkContentUpdate) SetLinkID(v uuid.UUID) *LinkContentUpdate {
+	_u.mutation.SetLinkID(v)
+	return _u
+}
+
+// SetNillableLinkID sets the "link_id" field if the given value is not nil.
+func (_u *LinkContentUpdate) SetNillableLinkID(v *uuid.UUID) *LinkContentUpdate {
+	if v != nil {
+		_u.SetLinkID(*v)
+	}
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *LinkContentUpdate) SetText(v string) *LinkContentUpdate {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *LinkContentUpdate) SetNillableText(v *string) *LinkContentUpdate {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// SetWordCount sets the "word_count" field.
+func (_u *LinkContentUpdate) SetWordCount(v int) *LinkContentUpdate {
+	_u.mutation.ResetWordCount()
+	_u.mutation.SetWordCount(v)
+	return _u
+}
+
+// SetNillableWordCount sets the "word_count" field if the given value is not nil.
+func (_u *LinkContentUpdate) SetNillableWordCount(v *int) *LinkContentUpdate {
+	if v != nil {
+		_u.SetWordCount(*v)
+	}
+	return _u
+}
+
+// AddWordCount adds value to the "word_count" field.
+func (_u *LinkContentUpdate) AddWordCount(v int) *LinkContentUpdate {
+	_u.mutation.AddWordCount(v)
+	return _u
+}
+
+// SetReadingMinutes sets the "reading_minutes" field.
+func (_u *LinkContentUpdate) SetReadingMinutes(v int) *LinkContentUpdate {
+	_u.mutation.ResetReadingMinutes()
+	_u.mutation.SetReadingMinutes(v)
+	return _u
+}
+
+// SetNillableReadingMinutes sets the "reading_minutes" field if the given value is not nil.
+func (_u *LinkContentUpdate) SetNillableReadingMinutes(v *int) *LinkContentUpdate {
+	if v != nil {
+		_u.SetReadingMinutes(*v)
+	}
+	return _u
+}
+
+// AddReadingMinutes adds value to the "reading_minutes" field.
+func (_u *LinkContentUpdate) AddReadingMinutes(v int) *LinkContentUpdate {
+	_u.mutation.AddReadingMinutes(v)
+	return _u
+}
+
+// SetExtractedAt sets the "extracted_at" field.
+func (_u *LinkContentUpdate) SetExtractedAt(v time.Time) *LinkContentUpdate {
+	_u.mutation.SetExtractedAt(v)
+	return _u
+}
+
+// SetNillableExtractedAt sets the "extracted_at" field if the given value is not nil.
+func (_u *LinkContentUpdate) SetNillableExtractedAt(v *time.Time) *LinkContentUpdate {
+	if v != nil {
+		_u.SetExtractedAt(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *LinkContentUpdate) SetCreatedAt(v time.Time) *LinkContentUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *LinkContentUpdate) SetNillableCreatedAt(v *time.Time) *LinkContentUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LinkContentUpdate) SetUpdatedAt(v time.Time) *LinkContentUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetLink sets the "link" edge to the Link entity.
+func (_u *LinkContentUpdate) SetLink(v *Link) *LinkContentUpdate {
+	return _u.SetLinkID(v.ID)
+}
+
+// Mutation returns the LinkContentMutation object of the builder.
+func (_u *LinkContentUpdate) Mutation() *LinkContentMutation {
+	return _u.mutation
+}
+
+// ClearLink clears the "link" edge to the Link entity.
+func (_u *LinkContentUpdate) ClearLink() *LinkContentUpdate {
+	_u.mutation.ClearLink()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LinkContentUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LinkContentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LinkContentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LinkContentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LinkContentUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := linkcontent.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LinkContentUpdate) check() error {
+	if _u.mutation.LinkCleared() && len(_u.mutation.LinkIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LinkContent.link"`)
+	}
+	return nil
+}
+
+func (_u *LinkContentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(linkcontent.Table, linkcontent.Columns, sqlgraph.NewFieldSpec(linkcontent.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Text(); ok {
+		_spec.SetField(linkcontent.FieldText, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.WordCount(); ok {
+		_spec.SetField(linkcontent.FieldWordCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWordCount(); ok {
+		_spec.AddField(linkcontent.FieldWordCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReadingMinutes(); ok {
+		_spec.SetField(linkcontent.FieldReadingMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReadingMinutes(); ok {
+		_spec.AddField(linkcontent.FieldReadingMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExtractedAt(); ok {
+		_spec.SetField(linkcontent.FieldExtractedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(linkcontent.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(linkcontent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LinkCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   linkcontent.LinkTable,
+			Columns: []string{linkcontent.LinkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(link.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinkIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   linkcontent.LinkTable,
+			Columns: []string{linkcontent.LinkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(link.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{linkcontent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LinkContentUpdateOne is the builder for updating a single LinkContent entity.
+type LinkContentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LinkContentMutation
+}
+
+// SetLinkID sets the "link_id" field.
+func (_u *LinkContentUpdateOne) SetLinkID(v uuid.UUID) *LinkContentUpdateOne {
+	_u.mutation.SetLinkID(v)
+	return _u
+}
+
+// SetNillableLinkID sets the "link_id" field if the given value is not nil.
+func (_u *LinkContentUpdateOne) SetNillableLinkID(v *uuid.UUID) *LinkContentUpdateOne {
+	if v != nil {
+		_u.SetLinkID(*v)
+	}
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *LinkContentUpdateOne) SetText(v string) *LinkContentUpdateOne {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *LinkContentUpdateOne) SetNillableText(v *string) *LinkContentUpdateOne {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// SetWordCount sets the "word_count" field.
+func (_u *LinkContentUpdateOne) SetWordCount(v int) *LinkContentUpdateOne {
+	_u.mutation.ResetWordCount()
+	_u.mutation.SetWordCount(v)
+	return _u
+}
+
+// SetNillableWordCount sets the "word_count" field if the given value is not nil.
+func (_u *LinkContentUpdateOne) SetNillableWordCount(v *int) *LinkContentUpdateOne {
+	if v != nil {
+		_u.SetWordCount(*v)
+	}
+	return _u
+}
+
+// AddWordCount adds value to the "word_count" field.
+func (_u *LinkContentUpdateOne) AddWordCount(v int) *LinkContentUpdateOne {
+	_u.mutation.AddWordCount(v)
+	return _u
+}
+
+// SetReadingMinutes sets the "reading_minutes" field.
+func (_u *LinkContentUpdateOne) SetReadingMinutes(v int) *LinkContentUpdateOne {
+	_u.mutation.ResetReadingMinutes()
+	_u.mutation.SetReadingMinutes(v)
+	return _u
+}
+
+// SetNillableReadingMinutes sets the "reading_minutes" field if the given value is not nil.
+func (_u *LinkContentUpdateOne) SetNillableReadingMinutes(v *int) *LinkContentUpdateOne {
+	if v != nil {
+		_u.SetReadingMinutes(*v)
+	}
+	return _u
+}
+
+// AddReadingMinutes adds value to the "reading_minutes" field.
+func (_u *LinkContentUpdateOne) AddReadingMinutes(v int) *LinkContentUpdateOne {
+	_u.mutation.AddReadingMinutes(v)
+	return _u
+}
+
+// SetExtractedAt sets the "extracted_at" field.
+func (_u *LinkContentUpdateOne) SetExtractedAt(v time.Time) *LinkContentUpdateOne {
+	_u.mutation.SetExtractedAt(v)
+	return _u
+}
+
+// SetNillableExtractedAt sets the "extracted_at" field if the given value is not nil.
+func (_u *LinkContentUpdateOne) SetNillableExtractedAt(v *time.Time) *LinkContentUpdateOne {
+	if v != nil {
+		_u.SetExtractedAt(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *LinkContentUpdateOne) SetCreatedAt(v time.Time) *LinkContentUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *LinkContentUpdateOne) SetNillableCreatedAt(v *time.Time) *LinkContentUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LinkContentUpdateOne) SetUpdatedAt(v time.Time) *LinkContentUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetLink sets the "link" edge to the Link entity.
+func (_u *LinkContentUpdateOne) SetLink(v *Link) *LinkContentUpdateOne {
+	return _u.SetLinkID(v.ID)
+}
+
+// Mutation returns the LinkContentMutation object of the builder.
+func (_u *LinkContentUpdateOne) Mutation() *LinkContentMutation {
+	return _u.mutation
+}
+
+// ClearLink clears the "link" edge to the Link entity.
+func (_u *LinkContentUpdateOne) ClearLink() *LinkContentUpdateOne {
+	_u.mutation.ClearLink()
+	return _u
+}
+
+// Where appends a list predicates to the LinkContentUpdate builder.
+func (_u *LinkContentUpdateOne) Where(ps ...predicate.LinkContent) *LinkContentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LinkContentUpdateOne) Select(field string, fields ...string) *LinkContentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LinkContent entity.
+func (_u *LinkContentUpdateOne) Save(ctx context.Context) (*LinkContent, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LinkContentUpdateOne) SaveX(ctx context.Context) *LinkContent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LinkContentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LinkContentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LinkContentUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := linkcontent.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LinkContentUpdateOne) check() error {
+	if _u.mutation.LinkCleared() && len(_u.mutation.LinkIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LinkContent.link"`)
+	}
+	return nil
+}
+
+func (_u *LinkContentUpdateOne) sqlSave(ctx context.Context) (_node *LinkContent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(linkcontent.Table, linkcontent.Columns, sqlgraph.NewFieldSpec(linkcontent.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LinkContent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, linkcontent.FieldID)
+		for _, f := range fields {
+			if !linkcontent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != linkcontent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Text(); ok {
+		_spec.SetField(linkcontent.FieldText, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.WordCount(); ok {
+		_spec.SetField(linkcontent.FieldWordCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWordCount(); ok {
+		_spec.AddField(linkcontent.FieldWordCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReadingMinutes(); ok {
+		_spec.SetField(linkcontent.FieldReadingMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReadingMinutes(); ok {
+		_spec.AddField(linkcontent.FieldReadingMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExtractedAt(); ok {
+		_spec.SetField(linkcontent.FieldExtractedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(linkcontent.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(linkcontent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LinkCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   linkcontent.LinkTable,
+			Columns: []string{linkcontent.LinkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(link.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinkIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   linkcontent.LinkTable,
+			Columns: []string{linkcontent.LinkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(link.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LinkContent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{linkcontent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,810 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lvncer/quicklinks/api/ent/metadatacache"
+)
+
+// MetadataCacheCreate is the builder for creating a MetadataCache entity.
+type MetadataCacheCreate struct {
+	config
+	mutation *MetadataCacheMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCanonicalURL sets the "canonical_url" field.
+func (_c *MetadataCacheCreate) SetCanonicalURL(v string) *MetadataCacheCreate {
+	_c.mutation.SetCanonicalURL(v)
+	return _c
+}
+
+// SetData sets the "data" field.
+func (_c *MetadataCacheCreate) SetData(v map[string]interface{}) *MetadataCacheCreate {
+	_c.mutation.SetData(v)
+	return _c
+}
+
+// SetNegative sets the "negative" field.
+func (_c *MetadataCacheCreate) SetNegative(v bool) *MetadataCacheCreate {
+	_c.mutation.SetNegative(v)
+	return _c
+}
+
+// SetNillableNegative sets the "negative" field if the given value is not nil.
+func (_c *MetadataCacheCreate) SetNillableNegative(v *bool) *MetadataCacheCreate {
+	if v != nil {
+		_c.SetNegative(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *MetadataCacheCreate) SetExpiresAt(v time.Time) *MetadataCacheCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MetadataCacheCreate) SetCreatedAt(v time.Time) *MetadataCacheCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MetadataCacheCreate) SetNillableCreatedAt(v *time.Time) *MetadataCacheCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *MetadataCacheCreate) SetUpdatedAt(v time.Time) *MetadataCacheCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *MetadataCacheCreate) SetNillableUpdatedAt(v *time.Time) *MetadataCacheCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MetadataCacheCreate) SetID(v uuid.UUID) *MetadataCacheCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *MetadataCacheCreate) SetNillableID(v *uuid.UUID) *MetadataCacheCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the MetadataCacheMutation object of the builder.
+func (_c *MetadataCacheCreate) Mutation() *MetadataCacheMutation {
+	return _c.mutation
+}
+
+// Save creates the MetadataCache in the database.
+func (_c *MetadataCacheCreate) Save(ctx context.Context) (*MetadataCache, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MetadataCacheCreate) SaveX(ctx context.Context) *MetadataCache {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MetadataCacheCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MetadataCacheCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MetadataCacheCreate) defaults() {
+	if _, ok := _c.mutation.Data(); !ok {
+		v := metadatacache.DefaultData
+		_c.mutation.SetData(v)
+	}
+	if _, ok := _c.mutation.Negative(); !ok {
+		v := metadatacache.DefaultNegative
+		_c.mutation.SetNegative(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := metadatacache.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := metadatacache.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := metadatacache.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MetadataCacheCreate) check() error {
+	if _, ok := _c.mutation.CanonicalURL(); !ok {
+		return &ValidationError{Name: "canonical_url", err: errors.New(`ent: missing required field "MetadataCache.canonical_url"`)}
+	}
+	if v, ok := _c.mutation.CanonicalURL(); ok {
+		if err := metadatacache.CanonicalURLValidator(v); err != nil {
+			return &ValidationError{Name: "canonical_url", err: fmt.Errorf(`ent: validator failed for field "MetadataCache.canonical_url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Negative(); !ok {
+		return &ValidationError{Name: "negative", err: errors.New(`ent: missing required field "MetadataCache.negative"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "MetadataCache.expires_at"`)}
+	}
+	return nil
+}
+
+func (_c *MetadataCacheCreate) sqlSave(ctx context.Context) (*MetadataCache, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MetadataCacheCreate) createSpec() (*MetadataCache, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MetadataCache{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(metadatacache.Table, sqlgraph.NewFieldSpec(metadatacache.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CanonicalURL(); ok {
+		_spec.SetField(metadatacache.FieldCanonicalURL, field.TypeString, value)
+		_node.CanonicalURL = value
+	}
+	if value, ok := _c.mutation.Data(); ok {
+		_spec.SetField(metadatacache.FieldData, field.TypeJSON, value)
+		_node.Data = value
+	}
+	if value, ok := _c.mutation.Negative(); ok {
+		_spec.SetField(metadatacache.FieldNegative, field.TypeBool, value)
+		_node.Negative = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(metadatacache.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(metadatacache.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(metadatacache.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MetadataCache.Create().
+//		SetCanonicalURL(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MetadataCacheUpsert) {
+//			SetCanonicalURL(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MetadataCacheCreate) OnConflict(opts ...sql.ConflictOption) *MetadataCacheUpsertOne {
+	_c.conflict = opts
+	return &MetadataCacheUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MetadataCache.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MetadataCacheCreate) OnConflictColumns(columns ...string) *MetadataCacheUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MetadataCacheUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// MetadataCacheUpsertOne is the builder for "upsert"-ing
+	//  one MetadataCache node.
+	MetadataCacheUpsertOne struct {
+		create *MetadataCacheCreate
+	}
+
+	// MetadataCacheUpsert is the "OnConflict" setter.
+	MetadataCacheUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCanonicalURL sets the "canonical_url" field.
+func (u *MetadataCacheUpsert) SetCanonicalURL(v string) *MetadataCacheUpsert {
+	u.Set(metadatacache.FieldCanonicalURL, v)
+	return u
+}
+
+// UpdateCanonicalURL sets the "canonical_url" field to the value that was provided on create.
+func (u *MetadataCacheUpsert) UpdateCanonicalURL() *MetadataCacheUpsert {
+	u.SetExcluded(metadatacache.FieldCanonicalURL)
+	return u
+}
+
+// SetData sets the "data" field.
+func (u *MetadataCacheUpsert) SetData(v map[string]interface{}) *MetadataCacheUpsert {
+	u.Set(metadatacache.FieldData, v)
+	return u
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *MetadataCacheUpsert) UpdateData() *MetadataCacheUpsert {
+	u.SetExcluded(metadatacache.FieldData)
+	return u
+}
+
+// SetNegative sets the "negative" field.
+func (u *MetadataCacheUpsert) SetNegative(v bool) *MetadataCacheUpsert {
+	u.Set(metadatacache.FieldNegative, v)
+	return u
+}
+
+// UpdateNegative sets the "negative" field to the value that was provided on create.
+func (u *MetadataCacheUpsert) UpdateNegative() *MetadataCacheUpsert {
+	u.SetExcluded(metadatacache.FieldNegative)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *MetadataCacheUpsert) SetExpiresAt(v time.Time) *MetadataCacheUpsert {
+	u.Set(metadatacache.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *MetadataCacheUpsert) UpdateExpiresAt() *MetadataCacheUpsert {
+	u.SetExcluded(metadatacache.FieldExpiresAt)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *MetadataCacheUpsert) SetCreatedAt(v time.Time) *MetadataCacheUpsert {
+	u.Set(metadatacache.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *MetadataCacheUpsert) UpdateCreatedAt() *MetadataCacheUpsert {
+	u.SetExcluded(metadatacache.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MetadataCacheUpsert) SetUpdatedAt(v time.Time) *MetadataCacheUpsert {
+	u.Set(metadatacache.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MetadataCacheUpsert) UpdateUpdatedAt() *MetadataCacheUpsert {
+	u.SetExcluded(metadatacache.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MetadataCache.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(metadatacache.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MetadataCacheUpsertOne) UpdateNewValues() *MetadataCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(metadatacache.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MetadataCache.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MetadataCacheUpsertOne) Ignore() *MetadataCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MetadataCacheUpsertOne) DoNothing() *MetadataCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MetadataCacheCreate.OnConflict
+// documentation for more info.
+func (u *MetadataCacheUpsertOne) Update(set func(*MetadataCacheUpsert)) *MetadataCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MetadataCacheUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCanonicalURL sets the "canonical_url" field.
+func (u *MetadataCacheUpsertOne) SetCanonicalURL(v string) *MetadataCacheUpsertOne {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.SetCanonicalURL(v)
+	})
+}
+
+// UpdateCanonicalURL sets the "canonical_url" field to the value that was provided on create.
+func (u *MetadataCacheUpsertOne) UpdateCanonicalURL() *MetadataCacheUpsertOne {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.UpdateCanonicalURL()
+	})
+}
+
+// SetData sets the "data" field.
+func (u *MetadataCacheUpsertOne) SetData(v map[string]interface{}) *MetadataCacheUpsertOne {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.SetData(v)
+	})
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *MetadataCacheUpsertOne) UpdateData() *MetadataCacheUpsertOne {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.UpdateData()
+	})
+}
+
+// SetNegative sets the "negative" field.
+func (u *MetadataCacheUpsertOne) SetNegative(v bool) *MetadataCacheUpsertOne {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.SetNegative(v)
+	})
+}
+
+// UpdateNegative sets the "negative" field to the value that was provided on create.
+func (u *MetadataCacheUpsertOne) UpdateNegative() *MetadataCacheUpsertOne {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.UpdateNegative()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *MetadataCacheUpsertOne) SetExpiresAt(v time.Time) *MetadataCacheUpsertOne {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *MetadataCacheUpsertOne) UpdateExpiresAt() *MetadataCacheUpsertOne {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *MetadataCacheUpsertOne) SetCreatedAt(v time.Time) *MetadataCacheUpsertOne {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *MetadataCacheUpsertOne) UpdateCreatedAt() *MetadataCacheUpsertOne {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MetadataCacheUpsertOne) SetUpdatedAt(v time.Time) *MetadataCacheUpsertOne {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MetadataCacheUpsertOne) UpdateUpdatedAt() *MetadataCacheUpsertOne {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MetadataCacheUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MetadataCacheCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MetadataCacheUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MetadataCacheUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MetadataCacheUpsertOne.ID is not supported by MySQL driver. Use MetadataCacheUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MetadataCacheUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MetadataCacheCreateBulk is the builder for creating many MetadataCache entities in bulk.
+type MetadataCacheCreateBulk struct {
+	config
+	err      error
+	builders []*MetadataCacheCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MetadataCache entities in the database.
+func (_c *MetadataCacheCreateBulk) Save(ctx context.Context) ([]*MetadataCache, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MetadataCache, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MetadataCacheMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MetadataCacheCreateBulk) SaveX(ctx context.Context) []*MetadataCache {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MetadataCacheCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MetadataCacheCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MetadataCache.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MetadataCacheUpsert) {
+//			SetCanonicalURL(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MetadataCacheCreateBulk) OnConflict(opts ...sql.ConflictOption) *MetadataCacheUpsertBulk {
+	_c.conflict = opts
+	return &MetadataCacheUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MetadataCache.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MetadataCacheCreateBulk) OnConflictColumns(columns ...string) *MetadataCacheUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MetadataCacheUpsertBulk{
+		create: _c,
+	}
+}
+
+// MetadataCacheUpsertBulk is the builder for "upsert"-ing
+// a bulk of MetadataCache nodes.
+type MetadataCacheUpsertBulk struct {
+	create *MetadataCacheCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MetadataCache.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(metadatacache.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MetadataCacheUpsertBulk) UpdateNewValues() *MetadataCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(metadatacache.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MetadataCache.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MetadataCacheUpsertBulk) Ignore() *MetadataCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MetadataCacheUpsertBulk) DoNothing() *MetadataCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MetadataCacheCreateBulk.OnConflict
+// documentation for more info.
+func (u *MetadataCacheUpsertBulk) Update(set func(*MetadataCacheUpsert)) *MetadataCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MetadataCacheUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCanonicalURL sets the "canonical_url" field.
+func (u *MetadataCacheUpsertBulk) SetCanonicalURL(v string) *MetadataCacheUpsertBulk {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.SetCanonicalURL(v)
+	})
+}
+
+// UpdateCanonicalURL sets the "canonical_url" field to the value that was provided on create.
+func (u *MetadataCacheUpsertBulk) UpdateCanonicalURL() *MetadataCacheUpsertBulk {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.UpdateCanonicalURL()
+	})
+}
+
+// SetData sets the "data" field.
+func (u *MetadataCacheUpsertBulk) SetData(v map[string]interface{}) *MetadataCacheUpsertBulk {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.SetData(v)
+	})
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *MetadataCacheUpsertBulk) UpdateData() *MetadataCacheUpsertBulk {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.UpdateData()
+	})
+}
+
+// SetNegative sets the "negative" field.
+func (u *MetadataCacheUpsertBulk) SetNegative(v bool) *MetadataCacheUpsertBulk {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.SetNegative(v)
+	})
+}
+
+// UpdateNegative sets the "negative" field to the value that was provided on create.
+func (u *MetadataCacheUpsertBulk) UpdateNegative() *MetadataCacheUpsertBulk {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.UpdateNegative()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *MetadataCacheUpsertBulk) SetExpiresAt(v time.Time) *MetadataCacheUpsertBulk {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *MetadataCacheUpsertBulk) UpdateExpiresAt() *MetadataCacheUpsertBulk {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *MetadataCacheUpsertBulk) SetCreatedAt(v time.Time) *MetadataCacheUpsertBulk {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *MetadataCacheUpsertBulk) UpdateCreatedAt() *MetadataCacheUpsertBulk {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MetadataCacheUpsertBulk) SetUpdatedAt(v time.Time) *MetadataCacheUpsertBulk {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MetadataCacheUpsertBulk) UpdateUpdatedAt() *MetadataCacheUpsertBulk {
+	return u.Update(func(s *MetadataCacheUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MetadataCacheUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MetadataCacheCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MetadataCacheCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MetadataCacheUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
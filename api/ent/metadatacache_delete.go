@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lvncer/quicklinks/api/ent/metadatacache"
+	"github.com/lvncer/quicklinks/api/ent/predicate"
+)
+
+// MetadataCacheDelete is the builder for deleting a MetadataCache entity.
+type MetadataCacheDelete struct {
+	config
+	hooks    []Hook
+	mutation *MetadataCacheMutation
+}
+
+// Where appends a list predicates to the MetadataCacheDelete builder.
+func (_d *MetadataCacheDelete) Where(ps ...predicate.MetadataCache) *MetadataCacheDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *MetadataCacheDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *MetadataCacheDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *MetadataCacheDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(metadatacache.Table, sqlgraph.NewFieldSpec(metadatacache.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// MetadataCacheDeleteOne is the builder for deleting a single MetadataCache entity.
+type MetadataCacheDeleteOne struct {
+	_d *MetadataCacheDelete
+}
+
+// Where appends a list predicates to the MetadataCacheDelete builder.
+func (_d *MetadataCacheDeleteOne) Where(ps ...predicate.MetadataCache) *MetadataCacheDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *MetadataCacheDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{metadatacache.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *MetadataCacheDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,392 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lvncer/quicklinks/api/ent/metadatacache"
+	"github.com/lvncer/quicklinks/api/ent/predicate"
+)
+
+// MetadataCacheUpdate is the builder for updating MetadataCache entities.
+type MetadataCacheUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MetadataCacheMutation
+}
+
+// Where appends a list predicates to the MetadataCacheUpdate builder.
+func (_u *MetadataCacheUpdate) Where(ps ...predicate.MetadataCache) *MetadataCacheUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCanonicalURL sets the "canonical_url" field.
+func (_u *MetadataCacheUpdate) SetCanonicalURL(v string) *MetadataCacheUpdate {
+	_u.mutation.SetCanonicalURL(v)
+	return _u
+}
+
+// SetNillableCanonicalURL sets the "canonical_url" field if the given value is not nil.
+func (_u *MetadataCacheUpdate) SetNillableCanonicalURL(v *string) *MetadataCacheUpdate {
+	if v != nil {
+		_u.SetCanonicalURL(*v)
+	}
+	return _u
+}
+
+// SetData sets the "data" field.
+func (_u *MetadataCacheUpdate) SetData(v map[string]interface{}) *MetadataCacheUpdate {
+	_u.mutation.SetData(v)
+	return _u
+}
+
+// SetNegative sets the "negative" field.
+func (_u *MetadataCacheUpdate) SetNegative(v bool) *MetadataCacheUpdate {
+	_u.mutation.SetNegative(v)
+	return _u
+}
+
+// SetNillableNegative sets the "negative" field if the given value is not nil.
+func (_u *MetadataCacheUpdate) SetNillableNegative(v *bool) *MetadataCacheUpdate {
+	if v != nil {
+		_u.SetNegative(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *MetadataCacheUpdate) SetExpiresAt(v time.Time) *MetadataCacheUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *MetadataCacheUpdate) SetNillableExpiresAt(v *time.Time) *MetadataCacheUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *MetadataCacheUpdate) SetCreatedAt(v time.Time) *MetadataCacheUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *MetadataCacheUpdate) SetNillableCreatedAt(v *time.Time) *MetadataCacheUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MetadataCacheUpdate) SetUpdatedAt(v time.Time) *MetadataCacheUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the MetadataCacheMutation object of the builder.
+func (_u *MetadataCacheUpdate) Mutation() *MetadataCacheMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MetadataCacheUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MetadataCacheUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MetadataCacheUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MetadataCacheUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MetadataCacheUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := metadatacache.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MetadataCacheUpdate) check() error {
+	if v, ok := _u.mutation.CanonicalURL(); ok {
+		if err := metadatacache.CanonicalURLValidator(v); err != nil {
+			return &ValidationError{Name: "canonical_url", err: fmt.Errorf(`ent: validator failed for field "MetadataCache.canonical_url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MetadataCacheUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(metadatacache.Table, metadatacache.Columns, sqlgraph.NewFieldSpec(metadatacache.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CanonicalURL(); ok {
+		_spec.SetField(metadatacache.FieldCanonicalURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Data(); ok {
+		_spec.SetField(metadatacache.FieldData, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Negative(); ok {
+		_spec.SetField(metadatacache.FieldNegative, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(metadatacache.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(metadatacache.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(metadatacache.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{metadatacache.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MetadataCacheUpdateOne is the builder for updating a single MetadataCache entity.
+type MetadataCacheUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MetadataCacheMutation
+}
+
+// SetCanonicalURL sets the "canonical_url" field.
+func (_u *MetadataCacheUpdateOne) SetCanonicalURL(v string) *MetadataCacheUpdateOne {
+	_u.mutation.SetCanonicalURL(v)
+	return _u
+}
+
+// SetNillableCanonicalURL sets the "canonical_url" field if the given value is not nil.
+func (_u *MetadataCacheUpdateOne) SetNillableCanonicalURL(v *string) *MetadataCacheUpdateOne {
+	if v != nil {
+		_u.SetCanonicalURL(*v)
+	}
+	return _u
+}
+
+// SetData sets the "data" field.
+func (_u *MetadataCacheUpdateOne) SetData(v map[string]interface{}) *MetadataCacheUpdateOne {
+	_u.mutation.SetData(v)
+	return _u
+}
+
+// SetNegative sets the "negative" field.
+func (_u *MetadataCacheUpdateOne) SetNegative(v bool) *MetadataCacheUpdateOne {
+	_u.mutation.SetNegative(v)
+	return _u
+}
+
+// SetNillableNegative sets the "negative" field if the given value is not nil.
+func (_u *MetadataCacheUpdateOne) SetNillableNegative(v *bool) *MetadataCacheUpdateOne {
+	if v != nil {
+		_u.SetNegative(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *MetadataCacheUpdateOne) SetExpiresAt(v time.Time) *MetadataCacheUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *MetadataCacheUpdateOne) SetNillableExpiresAt(v *time.Time) *MetadataCacheUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *MetadataCacheUpdateOne) SetCreatedAt(v time.Time) *MetadataCacheUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *MetadataCacheUpdateOne) SetNillableCreatedAt(v *time.Time) *MetadataCacheUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MetadataCacheUpdateOne) SetUpdatedAt(v time.Time) *MetadataCacheUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the MetadataCacheMutation object of the builder.
+func (_u *MetadataCacheUpdateOne) Mutation() *MetadataCacheMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MetadataCacheUpdate builder.
+func (_u *MetadataCacheUpdateOne) Where(ps ...predicate.MetadataCache) *MetadataCacheUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MetadataCacheUpdateOne) Select(field string, fields ...string) *MetadataCacheUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MetadataCache entity.
+func (_u *MetadataCacheUpdateOne) Save(ctx context.Context) (*MetadataCache, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MetadataCacheUpdateOne) SaveX(ctx context.Context) *MetadataCache {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MetadataCacheUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MetadataCacheUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MetadataCacheUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := metadatacache.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MetadataCacheUpdateOne) check() error {
+	if v, ok := _u.mutation.CanonicalURL(); ok {
+		if err := metadatacache.CanonicalURLValidator(v); err != nil {
+			return &ValidationError{Name: "canonical_url", err: fmt.Errorf(`ent: validator failed for field "MetadataCache.canonical_url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MetadataCacheUpdateOne) sqlSave(ctx context.Context) (_node *MetadataCache, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(metadatacache.Table, metadatacache.Columns, sqlgraph.NewFieldSpec(metadatacache.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MetadataCache.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, metadatacache.FieldID)
+		for _, f := range fields {
+			if !metadatacache.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != metadatacache.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CanonicalURL(); ok {
+		_spec.SetField(metadatacache.FieldCanonicalURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Data(); ok {
+		_spec.SetField(metadatacache.FieldData, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Negative(); ok {
+		_spec.SetField(metadatacache.FieldNegative, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(metadatacache.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(metadatacache.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(metadatacache.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &MetadataCache{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{metadatacache.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
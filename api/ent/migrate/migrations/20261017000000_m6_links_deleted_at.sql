@@ -0,0 +1,10 @@
+-- Soft delete for links.
+--
+-- Rows with a non-null `deleted_at` are in the trash. They are hidden from the
+-- default listing and hard-deleted by the API's trash sweeper after the
+-- configured retention period.
+
+-- Modify "links" table
+ALTER TABLE "links" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "idx_links_deleted_at" to table: "links"
+CREATE INDEX "idx_links_deleted_at" ON "links" ("deleted_at");
@@ -0,0 +1,31 @@
+-- Full-text search for links.
+--
+-- `search_vector` is kept up to date by a trigger so that Ent can treat it as a
+-- plain column. The `simple` configuration is used because saved content is a
+-- mix of Japanese and English and we do not want language-specific stemming.
+--
+-- Weights: title (A) > description, note (B) > url (C).
+
+-- Modify "links" table
+ALTER TABLE "links" ADD COLUMN "search_vector" tsvector NULL;
+
+CREATE OR REPLACE FUNCTION links_search_vector_update() RETURNS trigger AS $$
+BEGIN
+  NEW.search_vector :=
+    setweight(to_tsvector('simple', coalesce(NEW.title, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(NEW.description, '')), 'B') ||
+    setweight(to_tsvector('simple', coalesce(NEW.note, '')), 'B') ||
+    setweight(to_tsvector('simple', coalesce(NEW.url, '')), 'C');
+  RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER links_search_vector_trg
+  BEFORE INSERT OR UPDATE OF title, description, note, url ON "links"
+  FOR EACH ROW EXECUTE FUNCTION links_search_vector_update();
+
+-- Backfill existing rows (fires the trigger).
+UPDATE "links" SET "title" = "title";
+
+-- Create index "idx_links_search_vector_gin" to table: "links"
+CREATE INDEX "idx_links_search_vector_gin" ON "links" USING gin ("search_vector");
@@ -0,0 +1,10 @@
+-- Per-user duplicate detection for links.
+--
+-- `canonical_url` is computed by the API on save. Existing rows stay NULL
+-- (NULLs never conflict in a unique index) until backfilled with:
+--   go run ./cmd/backfill-canonical-urls
+
+-- Modify "links" table
+ALTER TABLE "links" ADD COLUMN "canonical_url" text NULL;
+-- Create index "idx_links_user_canonical_url" to table: "links"
+CREATE UNIQUE INDEX "idx_links_user_canonical_url" ON "links" ("user_id", "canonical_url");
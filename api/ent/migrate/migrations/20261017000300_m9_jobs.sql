@@ -0,0 +1,24 @@
+-- Background job queue.
+--
+-- Jobs are claimed by in-process workers with `SELECT ... FOR UPDATE SKIP LOCKED`.
+-- The first job kind is `fetch_metadata`, which fills in a link's title,
+-- description and og_image after it has been saved.
+
+-- Create "jobs" table
+CREATE TABLE "jobs" (
+  "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "kind" text NOT NULL,
+  "link_id" uuid NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'pending',
+  "attempts" bigint NOT NULL DEFAULT 0,
+  "last_error" text NULL,
+  "run_at" timestamptz NOT NULL DEFAULT now(),
+  "locked_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_jobs_status_run_at" to table: "jobs"
+CREATE INDEX "idx_jobs_status_run_at" ON "jobs" ("status", "run_at");
+-- Create index "idx_jobs_link_id" to table: "jobs"
+CREATE INDEX "idx_jobs_link_id" ON "jobs" ("link_id");
@@ -0,0 +1,22 @@
+-- Site identity (name and favicon) per domain.
+--
+-- Resolved by the metadata service and shared by every link on the domain.
+-- Existing links keep "site_id" NULL until their metadata is next fetched.
+
+-- Create "sites" table
+CREATE TABLE "sites" (
+  "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "domain" text NOT NULL,
+  "name" text NULL,
+  "icon_url" text NULL,
+  "fetched_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id")
+);
+-- Create index "sites_domain_key" to table: "sites"
+CREATE UNIQUE INDEX "sites_domain_key" ON "sites" ("domain");
+-- Modify "links" table
+ALTER TABLE "links" ADD COLUMN "site_id" uuid NULL, ADD CONSTRAINT "links_sites_links" FOREIGN KEY ("site_id") REFERENCES "sites" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "idx_links_site_id" to table: "links"
+CREATE INDEX "idx_links_site_id" ON "links" ("site_id");
@@ -0,0 +1,21 @@
+-- Shared metadata cache keyed by canonical URL.
+--
+-- Lets link creation and GET /api/og reuse a recent fetch of the same page
+-- instead of scraping it again. Negative rows record blocked or empty
+-- results with a shorter TTL. Expired rows are swept by the server.
+
+-- Create "metadata_cache" table
+CREATE TABLE "metadata_cache" (
+  "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "canonical_url" text NOT NULL,
+  "data" jsonb NOT NULL DEFAULT '{}'::jsonb,
+  "negative" boolean NOT NULL DEFAULT false,
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id")
+);
+-- Create index "metadata_cache_canonical_url_key" to table: "metadata_cache"
+CREATE UNIQUE INDEX "metadata_cache_canonical_url_key" ON "metadata_cache" ("canonical_url");
+-- Create index "idx_metadata_cache_expires_at" to table: "metadata_cache"
+CREATE INDEX "idx_metadata_cache_expires_at" ON "metadata_cache" ("expires_at");
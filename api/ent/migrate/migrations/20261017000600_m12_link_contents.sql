@@ -0,0 +1,20 @@
+-- Readable main text per link (readability-style extraction).
+--
+-- Filled after each successful metadata fetch and kept when the page later
+-- disappears, so saved links survive link rot. Deleted with the link.
+
+-- Create "link_contents" table
+CREATE TABLE "link_contents" (
+  "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "text" text NOT NULL,
+  "word_count" bigint NOT NULL DEFAULT 0,
+  "reading_minutes" bigint NOT NULL DEFAULT 0,
+  "extracted_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  "link_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "link_contents_links_content" FOREIGN KEY ("link_id") REFERENCES "links" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "link_contents_link_id_key" to table: "link_contents"
+CREATE UNIQUE INDEX "link_contents_link_id_key" ON "link_contents" ("link_id");
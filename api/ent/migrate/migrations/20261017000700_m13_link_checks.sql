@@ -0,0 +1,20 @@
+-- Link health check history.
+--
+-- A periodic worker requests each saved URL and records the HTTP status, the
+-- final URL after redirects and the derived health. The latest row per link
+-- backs the `health` filter of GET /api/links. Deleted with the link.
+
+-- Create "link_checks" table
+CREATE TABLE "link_checks" (
+  "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "status_code" bigint NULL,
+  "final_url" text NULL,
+  "health" character varying NOT NULL,
+  "error" text NULL,
+  "checked_at" timestamptz NOT NULL DEFAULT now(),
+  "link_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "link_checks_links_checks" FOREIGN KEY ("link_id") REFERENCES "links" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_link_checks_link_checked_at" to table: "link_checks"
+CREATE INDEX "idx_link_checks_link_checked_at" ON "link_checks" ("link_id", "checked_at" DESC);
@@ -0,0 +1,19 @@
+-- Job queue integrity.
+--
+-- Enqueue used to check for an active job and then insert, so two concurrent
+-- saves could queue the same job twice. A unique partial index now allows at
+-- most one pending or running job of each kind per link, and inserts use
+-- `ON CONFLICT DO NOTHING`. Jobs are deleted with their link.
+
+-- Drop jobs of links that no longer exist
+DELETE FROM "jobs" WHERE NOT EXISTS (SELECT 1 FROM "links" WHERE "links"."id" = "jobs"."link_id");
+-- Keep only the oldest active job of each kind per link
+DELETE FROM "jobs" AS "j" WHERE "j"."status" IN ('pending', 'running') AND EXISTS (
+  SELECT 1 FROM "jobs" AS "o"
+  WHERE "o"."kind" = "j"."kind" AND "o"."link_id" = "j"."link_id" AND "o"."status" IN ('pending', 'running')
+    AND ("o"."created_at", "o"."id") < ("j"."created_at", "j"."id")
+);
+-- Modify "jobs" table
+ALTER TABLE "jobs" ADD CONSTRAINT "jobs_links_jobs" FOREIGN KEY ("link_id") REFERENCES "links" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Create index "idx_jobs_kind_link_active" to table: "jobs"
+CREATE UNIQUE INDEX "idx_jobs_kind_link_active" ON "jobs" ("kind", "link_id") WHERE ("status" IN ('pending', 'running'));
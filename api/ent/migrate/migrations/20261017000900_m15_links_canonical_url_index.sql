@@ -0,0 +1,9 @@
+-- Cross-user lookup by canonical URL.
+--
+-- Page content is not kept in the shared metadata cache, so a link whose
+-- metadata comes from the cache copies the content of another link to the
+-- same page. idx_links_user_canonical_url leads with user_id and cannot serve
+-- that lookup.
+
+-- Create index "idx_links_canonical_url" to table: "links"
+CREATE INDEX "idx_links_canonical_url" ON "links" ("canonical_url");
@@ -0,0 +1,37 @@
+-- Substring search for Japanese text.
+--
+-- m7 builds `search_vector` with the `simple` configuration, which only splits
+-- on spaces and punctuation. Japanese is written without spaces, so a phrase
+-- such as 「東京の天気予報」 becomes a single lexeme and searching for 「天気」
+-- finds nothing. (The m7 comment claiming `simple` suits mixed content was
+-- wrong for Japanese.)
+--
+-- `search_text` holds the same fields as plain text, kept up to date by the
+-- same trigger, with a pg_trgm index that serves `ILIKE '%term%'`. Queries
+-- containing non-ASCII characters match either way. pg_trgm only indexes
+-- characters the database's LC_CTYPE classifies as letters, so CJK needs a
+-- UTF-8 locale other than C; terms of one or two characters cannot use the
+-- index and scan the user's links instead.
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Modify "links" table
+ALTER TABLE "links" ADD COLUMN "search_text" text NULL;
+
+CREATE OR REPLACE FUNCTION links_search_vector_update() RETURNS trigger AS $$
+BEGIN
+  NEW.search_vector :=
+    setweight(to_tsvector('simple', coalesce(NEW.title, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(NEW.description, '')), 'B') ||
+    setweight(to_tsvector('simple', coalesce(NEW.note, '')), 'B') ||
+    setweight(to_tsvector('simple', coalesce(NEW.url, '')), 'C');
+  NEW.search_text := concat_ws(' ', NEW.title, NEW.description, NEW.note, NEW.url);
+  RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+-- Backfill existing rows (fires the trigger).
+UPDATE "links" SET "title" = "title";
+
+-- Create index "idx_links_search_text_trgm" to table: "links"
+CREATE INDEX "idx_links_search_text_trgm" ON "links" USING gin ("search_text" gin_trgm_ops);
@@ -9,11 +9,59 @@ import (
 )
 
 var (
+	// JobsColumns holds the columns for the "jobs" table.
+	JobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Default: schema.Expr("gen_random_uuid()")},
+		{Name: "kind", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "done", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "run_at", Type: field.TypeTime, Default: schema.Expr("now()")},
+		{Name: "locked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Default: schema.Expr("now()")},
+		{Name: "updated_at", Type: field.TypeTime, Default: schema.Expr("now()")},
+		{Name: "link_id", Type: field.TypeUUID},
+	}
+	// JobsTable holds the schema information for the "jobs" table.
+	JobsTable = &schema.Table{
+		Name:       "jobs",
+		Columns:    JobsColumns,
+		PrimaryKey: []*schema.Column{JobsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "jobs_links_jobs",
+				Columns:    []*schema.Column{JobsColumns[9]},
+				RefColumns: []*schema.Column{LinksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_jobs_status_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[2], JobsColumns[5]},
+			},
+			{
+				Name:    "idx_jobs_link_id",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[9]},
+			},
+			{
+				Name:    "idx_jobs_kind_link_active",
+				Unique:  true,
+				Columns: []*schema.Column{JobsColumns[1], JobsColumns[9]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status IN ('pending', 'running')",
+				},
+			},
+		},
+	}
 	// LinksColumns holds the columns for the "links" table.
 	LinksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Default: schema.Expr("gen_random_uuid()")},
 		{Name: "user_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "url", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "canonical_url", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "title", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "description", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "domain", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
@@ -24,43 +72,204 @@ var (
 		{Name: "metadata", Type: field.TypeJSON, Default: schema.Expr("'{}'::jsonb")},
 		{Name: "saved_at", Type: field.TypeTime, Default: schema.Expr("now()")},
 		{Name: "created_at", Type: field.TypeTime, Default: schema.Expr("now()")},
+		{Name: "search_vector", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "tsvector"}},
+		{Name: "search_text", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "site_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// LinksTable holds the schema information for the "links" table.
 	LinksTable = &schema.Table{
 		Name:       "links",
 		Columns:    LinksColumns,
 		PrimaryKey: []*schema.Column{LinksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "links_sites_links",
+				Columns:    []*schema.Column{LinksColumns[17]},
+				RefColumns: []*schema.Column{SitesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "idx_links_user_saved_at",
 				Unique:  false,
-				Columns: []*schema.Column{LinksColumns[1], LinksColumns[11]},
+				Columns: []*schema.Column{LinksColumns[1], LinksColumns[12]},
 				Annotation: &entsql.IndexAnnotation{
 					DescColumns: map[string]bool{
-						LinksColumns[11].Name: true,
+						LinksColumns[12].Name: true,
 					},
 				},
 			},
+			{
+				Name:    "idx_links_user_canonical_url",
+				Unique:  true,
+				Columns: []*schema.Column{LinksColumns[1], LinksColumns[3]},
+			},
+			{
+				Name:    "idx_links_canonical_url",
+				Unique:  false,
+				Columns: []*schema.Column{LinksColumns[3]},
+			},
 			{
 				Name:    "idx_links_domain",
 				Unique:  false,
-				Columns: []*schema.Column{LinksColumns[5]},
+				Columns: []*schema.Column{LinksColumns[6]},
 			},
 			{
 				Name:    "idx_links_tags_gin",
 				Unique:  false,
-				Columns: []*schema.Column{LinksColumns[9]},
+				Columns: []*schema.Column{LinksColumns[10]},
+				Annotation: &entsql.IndexAnnotation{
+					Type: "GIN",
+				},
+			},
+			{
+				Name:    "idx_links_search_vector_gin",
+				Unique:  false,
+				Columns: []*schema.Column{LinksColumns[14]},
 				Annotation: &entsql.IndexAnnotation{
 					Type: "GIN",
 				},
 			},
+			{
+				Name:    "idx_links_search_text_trgm",
+				Unique:  false,
+				Columns: []*schema.Column{LinksColumns[15]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
+			{
+				Name:    "idx_links_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{LinksColumns[16]},
+			},
+			{
+				Name:    "idx_links_site_id",
+				Unique:  false,
+				Columns: []*schema.Column{LinksColumns[17]},
+			},
+		},
+	}
+	// LinkChecksColumns holds the columns for the "link_checks" table.
+	LinkChecksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Default: schema.Expr("gen_random_uuid()")},
+		{Name: "status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "final_url", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "health", Type: field.TypeEnum, Enums: []string{"ok", "broken", "redirected", "unknown"}},
+		{Name: "error", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "checked_at", Type: field.TypeTime, Default: schema.Expr("now()")},
+		{Name: "link_id", Type: field.TypeUUID},
+	}
+	// LinkChecksTable holds the schema information for the "link_checks" table.
+	LinkChecksTable = &schema.Table{
+		Name:       "link_checks",
+		Columns:    LinkChecksColumns,
+		PrimaryKey: []*schema.Column{LinkChecksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "link_checks_links_checks",
+				Columns:    []*schema.Column{LinkChecksColumns[6]},
+				RefColumns: []*schema.Column{LinksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_link_checks_link_checked_at",
+				Unique:  false,
+				Columns: []*schema.Column{LinkChecksColumns[6], LinkChecksColumns[5]},
+				Annotation: &entsql.IndexAnnotation{
+					DescColumns: map[string]bool{
+						LinkChecksColumns[5].Name: true,
+					},
+				},
+			},
+		},
+	}
+	// LinkContentsColumns holds the columns for the "link_contents" table.
+	LinkContentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Default: schema.Expr("gen_random_uuid()")},
+		{Name: "text", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "word_count", Type: field.TypeInt, Default: 0},
+		{Name: "reading_minutes", Type: field.TypeInt, Default: 0},
+		{Name: "extracted_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime, Default: schema.Expr("now()")},
+		{Name: "updated_at", Type: field.TypeTime, Default: schema.Expr("now()")},
+		{Name: "link_id", Type: field.TypeUUID, Unique: true},
+	}
+	// LinkContentsTable holds the schema information for the "link_contents" table.
+	LinkContentsTable = &schema.Table{
+		Name:       "link_contents",
+		Columns:    LinkContentsColumns,
+		PrimaryKey: []*schema.Column{LinkContentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "link_contents_links_content",
+				Columns:    []*schema.Column{LinkContentsColumns[7]},
+				RefColumns: []*schema.Column{LinksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// MetadataCacheColumns holds the columns for the "metadata_cache" table.
+	MetadataCacheColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Default: schema.Expr("gen_random_uuid()")},
+		{Name: "canonical_url", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "data", Type: field.TypeJSON, Default: schema.Expr("'{}'::jsonb")},
+		{Name: "negative", Type: field.TypeBool, Default: false},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime, Default: schema.Expr("now()")},
+		{Name: "updated_at", Type: field.TypeTime, Default: schema.Expr("now()")},
+	}
+	// MetadataCacheTable holds the schema information for the "metadata_cache" table.
+	MetadataCacheTable = &schema.Table{
+		Name:       "metadata_cache",
+		Columns:    MetadataCacheColumns,
+		PrimaryKey: []*schema.Column{MetadataCacheColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_metadata_cache_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{MetadataCacheColumns[4]},
+			},
 		},
 	}
+	// SitesColumns holds the columns for the "sites" table.
+	SitesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Default: schema.Expr("gen_random_uuid()")},
+		{Name: "domain", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "name", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "icon_url", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "fetched_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Default: schema.Expr("now()")},
+		{Name: "updated_at", Type: field.TypeTime, Default: schema.Expr("now()")},
+	}
+	// SitesTable holds the schema information for the "sites" table.
+	SitesTable = &schema.Table{
+		Name:       "sites",
+		Columns:    SitesColumns,
+		PrimaryKey: []*schema.Column{SitesColumns[0]},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		JobsTable,
 		LinksTable,
+		LinkChecksTable,
+		LinkContentsTable,
+		MetadataCacheTable,
+		SitesTable,
 	}
 )
 
 func init() {
+	JobsTable.ForeignKeys[0].RefTable = LinksTable
+	LinksTable.ForeignKeys[0].RefTable = SitesTable
+	LinkChecksTable.ForeignKeys[0].RefTable = LinksTable
+	LinkContentsTable.ForeignKeys[0].RefTable = LinksTable
+	MetadataCacheTable.Annotation = &entsql.Annotation{
+		Table: "metadata_cache",
+	}
 }
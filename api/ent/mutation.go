@@ -12,8 +12,13 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/lvncer/quicklinks/api/ent/job"
 	"github.com/lvncer/quicklinks/api/ent/link"
+	"github.com/lvncer/quicklinks/api/ent/linkcheck"
+	"github.com/lvncer/quicklinks/api/ent/linkcontent"
+	"github.com/lvncer/quicklinks/api/ent/metadatacache"
 	"github.com/lvncer/quicklinks/api/ent/predicate"
+	"github.com/lvncer/quicklinks/api/ent/site"
 )
 
 const (
@@ -25,45 +30,48 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeLink = "Link"
+	TypeJob           = "Job"
+	TypeLink          = "Link"
+	TypeLinkCheck     = "LinkCheck"
+	TypeLinkContent   = "LinkContent"
+	TypeMetadataCache = "MetadataCache"
+	TypeSite          = "Site"
 )
 
-// LinkMutation represents an operation that mutates the Link nodes in the graph.
-type LinkMutation struct {
+// JobMutation represents an operation that mutates the Job nodes in the graph.
+type JobMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *uuid.UUID
-	user_id       *string
-	url           *string
-	title         *string
-	description   *string
-	domain        *string
-	og_image      *string
-	page_url      *string
-	note          *string
-	tags          *[]string
-	appendtags    []string
-	metadata      *map[string]interface{}
-	saved_at      *time.Time
+	kind          *string
+	status        *job.Status
+	attempts      *int
+	addattempts   *int
+	last_error    *string
+	run_at        *time.Time
+	locked_at     *time.Time
 	created_at    *time.Time
+	updated_at    *time.Time
 	clearedFields map[string]struct{}
+	link          *uuid.UUID
+	clearedlink   bool
 	done          bool
-	oldValue      func(context.Context) (*Link, error)
-	predicates    []predicate.Link
+	oldValue      func(context.Context) (*Job, error)
+	predicates    []predicate.Job
 }
 
-var _ ent.Mutation = (*LinkMutation)(nil)
+var _ ent.Mutation = (*JobMutation)(nil)
 
-// linkOption allows management of the mutation configuration using functional options.
-type linkOption func(*LinkMutation)
+// jobOption allows management of the mutation configuration using functional options.
+type jobOption func(*JobMutation)
 
-// newLinkMutation creates new mutation for the Link entity.
-func newLinkMutation(c config, op Op, opts ...linkOption) *LinkMutation {
-	m := &LinkMutation{
+// newJobMutation creates new mutation for the Job entity.
+func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
+	m := &JobMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLink,
+		typ:           TypeJob,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -72,20 +80,20 @@ func newLinkMutation(c config, op Op, opts ...linkOption) *LinkMutation {
 	return m
 }
 
-// withLinkID sets the ID field of the mutation.
-func withLinkID(id uuid.UUID) linkOption {
-	return func(m *LinkMutation) {
+// withJobID sets the ID field of the mutation.
+func withJobID(id uuid.UUID) jobOption {
+	return func(m *JobMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Link
+			value *Job
 		)
-		m.oldValue = func(ctx context.Context) (*Link, error) {
+		m.oldValue = func(ctx context.Context) (*Job, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Link.Get(ctx, id)
+					value, err = m.Client().Job.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -94,10 +102,10 @@ func withLinkID(id uuid.UUID) linkOption {
 	}
 }
 
-// withLink sets the old Link of the mutation.
-func withLink(node *Link) linkOption {
-	return func(m *LinkMutation) {
-		m.oldValue = func(context.Context) (*Link, error) {
+// withJob sets the old Job of the mutation.
+func withJob(node *Job) jobOption {
+	return func(m *JobMutation) {
+		m.oldValue = func(context.Context) (*Job, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -106,7 +114,7 @@ func withLink(node *Link) linkOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LinkMutation) Client() *Client {
+func (m JobMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -114,7 +122,7 @@ func (m LinkMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m LinkMutation) Tx() (*Tx, error) {
+func (m JobMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -124,14 +132,14 @@ func (m LinkMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Link entities.
-func (m *LinkMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Job entities.
+func (m *JobMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *LinkMutation) ID() (id uuid.UUID, exists bool) {
+func (m *JobMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -142,7 +150,7 @@ func (m *LinkMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *LinkMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *JobMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -151,535 +159,4973 @@ func (m *LinkMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Link.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Job.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *LinkMutation) SetUserID(s string) {
-	m.user_id = &s
+// SetKind sets the "kind" field.
+func (m *JobMutation) SetKind(s string) {
+	m.kind = &s
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *LinkMutation) UserID() (r string, exists bool) {
-	v := m.user_id
+// Kind returns the value of the "kind" field in the mutation.
+func (m *JobMutation) Kind() (r string, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldUserID(ctx context.Context) (v *string, err error) {
+func (m *JobMutation) OldKind(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return oldValue.UserID, nil
-}
-
-// ClearUserID clears the value of the "user_id" field.
-func (m *LinkMutation) ClearUserID() {
-	m.user_id = nil
-	m.clearedFields[link.FieldUserID] = struct{}{}
-}
-
-// UserIDCleared returns if the "user_id" field was cleared in this mutation.
-func (m *LinkMutation) UserIDCleared() bool {
-	_, ok := m.clearedFields[link.FieldUserID]
-	return ok
+	return oldValue.Kind, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *LinkMutation) ResetUserID() {
-	m.user_id = nil
-	delete(m.clearedFields, link.FieldUserID)
+// ResetKind resets all changes to the "kind" field.
+func (m *JobMutation) ResetKind() {
+	m.kind = nil
 }
 
-// SetURL sets the "url" field.
-func (m *LinkMutation) SetURL(s string) {
-	m.url = &s
+// SetLinkID sets the "link_id" field.
+func (m *JobMutation) SetLinkID(u uuid.UUID) {
+	m.link = &u
 }
 
-// URL returns the value of the "url" field in the mutation.
-func (m *LinkMutation) URL() (r string, exists bool) {
-	v := m.url
+// LinkID returns the value of the "link_id" field in the mutation.
+func (m *JobMutation) LinkID() (r uuid.UUID, exists bool) {
+	v := m.link
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldURL returns the old "url" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldLinkID returns the old "link_id" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldURL(ctx context.Context) (v string, err error) {
+func (m *JobMutation) OldLinkID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldLinkID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
+		return v, errors.New("OldLinkID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldLinkID: %w", err)
 	}
-	return oldValue.URL, nil
+	return oldValue.LinkID, nil
 }
 
-// ResetURL resets all changes to the "url" field.
-func (m *LinkMutation) ResetURL() {
-	m.url = nil
+// ResetLinkID resets all changes to the "link_id" field.
+func (m *JobMutation) ResetLinkID() {
+	m.link = nil
 }
 
-// SetTitle sets the "title" field.
-func (m *LinkMutation) SetTitle(s string) {
-	m.title = &s
+// SetStatus sets the "status" field.
+func (m *JobMutation) SetStatus(j job.Status) {
+	m.status = &j
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *LinkMutation) Title() (r string, exists bool) {
-	v := m.title
+// Status returns the value of the "status" field in the mutation.
+func (m *JobMutation) Status() (r job.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldTitle(ctx context.Context) (v *string, err error) {
+func (m *JobMutation) OldStatus(ctx context.Context) (v job.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Title, nil
-}
-
-// ClearTitle clears the value of the "title" field.
-func (m *LinkMutation) ClearTitle() {
-	m.title = nil
-	m.clearedFields[link.FieldTitle] = struct{}{}
-}
-
-// TitleCleared returns if the "title" field was cleared in this mutation.
-func (m *LinkMutation) TitleCleared() bool {
-	_, ok := m.clearedFields[link.FieldTitle]
-	return ok
+	return oldValue.Status, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *LinkMutation) ResetTitle() {
-	m.title = nil
-	delete(m.clearedFields, link.FieldTitle)
+// ResetStatus resets all changes to the "status" field.
+func (m *JobMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *LinkMutation) SetDescription(s string) {
-	m.description = &s
+// SetAttempts sets the "attempts" field.
+func (m *JobMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *LinkMutation) Description() (r string, exists bool) {
-	v := m.description
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *JobMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldDescription(ctx context.Context) (v *string, err error) {
+func (m *JobMutation) OldAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.Attempts, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *LinkMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[link.FieldDescription] = struct{}{}
+// AddAttempts adds i to the "attempts" field.
+func (m *JobMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *LinkMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[link.FieldDescription]
-	return ok
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *JobMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *LinkMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, link.FieldDescription)
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *JobMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// SetDomain sets the "domain" field.
-func (m *LinkMutation) SetDomain(s string) {
-	m.domain = &s
+// SetLastError sets the "last_error" field.
+func (m *JobMutation) SetLastError(s string) {
+	m.last_error = &s
 }
 
-// Domain returns the value of the "domain" field in the mutation.
-func (m *LinkMutation) Domain() (r string, exists bool) {
-	v := m.domain
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *JobMutation) LastError() (r string, exists bool) {
+	v := m.last_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDomain returns the old "domain" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldLastError returns the old "last_error" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldDomain(ctx context.Context) (v *string, err error) {
+func (m *JobMutation) OldLastError(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDomain is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDomain requires an ID field in the mutation")
+		return v, errors.New("OldLastError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDomain: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
 	}
-	return oldValue.Domain, nil
+	return oldValue.LastError, nil
 }
 
-// ClearDomain clears the value of the "domain" field.
-func (m *LinkMutation) ClearDomain() {
-	m.domain = nil
-	m.clearedFields[link.FieldDomain] = struct{}{}
+// ClearLastError clears the value of the "last_error" field.
+func (m *JobMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[job.FieldLastError] = struct{}{}
 }
 
-// DomainCleared returns if the "domain" field was cleared in this mutation.
-func (m *LinkMutation) DomainCleared() bool {
-	_, ok := m.clearedFields[link.FieldDomain]
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *JobMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[job.FieldLastError]
 	return ok
 }
 
-// ResetDomain resets all changes to the "domain" field.
-func (m *LinkMutation) ResetDomain() {
-	m.domain = nil
-	delete(m.clearedFields, link.FieldDomain)
+// ResetLastError resets all changes to the "last_error" field.
+func (m *JobMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, job.FieldLastError)
 }
 
-// SetOgImage sets the "og_image" field.
-func (m *LinkMutation) SetOgImage(s string) {
-	m.og_image = &s
+// SetRunAt sets the "run_at" field.
+func (m *JobMutation) SetRunAt(t time.Time) {
+	m.run_at = &t
 }
 
-// OgImage returns the value of the "og_image" field in the mutation.
-func (m *LinkMutation) OgImage() (r string, exists bool) {
-	v := m.og_image
+// RunAt returns the value of the "run_at" field in the mutation.
+func (m *JobMutation) RunAt() (r time.Time, exists bool) {
+	v := m.run_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOgImage returns the old "og_image" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldRunAt returns the old "run_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldOgImage(ctx context.Context) (v *string, err error) {
+func (m *JobMutation) OldRunAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOgImage is only allowed on UpdateOne operations")
+		return v, errors.New("OldRunAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOgImage requires an ID field in the mutation")
+		return v, errors.New("OldRunAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOgImage: %w", err)
+		return v, fmt.Errorf("querying old value for OldRunAt: %w", err)
 	}
-	return oldValue.OgImage, nil
-}
-
-// ClearOgImage clears the value of the "og_image" field.
-func (m *LinkMutation) ClearOgImage() {
-	m.og_image = nil
-	m.clearedFields[link.FieldOgImage] = struct{}{}
-}
-
-// OgImageCleared returns if the "og_image" field was cleared in this mutation.
-func (m *LinkMutation) OgImageCleared() bool {
-	_, ok := m.clearedFields[link.FieldOgImage]
-	return ok
+	return oldValue.RunAt, nil
 }
 
-// ResetOgImage resets all changes to the "og_image" field.
-func (m *LinkMutation) ResetOgImage() {
-	m.og_image = nil
-	delete(m.clearedFields, link.FieldOgImage)
+// ResetRunAt resets all changes to the "run_at" field.
+func (m *JobMutation) ResetRunAt() {
+	m.run_at = nil
 }
 
-// SetPageURL sets the "page_url" field.
-func (m *LinkMutation) SetPageURL(s string) {
-	m.page_url = &s
+// SetLockedAt sets the "locked_at" field.
+func (m *JobMutation) SetLockedAt(t time.Time) {
+	m.locked_at = &t
 }
 
-// PageURL returns the value of the "page_url" field in the mutation.
-func (m *LinkMutation) PageURL() (r string, exists bool) {
-	v := m.page_url
+// LockedAt returns the value of the "locked_at" field in the mutation.
+func (m *JobMutation) LockedAt() (r time.Time, exists bool) {
+	v := m.locked_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPageURL returns the old "page_url" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldLockedAt returns the old "locked_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldPageURL(ctx context.Context) (v *string, err error) {
+func (m *JobMutation) OldLockedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPageURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldLockedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPageURL requires an ID field in the mutation")
+		return v, errors.New("OldLockedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPageURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldLockedAt: %w", err)
 	}
-	return oldValue.PageURL, nil
+	return oldValue.LockedAt, nil
 }
 
-// ClearPageURL clears the value of the "page_url" field.
-func (m *LinkMutation) ClearPageURL() {
-	m.page_url = nil
-	m.clearedFields[link.FieldPageURL] = struct{}{}
+// ClearLockedAt clears the value of the "locked_at" field.
+func (m *JobMutation) ClearLockedAt() {
+	m.locked_at = nil
+	m.clearedFields[job.FieldLockedAt] = struct{}{}
 }
 
-// PageURLCleared returns if the "page_url" field was cleared in this mutation.
-func (m *LinkMutation) PageURLCleared() bool {
-	_, ok := m.clearedFields[link.FieldPageURL]
+// LockedAtCleared returns if the "locked_at" field was cleared in this mutation.
+func (m *JobMutation) LockedAtCleared() bool {
+	_, ok := m.clearedFields[job.FieldLockedAt]
 	return ok
 }
 
-// ResetPageURL resets all changes to the "page_url" field.
-func (m *LinkMutation) ResetPageURL() {
-	m.page_url = nil
-	delete(m.clearedFields, link.FieldPageURL)
+// ResetLockedAt resets all changes to the "locked_at" field.
+func (m *JobMutation) ResetLockedAt() {
+	m.locked_at = nil
+	delete(m.clearedFields, job.FieldLockedAt)
 }
 
-// SetNote sets the "note" field.
-func (m *LinkMutation) SetNote(s string) {
-	m.note = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *JobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Note returns the value of the "note" field in the mutation.
-func (m *LinkMutation) Note() (r string, exists bool) {
-	v := m.note
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *JobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNote returns the old "note" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldNote(ctx context.Context) (v *string, err error) {
+func (m *JobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNote is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNote requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNote: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Note, nil
-}
-
-// ClearNote clears the value of the "note" field.
-func (m *LinkMutation) ClearNote() {
-	m.note = nil
-	m.clearedFields[link.FieldNote] = struct{}{}
-}
-
-// NoteCleared returns if the "note" field was cleared in this mutation.
-func (m *LinkMutation) NoteCleared() bool {
-	_, ok := m.clearedFields[link.FieldNote]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetNote resets all changes to the "note" field.
-func (m *LinkMutation) ResetNote() {
-	m.note = nil
-	delete(m.clearedFields, link.FieldNote)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *JobMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetTags sets the "tags" field.
-func (m *LinkMutation) SetTags(s []string) {
-	m.tags = &s
-	m.appendtags = nil
+// SetUpdatedAt sets the "updated_at" field.
+func (m *JobMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Tags returns the value of the "tags" field in the mutation.
-func (m *LinkMutation) Tags() (r []string, exists bool) {
-	v := m.tags
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *JobMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTags returns the old "tags" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *JobMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearLink clears the "link" edge to the Link entity.
+func (m *JobMutation) ClearLink() {
+	m.clearedlink = true
+	m.clearedFields[job.FieldLinkID] = struct{}{}
+}
+
+// LinkCleared reports if the "link" edge to the Link entity was cleared.
+func (m *JobMutation) LinkCleared() bool {
+	return m.clearedlink
+}
+
+// LinkIDs returns the "link" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LinkID instead. It exists only for internal usage by the builders.
+func (m *JobMutation) LinkIDs() (ids []uuid.UUID) {
+	if id := m.link; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLink resets all changes to the "link" edge.
+func (m *JobMutation) ResetLink() {
+	m.link = nil
+	m.clearedlink = false
+}
+
+// Where appends a list predicates to the JobMutation builder.
+func (m *JobMutation) Where(ps ...predicate.Job) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Job, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Job).
+func (m *JobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.kind != nil {
+		fields = append(fields, job.FieldKind)
+	}
+	if m.link != nil {
+		fields = append(fields, job.FieldLinkID)
+	}
+	if m.status != nil {
+		fields = append(fields, job.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	if m.last_error != nil {
+		fields = append(fields, job.FieldLastError)
+	}
+	if m.run_at != nil {
+		fields = append(fields, job.FieldRunAt)
+	}
+	if m.locked_at != nil {
+		fields = append(fields, job.FieldLockedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, job.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, job.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldKind:
+		return m.Kind()
+	case job.FieldLinkID:
+		return m.LinkID()
+	case job.FieldStatus:
+		return m.Status()
+	case job.FieldAttempts:
+		return m.Attempts()
+	case job.FieldLastError:
+		return m.LastError()
+	case job.FieldRunAt:
+		return m.RunAt()
+	case job.FieldLockedAt:
+		return m.LockedAt()
+	case job.FieldCreatedAt:
+		return m.CreatedAt()
+	case job.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case job.FieldKind:
+		return m.OldKind(ctx)
+	case job.FieldLinkID:
+		return m.OldLinkID(ctx)
+	case job.FieldStatus:
+		return m.OldStatus(ctx)
+	case job.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case job.FieldLastError:
+		return m.OldLastError(ctx)
+	case job.FieldRunAt:
+		return m.OldRunAt(ctx)
+	case job.FieldLockedAt:
+		return m.OldLockedAt(ctx)
+	case job.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case job.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Job field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case job.FieldLinkID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLinkID(v)
+		return nil
+	case job.FieldStatus:
+		v, ok := value.(job.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case job.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case job.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case job.FieldRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunAt(v)
+		return nil
+	case job.FieldLockedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedAt(v)
+		return nil
+	case job.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case job.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(job.FieldLastError) {
+		fields = append(fields, job.FieldLastError)
+	}
+	if m.FieldCleared(job.FieldLockedAt) {
+		fields = append(fields, job.FieldLockedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobMutation) ClearField(name string) error {
+	switch name {
+	case job.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case job.FieldLockedAt:
+		m.ClearLockedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Job nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobMutation) ResetField(name string) error {
+	switch name {
+	case job.FieldKind:
+		m.ResetKind()
+		return nil
+	case job.FieldLinkID:
+		m.ResetLinkID()
+		return nil
+	case job.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case job.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case job.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case job.FieldRunAt:
+		m.ResetRunAt()
+		return nil
+	case job.FieldLockedAt:
+		m.ResetLockedAt()
+		return nil
+	case job.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case job.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.link != nil {
+		edges = append(edges, job.EdgeLink)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case job.EdgeLink:
+		if id := m.link; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedlink {
+		edges = append(edges, job.EdgeLink)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobMutation) EdgeCleared(name string) bool {
+	switch name {
+	case job.EdgeLink:
+		return m.clearedlink
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobMutation) ClearEdge(name string) error {
+	switch name {
+	case job.EdgeLink:
+		m.ClearLink()
+		return nil
+	}
+	return fmt.Errorf("unknown Job unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobMutation) ResetEdge(name string) error {
+	switch name {
+	case job.EdgeLink:
+		m.ResetLink()
+		return nil
+	}
+	return fmt.Errorf("unknown Job edge %s", name)
+}
+
+// LinkMutation represents an operation that mutates the Link nodes in the graph.
+type LinkMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	user_id        *string
+	url            *string
+	canonical_url  *string
+	title          *string
+	description    *string
+	domain         *string
+	og_image       *string
+	page_url       *string
+	note           *string
+	tags           *[]string
+	appendtags     []string
+	metadata       *map[string]interface{}
+	saved_at       *time.Time
+	created_at     *time.Time
+	search_vector  *string
+	search_text    *string
+	deleted_at     *time.Time
+	clearedFields  map[string]struct{}
+	site           *uuid.UUID
+	clearedsite    bool
+	content        *uuid.UUID
+	clearedcontent bool
+	checks         map[uuid.UUID]struct{}
+	removedchecks  map[uuid.UUID]struct{}
+	clearedchecks  bool
+	jobs           map[uuid.UUID]struct{}
+	removedjobs    map[uuid.UUID]struct{}
+	clearedjobs    bool
+	done           bool
+	oldValue       func(context.Context) (*Link, error)
+	predicates     []predicate.Link
+}
+
+var _ ent.Mutation = (*LinkMutation)(nil)
+
+// linkOption allows management of the mutation configuration using functional options.
+type linkOption func(*LinkMutation)
+
+// newLinkMutation creates new mutation for the Link entity.
+func newLinkMutation(c config, op Op, opts ...linkOption) *LinkMutation {
+	m := &LinkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLink,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLinkID sets the ID field of the mutation.
+func withLinkID(id uuid.UUID) linkOption {
+	return func(m *LinkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Link
+		)
+		m.oldValue = func(ctx context.Context) (*Link, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Link.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLink sets the old Link of the mutation.
+func withLink(node *Link) linkOption {
+	return func(m *LinkMutation) {
+		m.oldValue = func(context.Context) (*Link, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LinkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LinkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Link entities.
+func (m *LinkMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LinkMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LinkMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Link.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *LinkMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *LinkMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldUserID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *LinkMutation) ClearUserID() {
+	m.user_id = nil
+	m.clearedFields[link.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *LinkMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[link.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *LinkMutation) ResetUserID() {
+	m.user_id = nil
+	delete(m.clearedFields, link.FieldUserID)
+}
+
+// SetURL sets the "url" field.
+func (m *LinkMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *LinkMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *LinkMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetCanonicalURL sets the "canonical_url" field.
+func (m *LinkMutation) SetCanonicalURL(s string) {
+	m.canonical_url = &s
+}
+
+// CanonicalURL returns the value of the "canonical_url" field in the mutation.
+func (m *LinkMutation) CanonicalURL() (r string, exists bool) {
+	v := m.canonical_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCanonicalURL returns the old "canonical_url" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldCanonicalURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCanonicalURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCanonicalURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCanonicalURL: %w", err)
+	}
+	return oldValue.CanonicalURL, nil
+}
+
+// ClearCanonicalURL clears the value of the "canonical_url" field.
+func (m *LinkMutation) ClearCanonicalURL() {
+	m.canonical_url = nil
+	m.clearedFields[link.FieldCanonicalURL] = struct{}{}
+}
+
+// CanonicalURLCleared returns if the "canonical_url" field was cleared in this mutation.
+func (m *LinkMutation) CanonicalURLCleared() bool {
+	_, ok := m.clearedFields[link.FieldCanonicalURL]
+	return ok
+}
+
+// ResetCanonicalURL resets all changes to the "canonical_url" field.
+func (m *LinkMutation) ResetCanonicalURL() {
+	m.canonical_url = nil
+	delete(m.clearedFields, link.FieldCanonicalURL)
+}
+
+// SetTitle sets the "title" field.
+func (m *LinkMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *LinkMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldTitle(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ClearTitle clears the value of the "title" field.
+func (m *LinkMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[link.FieldTitle] = struct{}{}
+}
+
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *LinkMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[link.FieldTitle]
+	return ok
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *LinkMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, link.FieldTitle)
+}
+
+// SetDescription sets the "description" field.
+func (m *LinkMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *LinkMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *LinkMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[link.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *LinkMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[link.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *LinkMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, link.FieldDescription)
+}
+
+// SetDomain sets the "domain" field.
+func (m *LinkMutation) SetDomain(s string) {
+	m.domain = &s
+}
+
+// Domain returns the value of the "domain" field in the mutation.
+func (m *LinkMutation) Domain() (r string, exists bool) {
+	v := m.domain
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDomain returns the old "domain" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldDomain(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDomain is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDomain requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDomain: %w", err)
+	}
+	return oldValue.Domain, nil
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (m *LinkMutation) ClearDomain() {
+	m.domain = nil
+	m.clearedFields[link.FieldDomain] = struct{}{}
+}
+
+// DomainCleared returns if the "domain" field was cleared in this mutation.
+func (m *LinkMutation) DomainCleared() bool {
+	_, ok := m.clearedFields[link.FieldDomain]
+	return ok
+}
+
+// ResetDomain resets all changes to the "domain" field.
+func (m *LinkMutation) ResetDomain() {
+	m.domain = nil
+	delete(m.clearedFields, link.FieldDomain)
+}
+
+// SetOgImage sets the "og_image" field.
+func (m *LinkMutation) SetOgImage(s string) {
+	m.og_image = &s
+}
+
+// OgImage returns the value of the "og_image" field in the mutation.
+func (m *LinkMutation) OgImage() (r string, exists bool) {
+	v := m.og_image
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOgImage returns the old "og_image" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldOgImage(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOgImage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOgImage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOgImage: %w", err)
+	}
+	return oldValue.OgImage, nil
+}
+
+// ClearOgImage clears the value of the "og_image" field.
+func (m *LinkMutation) ClearOgImage() {
+	m.og_image = nil
+	m.clearedFields[link.FieldOgImage] = struct{}{}
+}
+
+// OgImageCleared returns if the "og_image" field was cleared in this mutation.
+func (m *LinkMutation) OgImageCleared() bool {
+	_, ok := m.clearedFields[link.FieldOgImage]
+	return ok
+}
+
+// ResetOgImage resets all changes to the "og_image" field.
+func (m *LinkMutation) ResetOgImage() {
+	m.og_image = nil
+	delete(m.clearedFields, link.FieldOgImage)
+}
+
+// SetPageURL sets the "page_url" field.
+func (m *LinkMutation) SetPageURL(s string) {
+	m.page_url = &s
+}
+
+// PageURL returns the value of the "page_url" field in the mutation.
+func (m *LinkMutation) PageURL() (r string, exists bool) {
+	v := m.page_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPageURL returns the old "page_url" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldPageURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPageURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPageURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPageURL: %w", err)
+	}
+	return oldValue.PageURL, nil
+}
+
+// ClearPageURL clears the value of the "page_url" field.
+func (m *LinkMutation) ClearPageURL() {
+	m.page_url = nil
+	m.clearedFields[link.FieldPageURL] = struct{}{}
+}
+
+// PageURLCleared returns if the "page_url" field was cleared in this mutation.
+func (m *LinkMutation) PageURLCleared() bool {
+	_, ok := m.clearedFields[link.FieldPageURL]
+	return ok
+}
+
+// ResetPageURL resets all changes to the "page_url" field.
+func (m *LinkMutation) ResetPageURL() {
+	m.page_url = nil
+	delete(m.clearedFields, link.FieldPageURL)
+}
+
+// SetNote sets the "note" field.
+func (m *LinkMutation) SetNote(s string) {
+	m.note = &s
+}
+
+// Note returns the value of the "note" field in the mutation.
+func (m *LinkMutation) Note() (r string, exists bool) {
+	v := m.note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNote returns the old "note" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldNote(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
+	}
+	return oldValue.Note, nil
+}
+
+// ClearNote clears the value of the "note" field.
+func (m *LinkMutation) ClearNote() {
+	m.note = nil
+	m.clearedFields[link.FieldNote] = struct{}{}
+}
+
+// NoteCleared returns if the "note" field was cleared in this mutation.
+func (m *LinkMutation) NoteCleared() bool {
+	_, ok := m.clearedFields[link.FieldNote]
+	return ok
+}
+
+// ResetNote resets all changes to the "note" field.
+func (m *LinkMutation) ResetNote() {
+	m.note = nil
+	delete(m.clearedFields, link.FieldNote)
+}
+
+// SetTags sets the "tags" field.
+func (m *LinkMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *LinkMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
 func (m *LinkMutation) OldTags(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// AppendTags adds s to the "tags" field.
+func (m *LinkMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *LinkMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *LinkMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[link.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *LinkMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[link.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *LinkMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, link.FieldTags)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *LinkMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *LinkMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *LinkMutation) ResetMetadata() {
+	m.metadata = nil
+}
+
+// SetSavedAt sets the "saved_at" field.
+func (m *LinkMutation) SetSavedAt(t time.Time) {
+	m.saved_at = &t
+}
+
+// SavedAt returns the value of the "saved_at" field in the mutation.
+func (m *LinkMutation) SavedAt() (r time.Time, exists bool) {
+	v := m.saved_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSavedAt returns the old "saved_at" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldSavedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSavedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSavedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSavedAt: %w", err)
+	}
+	return oldValue.SavedAt, nil
+}
+
+// ResetSavedAt resets all changes to the "saved_at" field.
+func (m *LinkMutation) ResetSavedAt() {
+	m.saved_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LinkMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LinkMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LinkMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetSearchVector sets the "search_vector" field.
+func (m *LinkMutation) SetSearchVector(s string) {
+	m.search_vector = &s
+}
+
+// SearchVector returns the value of the "search_vector" field in the mutation.
+func (m *LinkMutation) SearchVector() (r string, exists bool) {
+	v := m.search_vector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSearchVector returns the old "search_vector" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldSearchVector(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSearchVector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSearchVector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSearchVector: %w", err)
+	}
+	return oldValue.SearchVector, nil
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (m *LinkMutation) ClearSearchVector() {
+	m.search_vector = nil
+	m.clearedFields[link.FieldSearchVector] = struct{}{}
+}
+
+// SearchVectorCleared returns if the "search_vector" field was cleared in this mutation.
+func (m *LinkMutation) SearchVectorCleared() bool {
+	_, ok := m.clearedFields[link.FieldSearchVector]
+	return ok
+}
+
+// ResetSearchVector resets all changes to the "search_vector" field.
+func (m *LinkMutation) ResetSearchVector() {
+	m.search_vector = nil
+	delete(m.clearedFields, link.FieldSearchVector)
+}
+
+// SetSearchText sets the "search_text" field.
+func (m *LinkMutation) SetSearchText(s string) {
+	m.search_text = &s
+}
+
+// SearchText returns the value of the "search_text" field in the mutation.
+func (m *LinkMutation) SearchText() (r string, exists bool) {
+	v := m.search_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSearchText returns the old "search_text" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldSearchText(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSearchText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSearchText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSearchText: %w", err)
+	}
+	return oldValue.SearchText, nil
+}
+
+// ClearSearchText clears the value of the "search_text" field.
+func (m *LinkMutation) ClearSearchText() {
+	m.search_text = nil
+	m.clearedFields[link.FieldSearchText] = struct{}{}
+}
+
+// SearchTextCleared returns if the "search_text" field was cleared in this mutation.
+func (m *LinkMutation) SearchTextCleared() bool {
+	_, ok := m.clearedFields[link.FieldSearchText]
+	return ok
+}
+
+// ResetSearchText resets all changes to the "search_text" field.
+func (m *LinkMutation) ResetSearchText() {
+	m.search_text = nil
+	delete(m.clearedFields, link.FieldSearchText)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *LinkMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *LinkMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *LinkMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[link.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *LinkMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[link.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *LinkMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, link.FieldDeletedAt)
+}
+
+// SetSiteID sets the "site_id" field.
+func (m *LinkMutation) SetSiteID(u uuid.UUID) {
+	m.site = &u
+}
+
+// SiteID returns the value of the "site_id" field in the mutation.
+func (m *LinkMutation) SiteID() (r uuid.UUID, exists bool) {
+	v := m.site
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSiteID returns the old "site_id" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldSiteID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSiteID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSiteID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSiteID: %w", err)
+	}
+	return oldValue.SiteID, nil
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (m *LinkMutation) ClearSiteID() {
+	m.site = nil
+	m.clearedFields[link.FieldSiteID] = struct{}{}
+}
+
+// SiteIDCleared returns if the "site_id" field was cleared in this mutation.
+func (m *LinkMutation) SiteIDCleared() bool {
+	_, ok := m.clearedFields[link.FieldSiteID]
+	return ok
+}
+
+// ResetSiteID resets all changes to the "site_id" field.
+func (m *LinkMutation) ResetSiteID() {
+	m.site = nil
+	delete(m.clearedFields, link.FieldSiteID)
+}
+
+// ClearSite clears the "site" edge to the Site entity.
+func (m *LinkMutation) ClearSite() {
+	m.clearedsite = true
+	m.clearedFields[link.FieldSiteID] = struct{}{}
+}
+
+// SiteCleared reports if the "site" edge to the Site entity was cleared.
+func (m *LinkMutation) SiteCleared() bool {
+	return m.SiteIDCleared() || m.clearedsite
+}
+
+// SiteIDs returns the "site" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SiteID instead. It exists only for internal usage by the builders.
+func (m *LinkMutation) SiteIDs() (ids []uuid.UUID) {
+	if id := m.site; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSite resets all changes to the "site" edge.
+func (m *LinkMutation) ResetSite() {
+	m.site = nil
+	m.clearedsite = false
+}
+
+// SetContentID sets the "content" edge to the LinkContent entity by id.
+func (m *LinkMutation) SetContentID(id uuid.UUID) {
+	m.content = &id
+}
+
+// ClearContent clears the "content" edge to the LinkContent entity.
+func (m *LinkMutation) ClearContent() {
+	m.clearedcontent = true
+}
+
+// ContentCleared reports if the "content" edge to the LinkContent entity was cleared.
+func (m *LinkMutation) ContentCleared() bool {
+	return m.clearedcontent
+}
+
+// ContentID returns the "content" edge ID in the mutation.
+func (m *LinkMutation) ContentID() (id uuid.UUID, exists bool) {
+	if m.content != nil {
+		return *m.content, true
+	}
+	return
+}
+
+// ContentIDs returns the "content" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ContentID instead. It exists only for internal usage by the builders.
+func (m *LinkMutation) ContentIDs() (ids []uuid.UUID) {
+	if id := m.content; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetContent resets all changes to the "content" edge.
+func (m *LinkMutation) ResetContent() {
+	m.content = nil
+	m.clearedcontent = false
+}
+
+// AddCheckIDs adds the "checks" edge to the LinkCheck entity by ids.
+func (m *LinkMutation) AddCheckIDs(ids ...uuid.UUID) {
+	if m.checks == nil {
+		m.checks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.checks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChecks clears the "checks" edge to the LinkCheck entity.
+func (m *LinkMutation) ClearChecks() {
+	m.clearedchecks = true
+}
+
+// ChecksCleared reports if the "checks" edge to the LinkCheck entity was cleared.
+func (m *LinkMutation) ChecksCleared() bool {
+	return m.clearedchecks
+}
+
+// RemoveCheckIDs removes the "checks" edge to the LinkCheck entity by IDs.
+func (m *LinkMutation) RemoveCheckIDs(ids ...uuid.UUID) {
+	if m.removedchecks == nil {
+		m.removedchecks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.checks, ids[i])
+		m.removedchecks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChecks returns the removed IDs of the "checks" edge to the LinkCheck entity.
+func (m *LinkMutation) RemovedChecksIDs() (ids []uuid.UUID) {
+	for id := range m.removedchecks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChecksIDs returns the "checks" edge IDs in the mutation.
+func (m *LinkMutation) ChecksIDs() (ids []uuid.UUID) {
+	for id := range m.checks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChecks resets all changes to the "checks" edge.
+func (m *LinkMutation) ResetChecks() {
+	m.checks = nil
+	m.clearedchecks = false
+	m.removedchecks = nil
+}
+
+// AddJobIDs adds the "jobs" edge to the Job entity by ids.
+func (m *LinkMutation) AddJobIDs(ids ...uuid.UUID) {
+	if m.jobs == nil {
+		m.jobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.jobs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearJobs clears the "jobs" edge to the Job entity.
+func (m *LinkMutation) ClearJobs() {
+	m.clearedjobs = true
+}
+
+// JobsCleared reports if the "jobs" edge to the Job entity was cleared.
+func (m *LinkMutation) JobsCleared() bool {
+	return m.clearedjobs
+}
+
+// RemoveJobIDs removes the "jobs" edge to the Job entity by IDs.
+func (m *LinkMutation) RemoveJobIDs(ids ...uuid.UUID) {
+	if m.removedjobs == nil {
+		m.removedjobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.jobs, ids[i])
+		m.removedjobs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedJobs returns the removed IDs of the "jobs" edge to the Job entity.
+func (m *LinkMutation) RemovedJobsIDs() (ids []uuid.UUID) {
+	for id := range m.removedjobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// JobsIDs returns the "jobs" edge IDs in the mutation.
+func (m *LinkMutation) JobsIDs() (ids []uuid.UUID) {
+	for id := range m.jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetJobs resets all changes to the "jobs" edge.
+func (m *LinkMutation) ResetJobs() {
+	m.jobs = nil
+	m.clearedjobs = false
+	m.removedjobs = nil
+}
+
+// Where appends a list predicates to the LinkMutation builder.
+func (m *LinkMutation) Where(ps ...predicate.Link) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LinkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LinkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Link, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LinkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LinkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Link).
+func (m *LinkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LinkMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.user_id != nil {
+		fields = append(fields, link.FieldUserID)
+	}
+	if m.url != nil {
+		fields = append(fields, link.FieldURL)
+	}
+	if m.canonical_url != nil {
+		fields = append(fields, link.FieldCanonicalURL)
+	}
+	if m.title != nil {
+		fields = append(fields, link.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, link.FieldDescription)
+	}
+	if m.domain != nil {
+		fields = append(fields, link.FieldDomain)
+	}
+	if m.og_image != nil {
+		fields = append(fields, link.FieldOgImage)
+	}
+	if m.page_url != nil {
+		fields = append(fields, link.FieldPageURL)
+	}
+	if m.note != nil {
+		fields = append(fields, link.FieldNote)
+	}
+	if m.tags != nil {
+		fields = append(fields, link.FieldTags)
+	}
+	if m.metadata != nil {
+		fields = append(fields, link.FieldMetadata)
+	}
+	if m.saved_at != nil {
+		fields = append(fields, link.FieldSavedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, link.FieldCreatedAt)
+	}
+	if m.search_vector != nil {
+		fields = append(fields, link.FieldSearchVector)
+	}
+	if m.search_text != nil {
+		fields = append(fields, link.FieldSearchText)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, link.FieldDeletedAt)
+	}
+	if m.site != nil {
+		fields = append(fields, link.FieldSiteID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LinkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case link.FieldUserID:
+		return m.UserID()
+	case link.FieldURL:
+		return m.URL()
+	case link.FieldCanonicalURL:
+		return m.CanonicalURL()
+	case link.FieldTitle:
+		return m.Title()
+	case link.FieldDescription:
+		return m.Description()
+	case link.FieldDomain:
+		return m.Domain()
+	case link.FieldOgImage:
+		return m.OgImage()
+	case link.FieldPageURL:
+		return m.PageURL()
+	case link.FieldNote:
+		return m.Note()
+	case link.FieldTags:
+		return m.Tags()
+	case link.FieldMetadata:
+		return m.Metadata()
+	case link.FieldSavedAt:
+		return m.SavedAt()
+	case link.FieldCreatedAt:
+		return m.CreatedAt()
+	case link.FieldSearchVector:
+		return m.SearchVector()
+	case link.FieldSearchText:
+		return m.SearchText()
+	case link.FieldDeletedAt:
+		return m.DeletedAt()
+	case link.FieldSiteID:
+		return m.SiteID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case link.FieldUserID:
+		return m.OldUserID(ctx)
+	case link.FieldURL:
+		return m.OldURL(ctx)
+	case link.FieldCanonicalURL:
+		return m.OldCanonicalURL(ctx)
+	case link.FieldTitle:
+		return m.OldTitle(ctx)
+	case link.FieldDescription:
+		return m.OldDescription(ctx)
+	case link.FieldDomain:
+		return m.OldDomain(ctx)
+	case link.FieldOgImage:
+		return m.OldOgImage(ctx)
+	case link.FieldPageURL:
+		return m.OldPageURL(ctx)
+	case link.FieldNote:
+		return m.OldNote(ctx)
+	case link.FieldTags:
+		return m.OldTags(ctx)
+	case link.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case link.FieldSavedAt:
+		return m.OldSavedAt(ctx)
+	case link.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case link.FieldSearchVector:
+		return m.OldSearchVector(ctx)
+	case link.FieldSearchText:
+		return m.OldSearchText(ctx)
+	case link.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case link.FieldSiteID:
+		return m.OldSiteID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Link field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LinkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case link.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case link.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case link.FieldCanonicalURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCanonicalURL(v)
+		return nil
+	case link.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case link.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case link.FieldDomain:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDomain(v)
+		return nil
+	case link.FieldOgImage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOgImage(v)
+		return nil
+	case link.FieldPageURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPageURL(v)
+		return nil
+	case link.FieldNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNote(v)
+		return nil
+	case link.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case link.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case link.FieldSavedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSavedAt(v)
+		return nil
+	case link.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case link.FieldSearchVector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSearchVector(v)
+		return nil
+	case link.FieldSearchText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSearchText(v)
+		return nil
+	case link.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case link.FieldSiteID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSiteID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Link field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LinkMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LinkMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LinkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Link numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LinkMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(link.FieldUserID) {
+		fields = append(fields, link.FieldUserID)
+	}
+	if m.FieldCleared(link.FieldCanonicalURL) {
+		fields = append(fields, link.FieldCanonicalURL)
+	}
+	if m.FieldCleared(link.FieldTitle) {
+		fields = append(fields, link.FieldTitle)
+	}
+	if m.FieldCleared(link.FieldDescription) {
+		fields = append(fields, link.FieldDescription)
+	}
+	if m.FieldCleared(link.FieldDomain) {
+		fields = append(fields, link.FieldDomain)
+	}
+	if m.FieldCleared(link.FieldOgImage) {
+		fields = append(fields, link.FieldOgImage)
+	}
+	if m.FieldCleared(link.FieldPageURL) {
+		fields = append(fields, link.FieldPageURL)
+	}
+	if m.FieldCleared(link.FieldNote) {
+		fields = append(fields, link.FieldNote)
+	}
+	if m.FieldCleared(link.FieldTags) {
+		fields = append(fields, link.FieldTags)
+	}
+	if m.FieldCleared(link.FieldSearchVector) {
+		fields = append(fields, link.FieldSearchVector)
+	}
+	if m.FieldCleared(link.FieldSearchText) {
+		fields = append(fields, link.FieldSearchText)
+	}
+	if m.FieldCleared(link.FieldDeletedAt) {
+		fields = append(fields, link.FieldDeletedAt)
+	}
+	if m.FieldCleared(link.FieldSiteID) {
+		fields = append(fields, link.FieldSiteID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LinkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LinkMutation) ClearField(name string) error {
+	switch name {
+	case link.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case link.FieldCanonicalURL:
+		m.ClearCanonicalURL()
+		return nil
+	case link.FieldTitle:
+		m.ClearTitle()
+		return nil
+	case link.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case link.FieldDomain:
+		m.ClearDomain()
+		return nil
+	case link.FieldOgImage:
+		m.ClearOgImage()
+		return nil
+	case link.FieldPageURL:
+		m.ClearPageURL()
+		return nil
+	case link.FieldNote:
+		m.ClearNote()
+		return nil
+	case link.FieldTags:
+		m.ClearTags()
+		return nil
+	case link.FieldSearchVector:
+		m.ClearSearchVector()
+		return nil
+	case link.FieldSearchText:
+		m.ClearSearchText()
+		return nil
+	case link.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case link.FieldSiteID:
+		m.ClearSiteID()
+		return nil
+	}
+	return fmt.Errorf("unknown Link nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LinkMutation) ResetField(name string) error {
+	switch name {
+	case link.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case link.FieldURL:
+		m.ResetURL()
+		return nil
+	case link.FieldCanonicalURL:
+		m.ResetCanonicalURL()
+		return nil
+	case link.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case link.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case link.FieldDomain:
+		m.ResetDomain()
+		return nil
+	case link.FieldOgImage:
+		m.ResetOgImage()
+		return nil
+	case link.FieldPageURL:
+		m.ResetPageURL()
+		return nil
+	case link.FieldNote:
+		m.ResetNote()
+		return nil
+	case link.FieldTags:
+		m.ResetTags()
+		return nil
+	case link.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case link.FieldSavedAt:
+		m.ResetSavedAt()
+		return nil
+	case link.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case link.FieldSearchVector:
+		m.ResetSearchVector()
+		return nil
+	case link.FieldSearchText:
+		m.ResetSearchText()
+		return nil
+	case link.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case link.FieldSiteID:
+		m.ResetSiteID()
+		return nil
+	}
+	return fmt.Errorf("unknown Link field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LinkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.site != nil {
+		edges = append(edges, link.EdgeSite)
+	}
+	if m.content != nil {
+		edges = append(edges, link.EdgeContent)
+	}
+	if m.checks != nil {
+		edges = append(edges, link.EdgeChecks)
+	}
+	if m.jobs != nil {
+		edges = append(edges, link.EdgeJobs)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LinkMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case link.EdgeSite:
+		if id := m.site; id != nil {
+			return []ent.Value{*id}
+		}
+	case link.EdgeContent:
+		if id := m.content; id != nil {
+			return []ent.Value{*id}
+		}
+	case link.EdgeChecks:
+		ids := make([]ent.Value, 0, len(m.checks))
+		for id := range m.checks {
+			ids = append(ids, id)
+		}
+		return ids
+	case link.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.jobs))
+		for id := range m.jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LinkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedchecks != nil {
+		edges = append(edges, link.EdgeChecks)
+	}
+	if m.removedjobs != nil {
+		edges = append(edges, link.EdgeJobs)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LinkMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case link.EdgeChecks:
+		ids := make([]ent.Value, 0, len(m.removedchecks))
+		for id := range m.removedchecks {
+			ids = append(ids, id)
+		}
+		return ids
+	case link.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.removedjobs))
+		for id := range m.removedjobs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LinkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedsite {
+		edges = append(edges, link.EdgeSite)
+	}
+	if m.clearedcontent {
+		edges = append(edges, link.EdgeContent)
+	}
+	if m.clearedchecks {
+		edges = append(edges, link.EdgeChecks)
+	}
+	if m.clearedjobs {
+		edges = append(edges, link.EdgeJobs)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LinkMutation) EdgeCleared(name string) bool {
+	switch name {
+	case link.EdgeSite:
+		return m.clearedsite
+	case link.EdgeContent:
+		return m.clearedcontent
+	case link.EdgeChecks:
+		return m.clearedchecks
+	case link.EdgeJobs:
+		return m.clearedjobs
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LinkMutation) ClearEdge(name string) error {
+	switch name {
+	case link.EdgeSite:
+		m.ClearSite()
+		return nil
+	case link.EdgeContent:
+		m.ClearContent()
+		return nil
+	}
+	return fmt.Errorf("unknown Link unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LinkMutation) ResetEdge(name string) error {
+	switch name {
+	case link.EdgeSite:
+		m.ResetSite()
+		return nil
+	case link.EdgeContent:
+		m.ResetContent()
+		return nil
+	case link.EdgeChecks:
+		m.ResetChecks()
+		return nil
+	case link.EdgeJobs:
+		m.ResetJobs()
+		return nil
+	}
+	return fmt.Errorf("unknown Link edge %s", name)
+}
+
+// LinkCheckMutation represents an operation that mutates the LinkCheck nodes in the graph.
+type LinkCheckMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	status_code    *int
+	addstatus_code *int
+	final_url      *string
+	health         *linkcheck.Health
+	error          *string
+	checked_at     *time.Time
+	clearedFields  map[string]struct{}
+	link           *uuid.UUID
+	clearedlink    bool
+	done           bool
+	oldValue       func(context.Context) (*LinkCheck, error)
+	predicates     []predicate.LinkCheck
+}
+
+var _ ent.Mutation = (*LinkCheckMutation)(nil)
+
+// linkcheckOption allows management of the mutation configuration using functional options.
+type linkcheckOption func(*LinkCheckMutation)
+
+// newLinkCheckMutation creates new mutation for the LinkCheck entity.
+func newLinkCheckMutation(c config, op Op, opts ...linkcheckOption) *LinkCheckMutation {
+	m := &LinkCheckMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLinkCheck,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLinkCheckID sets the ID field of the mutation.
+func withLinkCheckID(id uuid.UUID) linkcheckOption {
+	return func(m *LinkCheckMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LinkCheck
+		)
+		m.oldValue = func(ctx context.Context) (*LinkCheck, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LinkCheck.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLinkCheck sets the old LinkCheck of the mutation.
+func withLinkCheck(node *LinkCheck) linkcheckOption {
+	return func(m *LinkCheckMutation) {
+		m.oldValue = func(context.Context) (*LinkCheck, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LinkCheckMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LinkCheckMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LinkCheck entities.
+func (m *LinkCheckMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LinkCheckMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LinkCheckMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LinkCheck.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLinkID sets the "link_id" field.
+func (m *LinkCheckMutation) SetLinkID(u uuid.UUID) {
+	m.link = &u
+}
+
+// LinkID returns the value of the "link_id" field in the mutation.
+func (m *LinkCheckMutation) LinkID() (r uuid.UUID, exists bool) {
+	v := m.link
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLinkID returns the old "link_id" field's value of the LinkCheck entity.
+// If the LinkCheck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkCheckMutation) OldLinkID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLinkID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLinkID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLinkID: %w", err)
+	}
+	return oldValue.LinkID, nil
+}
+
+// ResetLinkID resets all changes to the "link_id" field.
+func (m *LinkCheckMutation) ResetLinkID() {
+	m.link = nil
+}
+
+// SetStatusCode sets the "status_code" field.
+func (m *LinkCheckMutation) SetStatusCode(i int) {
+	m.status_code = &i
+	m.addstatus_code = nil
+}
+
+// StatusCode returns the value of the "status_code" field in the mutation.
+func (m *LinkCheckMutation) StatusCode() (r int, exists bool) {
+	v := m.status_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusCode returns the old "status_code" field's value of the LinkCheck entity.
+// If the LinkCheck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkCheckMutation) OldStatusCode(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusCode: %w", err)
+	}
+	return oldValue.StatusCode, nil
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (m *LinkCheckMutation) AddStatusCode(i int) {
+	if m.addstatus_code != nil {
+		*m.addstatus_code += i
+	} else {
+		m.addstatus_code = &i
+	}
+}
+
+// AddedStatusCode returns the value that was added to the "status_code" field in this mutation.
+func (m *LinkCheckMutation) AddedStatusCode() (r int, exists bool) {
+	v := m.addstatus_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (m *LinkCheckMutation) ClearStatusCode() {
+	m.status_code = nil
+	m.addstatus_code = nil
+	m.clearedFields[linkcheck.FieldStatusCode] = struct{}{}
+}
+
+// StatusCodeCleared returns if the "status_code" field was cleared in this mutation.
+func (m *LinkCheckMutation) StatusCodeCleared() bool {
+	_, ok := m.clearedFields[linkcheck.FieldStatusCode]
+	return ok
+}
+
+// ResetStatusCode resets all changes to the "status_code" field.
+func (m *LinkCheckMutation) ResetStatusCode() {
+	m.status_code = nil
+	m.addstatus_code = nil
+	delete(m.clearedFields, linkcheck.FieldStatusCode)
+}
+
+// SetFinalURL sets the "final_url" field.
+func (m *LinkCheckMutation) SetFinalURL(s string) {
+	m.final_url = &s
+}
+
+// FinalURL returns the value of the "final_url" field in the mutation.
+func (m *LinkCheckMutation) FinalURL() (r string, exists bool) {
+	v := m.final_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinalURL returns the old "final_url" field's value of the LinkCheck entity.
+// If the LinkCheck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkCheckMutation) OldFinalURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinalURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinalURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinalURL: %w", err)
+	}
+	return oldValue.FinalURL, nil
+}
+
+// ClearFinalURL clears the value of the "final_url" field.
+func (m *LinkCheckMutation) ClearFinalURL() {
+	m.final_url = nil
+	m.clearedFields[linkcheck.FieldFinalURL] = struct{}{}
+}
+
+// FinalURLCleared returns if the "final_url" field was cleared in this mutation.
+func (m *LinkCheckMutation) FinalURLCleared() bool {
+	_, ok := m.clearedFields[linkcheck.FieldFinalURL]
+	return ok
+}
+
+// ResetFinalURL resets all changes to the "final_url" field.
+func (m *LinkCheckMutation) ResetFinalURL() {
+	m.final_url = nil
+	delete(m.clearedFields, linkcheck.FieldFinalURL)
+}
+
+// SetHealth sets the "health" field.
+func (m *LinkCheckMutation) SetHealth(l linkcheck.Health) {
+	m.health = &l
+}
+
+// Health returns the value of the "health" field in the mutation.
+func (m *LinkCheckMutation) Health() (r linkcheck.Health, exists bool) {
+	v := m.health
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHealth returns the old "health" field's value of the LinkCheck entity.
+// If the LinkCheck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkCheckMutation) OldHealth(ctx context.Context) (v linkcheck.Health, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHealth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHealth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHealth: %w", err)
+	}
+	return oldValue.Health, nil
+}
+
+// ResetHealth resets all changes to the "health" field.
+func (m *LinkCheckMutation) ResetHealth() {
+	m.health = nil
+}
+
+// SetError sets the "error" field.
+func (m *LinkCheckMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *LinkCheckMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the LinkCheck entity.
+// If the LinkCheck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkCheckMutation) OldError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *LinkCheckMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[linkcheck.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *LinkCheckMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[linkcheck.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *LinkCheckMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, linkcheck.FieldError)
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (m *LinkCheckMutation) SetCheckedAt(t time.Time) {
+	m.checked_at = &t
+}
+
+// CheckedAt returns the value of the "checked_at" field in the mutation.
+func (m *LinkCheckMutation) CheckedAt() (r time.Time, exists bool) {
+	v := m.checked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCheckedAt returns the old "checked_at" field's value of the LinkCheck entity.
+// If the LinkCheck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkCheckMutation) OldCheckedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheckedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheckedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheckedAt: %w", err)
+	}
+	return oldValue.CheckedAt, nil
+}
+
+// ResetCheckedAt resets all changes to the "checked_at" field.
+func (m *LinkCheckMutation) ResetCheckedAt() {
+	m.checked_at = nil
+}
+
+// ClearLink clears the "link" edge to the Link entity.
+func (m *LinkCheckMutation) ClearLink() {
+	m.clearedlink = true
+	m.clearedFields[linkcheck.FieldLinkID] = struct{}{}
+}
+
+// LinkCleared reports if the "link" edge to the Link entity was cleared.
+func (m *LinkCheckMutation) LinkCleared() bool {
+	return m.clearedlink
+}
+
+// LinkIDs returns the "link" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LinkID instead. It exists only for internal usage by the builders.
+func (m *LinkCheckMutation) LinkIDs() (ids []uuid.UUID) {
+	if id := m.link; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLink resets all changes to the "link" edge.
+func (m *LinkCheckMutation) ResetLink() {
+	m.link = nil
+	m.clearedlink = false
+}
+
+// Where appends a list predicates to the LinkCheckMutation builder.
+func (m *LinkCheckMutation) Where(ps ...predicate.LinkCheck) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LinkCheckMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LinkCheckMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LinkCheck, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LinkCheckMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LinkCheckMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LinkCheck).
+func (m *LinkCheckMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LinkCheckMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.link != nil {
+		fields = append(fields, linkcheck.FieldLinkID)
+	}
+	if m.status_code != nil {
+		fields = append(fields, linkcheck.FieldStatusCode)
+	}
+	if m.final_url != nil {
+		fields = append(fields, linkcheck.FieldFinalURL)
+	}
+	if m.health != nil {
+		fields = append(fields, linkcheck.FieldHealth)
+	}
+	if m.error != nil {
+		fields = append(fields, linkcheck.FieldError)
+	}
+	if m.checked_at != nil {
+		fields = append(fields, linkcheck.FieldCheckedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LinkCheckMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case linkcheck.FieldLinkID:
+		return m.LinkID()
+	case linkcheck.FieldStatusCode:
+		return m.StatusCode()
+	case linkcheck.FieldFinalURL:
+		return m.FinalURL()
+	case linkcheck.FieldHealth:
+		return m.Health()
+	case linkcheck.FieldError:
+		return m.Error()
+	case linkcheck.FieldCheckedAt:
+		return m.CheckedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LinkCheckMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case linkcheck.FieldLinkID:
+		return m.OldLinkID(ctx)
+	case linkcheck.FieldStatusCode:
+		return m.OldStatusCode(ctx)
+	case linkcheck.FieldFinalURL:
+		return m.OldFinalURL(ctx)
+	case linkcheck.FieldHealth:
+		return m.OldHealth(ctx)
+	case linkcheck.FieldError:
+		return m.OldError(ctx)
+	case linkcheck.FieldCheckedAt:
+		return m.OldCheckedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LinkCheck field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LinkCheckMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case linkcheck.FieldLinkID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLinkID(v)
+		return nil
+	case linkcheck.FieldStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusCode(v)
+		return nil
+	case linkcheck.FieldFinalURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinalURL(v)
+		return nil
+	case linkcheck.FieldHealth:
+		v, ok := value.(linkcheck.Health)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHealth(v)
+		return nil
+	case linkcheck.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case linkcheck.FieldCheckedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheckedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LinkCheck field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LinkCheckMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus_code != nil {
+		fields = append(fields, linkcheck.FieldStatusCode)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LinkCheckMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case linkcheck.FieldStatusCode:
+		return m.AddedStatusCode()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LinkCheckMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case linkcheck.FieldStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatusCode(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LinkCheck numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LinkCheckMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(linkcheck.FieldStatusCode) {
+		fields = append(fields, linkcheck.FieldStatusCode)
+	}
+	if m.FieldCleared(linkcheck.FieldFinalURL) {
+		fields = append(fields, linkcheck.FieldFinalURL)
+	}
+	if m.FieldCleared(linkcheck.FieldError) {
+		fields = append(fields, linkcheck.FieldError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LinkCheckMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LinkCheckMutation) ClearField(name string) error {
+	switch name {
+	case linkcheck.FieldStatusCode:
+		m.ClearStatusCode()
+		return nil
+	case linkcheck.FieldFinalURL:
+		m.ClearFinalURL()
+		return nil
+	case linkcheck.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown LinkCheck nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LinkCheckMutation) ResetField(name string) error {
+	switch name {
+	case linkcheck.FieldLinkID:
+		m.ResetLinkID()
+		return nil
+	case linkcheck.FieldStatusCode:
+		m.ResetStatusCode()
+		return nil
+	case linkcheck.FieldFinalURL:
+		m.ResetFinalURL()
+		return nil
+	case linkcheck.FieldHealth:
+		m.ResetHealth()
+		return nil
+	case linkcheck.FieldError:
+		m.ResetError()
+		return nil
+	case linkcheck.FieldCheckedAt:
+		m.ResetCheckedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LinkCheck field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LinkCheckMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.link != nil {
+		edges = append(edges, linkcheck.EdgeLink)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LinkCheckMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case linkcheck.EdgeLink:
+		if id := m.link; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LinkCheckMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LinkCheckMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LinkCheckMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedlink {
+		edges = append(edges, linkcheck.EdgeLink)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LinkCheckMutation) EdgeCleared(name string) bool {
+	switch name {
+	case linkcheck.EdgeLink:
+		return m.clearedlink
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LinkCheckMutation) ClearEdge(name string) error {
+	switch name {
+	case linkcheck.EdgeLink:
+		m.ClearLink()
+		return nil
+	}
+	return fmt.Errorf("unknown LinkCheck unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LinkCheckMutation) ResetEdge(name string) error {
+	switch name {
+	case linkcheck.EdgeLink:
+		m.ResetLink()
+		return nil
+	}
+	return fmt.Errorf("unknown LinkCheck edge %s", name)
+}
+
+// LinkContentMutation represents an operation that mutates the LinkContent nodes in the graph.
+type LinkContentMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	text               *string
+	word_count         *int
+	addword_count      *int
+	reading_minutes    *int
+	addreading_minutes *int
+	extracted_at       *time.Time
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	link               *uuid.UUID
+	clearedlink        bool
+	done               bool
+	oldValue           func(context.Context) (*LinkContent, error)
+	predicates         []predicate.LinkContent
+}
+
+var _ ent.Mutation = (*LinkContentMutation)(nil)
+
+// linkcontentOption allows management of the mutation configuration using functional options.
+type linkcontentOption func(*LinkContentMutation)
+
+// newLinkContentMutation creates new mutation for the LinkContent entity.
+func newLinkContentMutation(c config, op Op, opts ...linkcontentOption) *LinkContentMutation {
+	m := &LinkContentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLinkContent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLinkContentID sets the ID field of the mutation.
+func withLinkContentID(id uuid.UUID) linkcontentOption {
+	return func(m *LinkContentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LinkContent
+		)
+		m.oldValue = func(ctx context.Context) (*LinkContent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LinkContent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLinkContent sets the old LinkContent of the mutation.
+func withLinkContent(node *LinkContent) linkcontentOption {
+	return func(m *LinkContentMutation) {
+		m.oldValue = func(context.Context) (*LinkContent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LinkContentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LinkContentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LinkContent entities.
+func (m *LinkContentMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LinkContentMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LinkContentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LinkContent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLinkID sets the "link_id" field.
+func (m *LinkContentMutation) SetLinkID(u uuid.UUID) {
+	m.link = &u
+}
+
+// LinkID returns the value of the "link_id" field in the mutation.
+func (m *LinkContentMutation) LinkID() (r uuid.UUID, exists bool) {
+	v := m.link
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLinkID returns the old "link_id" field's value of the LinkContent entity.
+// If the LinkContent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkContentMutation) OldLinkID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLinkID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLinkID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLinkID: %w", err)
+	}
+	return oldValue.LinkID, nil
+}
+
+// ResetLinkID resets all changes to the "link_id" field.
+func (m *LinkContentMutation) ResetLinkID() {
+	m.link = nil
+}
+
+// SetText sets the "text" field.
+func (m *LinkContentMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *LinkContentMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the LinkContent entity.
+// If the LinkContent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkContentMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *LinkContentMutation) ResetText() {
+	m.text = nil
+}
+
+// SetWordCount sets the "word_count" field.
+func (m *LinkContentMutation) SetWordCount(i int) {
+	m.word_count = &i
+	m.addword_count = nil
+}
+
+// WordCount returns the value of the "word_count" field in the mutation.
+func (m *LinkContentMutation) WordCount() (r int, exists bool) {
+	v := m.word_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWordCount returns the old "word_count" field's value of the LinkContent entity.
+// If the LinkContent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkContentMutation) OldWordCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWordCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWordCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWordCount: %w", err)
+	}
+	return oldValue.WordCount, nil
+}
+
+// AddWordCount adds i to the "word_count" field.
+func (m *LinkContentMutation) AddWordCount(i int) {
+	if m.addword_count != nil {
+		*m.addword_count += i
+	} else {
+		m.addword_count = &i
+	}
+}
+
+// AddedWordCount returns the value that was added to the "word_count" field in this mutation.
+func (m *LinkContentMutation) AddedWordCount() (r int, exists bool) {
+	v := m.addword_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWordCount resets all changes to the "word_count" field.
+func (m *LinkContentMutation) ResetWordCount() {
+	m.word_count = nil
+	m.addword_count = nil
+}
+
+// SetReadingMinutes sets the "reading_minutes" field.
+func (m *LinkContentMutation) SetReadingMinutes(i int) {
+	m.reading_minutes = &i
+	m.addreading_minutes = nil
+}
+
+// ReadingMinutes returns the value of the "reading_minutes" field in the mutation.
+func (m *LinkContentMutation) ReadingMinutes() (r int, exists bool) {
+	v := m.reading_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadingMinutes returns the old "reading_minutes" field's value of the LinkContent entity.
+// If the LinkContent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkContentMutation) OldReadingMinutes(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadingMinutes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadingMinutes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadingMinutes: %w", err)
+	}
+	return oldValue.ReadingMinutes, nil
+}
+
+// AddReadingMinutes adds i to the "reading_minutes" field.
+func (m *LinkContentMutation) AddReadingMinutes(i int) {
+	if m.addreading_minutes != nil {
+		*m.addreading_minutes += i
+	} else {
+		m.addreading_minutes = &i
+	}
+}
+
+// AddedReadingMinutes returns the value that was added to the "reading_minutes" field in this mutation.
+func (m *LinkContentMutation) AddedReadingMinutes() (r int, exists bool) {
+	v := m.addreading_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReadingMinutes resets all changes to the "reading_minutes" field.
+func (m *LinkContentMutation) ResetReadingMinutes() {
+	m.reading_minutes = nil
+	m.addreading_minutes = nil
+}
+
+// SetExtractedAt sets the "extracted_at" field.
+func (m *LinkContentMutation) SetExtractedAt(t time.Time) {
+	m.extracted_at = &t
+}
+
+// ExtractedAt returns the value of the "extracted_at" field in the mutation.
+func (m *LinkContentMutation) ExtractedAt() (r time.Time, exists bool) {
+	v := m.extracted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExtractedAt returns the old "extracted_at" field's value of the LinkContent entity.
+// If the LinkContent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkContentMutation) OldExtractedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExtractedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExtractedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExtractedAt: %w", err)
+	}
+	return oldValue.ExtractedAt, nil
+}
+
+// ResetExtractedAt resets all changes to the "extracted_at" field.
+func (m *LinkContentMutation) ResetExtractedAt() {
+	m.extracted_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LinkContentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LinkContentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LinkContent entity.
+// If the LinkContent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkContentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LinkContentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *LinkContentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *LinkContentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the LinkContent entity.
+// If the LinkContent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkContentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *LinkContentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearLink clears the "link" edge to the Link entity.
+func (m *LinkContentMutation) ClearLink() {
+	m.clearedlink = true
+	m.clearedFields[linkcontent.FieldLinkID] = struct{}{}
+}
+
+// LinkCleared reports if the "link" edge to the Link entity was cleared.
+func (m *LinkContentMutation) LinkCleared() bool {
+	return m.clearedlink
+}
+
+// LinkIDs returns the "link" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LinkID instead. It exists only for internal usage by the builders.
+func (m *LinkContentMutation) LinkIDs() (ids []uuid.UUID) {
+	if id := m.link; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLink resets all changes to the "link" edge.
+func (m *LinkContentMutation) ResetLink() {
+	m.link = nil
+	m.clearedlink = false
+}
+
+// Where appends a list predicates to the LinkContentMutation builder.
+func (m *LinkContentMutation) Where(ps ...predicate.LinkContent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LinkContentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LinkContentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LinkContent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LinkContentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LinkContentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LinkContent).
+func (m *LinkContentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LinkContentMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.link != nil {
+		fields = append(fields, linkcontent.FieldLinkID)
+	}
+	if m.text != nil {
+		fields = append(fields, linkcontent.FieldText)
+	}
+	if m.word_count != nil {
+		fields = append(fields, linkcontent.FieldWordCount)
+	}
+	if m.reading_minutes != nil {
+		fields = append(fields, linkcontent.FieldReadingMinutes)
+	}
+	if m.extracted_at != nil {
+		fields = append(fields, linkcontent.FieldExtractedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, linkcontent.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, linkcontent.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LinkContentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case linkcontent.FieldLinkID:
+		return m.LinkID()
+	case linkcontent.FieldText:
+		return m.Text()
+	case linkcontent.FieldWordCount:
+		return m.WordCount()
+	case linkcontent.FieldReadingMinutes:
+		return m.ReadingMinutes()
+	case linkcontent.FieldExtractedAt:
+		return m.ExtractedAt()
+	case linkcontent.FieldCreatedAt:
+		return m.CreatedAt()
+	case linkcontent.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LinkContentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case linkcontent.FieldLinkID:
+		return m.OldLinkID(ctx)
+	case linkcontent.FieldText:
+		return m.OldText(ctx)
+	case linkcontent.FieldWordCount:
+		return m.OldWordCount(ctx)
+	case linkcontent.FieldReadingMinutes:
+		return m.OldReadingMinutes(ctx)
+	case linkcontent.FieldExtractedAt:
+		return m.OldExtractedAt(ctx)
+	case linkcontent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case linkcontent.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LinkContent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LinkContentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case linkcontent.FieldLinkID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLinkID(v)
+		return nil
+	case linkcontent.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case linkcontent.FieldWordCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWordCount(v)
+		return nil
+	case linkcontent.FieldReadingMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadingMinutes(v)
+		return nil
+	case linkcontent.FieldExtractedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExtractedAt(v)
+		return nil
+	case linkcontent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case linkcontent.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LinkContent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LinkContentMutation) AddedFields() []string {
+	var fields []string
+	if m.addword_count != nil {
+		fields = append(fields, linkcontent.FieldWordCount)
+	}
+	if m.addreading_minutes != nil {
+		fields = append(fields, linkcontent.FieldReadingMinutes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LinkContentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case linkcontent.FieldWordCount:
+		return m.AddedWordCount()
+	case linkcontent.FieldReadingMinutes:
+		return m.AddedReadingMinutes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LinkContentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case linkcontent.FieldWordCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWordCount(v)
+		return nil
+	case linkcontent.FieldReadingMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReadingMinutes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LinkContent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LinkContentMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LinkContentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LinkContentMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LinkContent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LinkContentMutation) ResetField(name string) error {
+	switch name {
+	case linkcontent.FieldLinkID:
+		m.ResetLinkID()
+		return nil
+	case linkcontent.FieldText:
+		m.ResetText()
+		return nil
+	case linkcontent.FieldWordCount:
+		m.ResetWordCount()
+		return nil
+	case linkcontent.FieldReadingMinutes:
+		m.ResetReadingMinutes()
+		return nil
+	case linkcontent.FieldExtractedAt:
+		m.ResetExtractedAt()
+		return nil
+	case linkcontent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case linkcontent.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LinkContent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LinkContentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.link != nil {
+		edges = append(edges, linkcontent.EdgeLink)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LinkContentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case linkcontent.EdgeLink:
+		if id := m.link; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LinkContentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LinkContentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LinkContentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedlink {
+		edges = append(edges, linkcontent.EdgeLink)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LinkContentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case linkcontent.EdgeLink:
+		return m.clearedlink
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LinkContentMutation) ClearEdge(name string) error {
+	switch name {
+	case linkcontent.EdgeLink:
+		m.ClearLink()
+		return nil
+	}
+	return fmt.Errorf("unknown LinkContent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LinkContentMutation) ResetEdge(name string) error {
+	switch name {
+	case linkcontent.EdgeLink:
+		m.ResetLink()
+		return nil
+	}
+	return fmt.Errorf("unknown LinkContent edge %s", name)
+}
+
+// MetadataCacheMutation represents an operation that mutates the MetadataCache nodes in the graph.
+type MetadataCacheMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	canonical_url *string
+	data          *map[string]interface{}
+	negative      *bool
+	expires_at    *time.Time
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*MetadataCache, error)
+	predicates    []predicate.MetadataCache
+}
+
+var _ ent.Mutation = (*MetadataCacheMutation)(nil)
+
+// metadatacacheOption allows management of the mutation configuration using functional options.
+type metadatacacheOption func(*MetadataCacheMutation)
+
+// newMetadataCacheMutation creates new mutation for the MetadataCache entity.
+func newMetadataCacheMutation(c config, op Op, opts ...metadatacacheOption) *MetadataCacheMutation {
+	m := &MetadataCacheMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMetadataCache,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMetadataCacheID sets the ID field of the mutation.
+func withMetadataCacheID(id uuid.UUID) metadatacacheOption {
+	return func(m *MetadataCacheMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MetadataCache
+		)
+		m.oldValue = func(ctx context.Context) (*MetadataCache, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MetadataCache.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMetadataCache sets the old MetadataCache of the mutation.
+func withMetadataCache(node *MetadataCache) metadatacacheOption {
+	return func(m *MetadataCacheMutation) {
+		m.oldValue = func(context.Context) (*MetadataCache, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MetadataCacheMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MetadataCacheMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MetadataCache entities.
+func (m *MetadataCacheMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MetadataCacheMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MetadataCacheMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MetadataCache.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCanonicalURL sets the "canonical_url" field.
+func (m *MetadataCacheMutation) SetCanonicalURL(s string) {
+	m.canonical_url = &s
+}
+
+// CanonicalURL returns the value of the "canonical_url" field in the mutation.
+func (m *MetadataCacheMutation) CanonicalURL() (r string, exists bool) {
+	v := m.canonical_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCanonicalURL returns the old "canonical_url" field's value of the MetadataCache entity.
+// If the MetadataCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetadataCacheMutation) OldCanonicalURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCanonicalURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCanonicalURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCanonicalURL: %w", err)
+	}
+	return oldValue.CanonicalURL, nil
+}
+
+// ResetCanonicalURL resets all changes to the "canonical_url" field.
+func (m *MetadataCacheMutation) ResetCanonicalURL() {
+	m.canonical_url = nil
+}
+
+// SetData sets the "data" field.
+func (m *MetadataCacheMutation) SetData(value map[string]interface{}) {
+	m.data = &value
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *MetadataCacheMutation) Data() (r map[string]interface{}, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the MetadataCache entity.
+// If the MetadataCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetadataCacheMutation) OldData(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *MetadataCacheMutation) ResetData() {
+	m.data = nil
+}
+
+// SetNegative sets the "negative" field.
+func (m *MetadataCacheMutation) SetNegative(b bool) {
+	m.negative = &b
+}
+
+// Negative returns the value of the "negative" field in the mutation.
+func (m *MetadataCacheMutation) Negative() (r bool, exists bool) {
+	v := m.negative
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNegative returns the old "negative" field's value of the MetadataCache entity.
+// If the MetadataCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetadataCacheMutation) OldNegative(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNegative is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNegative requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNegative: %w", err)
+	}
+	return oldValue.Negative, nil
+}
+
+// ResetNegative resets all changes to the "negative" field.
+func (m *MetadataCacheMutation) ResetNegative() {
+	m.negative = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *MetadataCacheMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *MetadataCacheMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the MetadataCache entity.
+// If the MetadataCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetadataCacheMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *MetadataCacheMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MetadataCacheMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MetadataCacheMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MetadataCache entity.
+// If the MetadataCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetadataCacheMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MetadataCacheMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MetadataCacheMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MetadataCacheMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the MetadataCache entity.
+// If the MetadataCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetadataCacheMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MetadataCacheMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the MetadataCacheMutation builder.
+func (m *MetadataCacheMutation) Where(ps ...predicate.MetadataCache) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MetadataCacheMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MetadataCacheMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MetadataCache, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MetadataCacheMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MetadataCacheMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MetadataCache).
+func (m *MetadataCacheMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MetadataCacheMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.canonical_url != nil {
+		fields = append(fields, metadatacache.FieldCanonicalURL)
+	}
+	if m.data != nil {
+		fields = append(fields, metadatacache.FieldData)
+	}
+	if m.negative != nil {
+		fields = append(fields, metadatacache.FieldNegative)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, metadatacache.FieldExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, metadatacache.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, metadatacache.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MetadataCacheMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case metadatacache.FieldCanonicalURL:
+		return m.CanonicalURL()
+	case metadatacache.FieldData:
+		return m.Data()
+	case metadatacache.FieldNegative:
+		return m.Negative()
+	case metadatacache.FieldExpiresAt:
+		return m.ExpiresAt()
+	case metadatacache.FieldCreatedAt:
+		return m.CreatedAt()
+	case metadatacache.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MetadataCacheMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case metadatacache.FieldCanonicalURL:
+		return m.OldCanonicalURL(ctx)
+	case metadatacache.FieldData:
+		return m.OldData(ctx)
+	case metadatacache.FieldNegative:
+		return m.OldNegative(ctx)
+	case metadatacache.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case metadatacache.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case metadatacache.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MetadataCache field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MetadataCacheMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case metadatacache.FieldCanonicalURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCanonicalURL(v)
+		return nil
+	case metadatacache.FieldData:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	case metadatacache.FieldNegative:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNegative(v)
+		return nil
+	case metadatacache.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case metadatacache.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case metadatacache.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MetadataCache field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MetadataCacheMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MetadataCacheMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MetadataCacheMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MetadataCache numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MetadataCacheMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MetadataCacheMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MetadataCacheMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MetadataCache nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MetadataCacheMutation) ResetField(name string) error {
+	switch name {
+	case metadatacache.FieldCanonicalURL:
+		m.ResetCanonicalURL()
+		return nil
+	case metadatacache.FieldData:
+		m.ResetData()
+		return nil
+	case metadatacache.FieldNegative:
+		m.ResetNegative()
+		return nil
+	case metadatacache.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case metadatacache.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case metadatacache.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MetadataCache field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MetadataCacheMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MetadataCacheMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MetadataCacheMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MetadataCacheMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MetadataCacheMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MetadataCacheMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MetadataCacheMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MetadataCache unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MetadataCacheMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MetadataCache edge %s", name)
+}
+
+// SiteMutation represents an operation that mutates the Site nodes in the graph.
+type SiteMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	domain        *string
+	name          *string
+	icon_url      *string
+	fetched_at    *time.Time
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	links         map[uuid.UUID]struct{}
+	removedlinks  map[uuid.UUID]struct{}
+	clearedlinks  bool
+	done          bool
+	oldValue      func(context.Context) (*Site, error)
+	predicates    []predicate.Site
+}
+
+var _ ent.Mutation = (*SiteMutation)(nil)
+
+// siteOption allows management of the mutation configuration using functional options.
+type siteOption func(*SiteMutation)
+
+// newSiteMutation creates new mutation for the Site entity.
+func newSiteMutation(c config, op Op, opts ...siteOption) *SiteMutation {
+	m := &SiteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSite,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSiteID sets the ID field of the mutation.
+func withSiteID(id uuid.UUID) siteOption {
+	return func(m *SiteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Site
+		)
+		m.oldValue = func(ctx context.Context) (*Site, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Site.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSite sets the old Site of the mutation.
+func withSite(node *Site) siteOption {
+	return func(m *SiteMutation) {
+		m.oldValue = func(context.Context) (*Site, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SiteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SiteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Site entities.
+func (m *SiteMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SiteMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SiteMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Site.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDomain sets the "domain" field.
+func (m *SiteMutation) SetDomain(s string) {
+	m.domain = &s
+}
+
+// Domain returns the value of the "domain" field in the mutation.
+func (m *SiteMutation) Domain() (r string, exists bool) {
+	v := m.domain
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDomain returns the old "domain" field's value of the Site entity.
+// If the Site object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SiteMutation) OldDomain(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDomain is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTags requires an ID field in the mutation")
+		return v, errors.New("OldDomain requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+		return v, fmt.Errorf("querying old value for OldDomain: %w", err)
 	}
-	return oldValue.Tags, nil
+	return oldValue.Domain, nil
 }
 
-// AppendTags adds s to the "tags" field.
-func (m *LinkMutation) AppendTags(s []string) {
-	m.appendtags = append(m.appendtags, s...)
+// ResetDomain resets all changes to the "domain" field.
+func (m *SiteMutation) ResetDomain() {
+	m.domain = nil
 }
 
-// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
-func (m *LinkMutation) AppendedTags() ([]string, bool) {
-	if len(m.appendtags) == 0 {
-		return nil, false
+// SetName sets the "name" field.
+func (m *SiteMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SiteMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	return m.appendtags, true
+	return *v, true
 }
 
-// ClearTags clears the value of the "tags" field.
-func (m *LinkMutation) ClearTags() {
-	m.tags = nil
-	m.appendtags = nil
-	m.clearedFields[link.FieldTags] = struct{}{}
+// OldName returns the old "name" field's value of the Site entity.
+// If the Site object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SiteMutation) OldName(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// TagsCleared returns if the "tags" field was cleared in this mutation.
-func (m *LinkMutation) TagsCleared() bool {
-	_, ok := m.clearedFields[link.FieldTags]
+// ClearName clears the value of the "name" field.
+func (m *SiteMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[site.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *SiteMutation) NameCleared() bool {
+	_, ok := m.clearedFields[site.FieldName]
 	return ok
 }
 
-// ResetTags resets all changes to the "tags" field.
-func (m *LinkMutation) ResetTags() {
-	m.tags = nil
-	m.appendtags = nil
-	delete(m.clearedFields, link.FieldTags)
+// ResetName resets all changes to the "name" field.
+func (m *SiteMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, site.FieldName)
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *LinkMutation) SetMetadata(value map[string]interface{}) {
-	m.metadata = &value
+// SetIconURL sets the "icon_url" field.
+func (m *SiteMutation) SetIconURL(s string) {
+	m.icon_url = &s
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *LinkMutation) Metadata() (r map[string]interface{}, exists bool) {
-	v := m.metadata
+// IconURL returns the value of the "icon_url" field in the mutation.
+func (m *SiteMutation) IconURL() (r string, exists bool) {
+	v := m.icon_url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldIconURL returns the old "icon_url" field's value of the Site entity.
+// If the Site object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *SiteMutation) OldIconURL(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldIconURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldIconURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldIconURL: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.IconURL, nil
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *LinkMutation) ResetMetadata() {
-	m.metadata = nil
+// ClearIconURL clears the value of the "icon_url" field.
+func (m *SiteMutation) ClearIconURL() {
+	m.icon_url = nil
+	m.clearedFields[site.FieldIconURL] = struct{}{}
 }
 
-// SetSavedAt sets the "saved_at" field.
-func (m *LinkMutation) SetSavedAt(t time.Time) {
-	m.saved_at = &t
+// IconURLCleared returns if the "icon_url" field was cleared in this mutation.
+func (m *SiteMutation) IconURLCleared() bool {
+	_, ok := m.clearedFields[site.FieldIconURL]
+	return ok
 }
 
-// SavedAt returns the value of the "saved_at" field in the mutation.
-func (m *LinkMutation) SavedAt() (r time.Time, exists bool) {
-	v := m.saved_at
+// ResetIconURL resets all changes to the "icon_url" field.
+func (m *SiteMutation) ResetIconURL() {
+	m.icon_url = nil
+	delete(m.clearedFields, site.FieldIconURL)
+}
+
+// SetFetchedAt sets the "fetched_at" field.
+func (m *SiteMutation) SetFetchedAt(t time.Time) {
+	m.fetched_at = &t
+}
+
+// FetchedAt returns the value of the "fetched_at" field in the mutation.
+func (m *SiteMutation) FetchedAt() (r time.Time, exists bool) {
+	v := m.fetched_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSavedAt returns the old "saved_at" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldFetchedAt returns the old "fetched_at" field's value of the Site entity.
+// If the Site object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldSavedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SiteMutation) OldFetchedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSavedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldFetchedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSavedAt requires an ID field in the mutation")
+		return v, errors.New("OldFetchedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSavedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldFetchedAt: %w", err)
 	}
-	return oldValue.SavedAt, nil
+	return oldValue.FetchedAt, nil
 }
 
-// ResetSavedAt resets all changes to the "saved_at" field.
-func (m *LinkMutation) ResetSavedAt() {
-	m.saved_at = nil
+// ClearFetchedAt clears the value of the "fetched_at" field.
+func (m *SiteMutation) ClearFetchedAt() {
+	m.fetched_at = nil
+	m.clearedFields[site.FieldFetchedAt] = struct{}{}
+}
+
+// FetchedAtCleared returns if the "fetched_at" field was cleared in this mutation.
+func (m *SiteMutation) FetchedAtCleared() bool {
+	_, ok := m.clearedFields[site.FieldFetchedAt]
+	return ok
+}
+
+// ResetFetchedAt resets all changes to the "fetched_at" field.
+func (m *SiteMutation) ResetFetchedAt() {
+	m.fetched_at = nil
+	delete(m.clearedFields, site.FieldFetchedAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *LinkMutation) SetCreatedAt(t time.Time) {
+func (m *SiteMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *LinkMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *SiteMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -687,10 +5133,10 @@ func (m *LinkMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Site entity.
+// If the Site object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SiteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -705,19 +5151,109 @@ func (m *LinkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *LinkMutation) ResetCreatedAt() {
+func (m *SiteMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// Where appends a list predicates to the LinkMutation builder.
-func (m *LinkMutation) Where(ps ...predicate.Link) {
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SiteMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SiteMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Site entity.
+// If the Site object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SiteMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SiteMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddLinkIDs adds the "links" edge to the Link entity by ids.
+func (m *SiteMutation) AddLinkIDs(ids ...uuid.UUID) {
+	if m.links == nil {
+		m.links = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.links[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLinks clears the "links" edge to the Link entity.
+func (m *SiteMutation) ClearLinks() {
+	m.clearedlinks = true
+}
+
+// LinksCleared reports if the "links" edge to the Link entity was cleared.
+func (m *SiteMutation) LinksCleared() bool {
+	return m.clearedlinks
+}
+
+// RemoveLinkIDs removes the "links" edge to the Link entity by IDs.
+func (m *SiteMutation) RemoveLinkIDs(ids ...uuid.UUID) {
+	if m.removedlinks == nil {
+		m.removedlinks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.links, ids[i])
+		m.removedlinks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLinks returns the removed IDs of the "links" edge to the Link entity.
+func (m *SiteMutation) RemovedLinksIDs() (ids []uuid.UUID) {
+	for id := range m.removedlinks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LinksIDs returns the "links" edge IDs in the mutation.
+func (m *SiteMutation) LinksIDs() (ids []uuid.UUID) {
+	for id := range m.links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLinks resets all changes to the "links" edge.
+func (m *SiteMutation) ResetLinks() {
+	m.links = nil
+	m.clearedlinks = false
+	m.removedlinks = nil
+}
+
+// Where appends a list predicates to the SiteMutation builder.
+func (m *SiteMutation) Where(ps ...predicate.Site) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the LinkMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SiteMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LinkMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Link, len(ps))
+func (m *SiteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Site, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -725,60 +5261,42 @@ func (m *LinkMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *LinkMutation) Op() Op {
+func (m *SiteMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *LinkMutation) SetOp(op Op) {
+func (m *SiteMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Link).
-func (m *LinkMutation) Type() string {
+// Type returns the node type of this mutation (Site).
+func (m *SiteMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LinkMutation) Fields() []string {
-	fields := make([]string, 0, 12)
-	if m.user_id != nil {
-		fields = append(fields, link.FieldUserID)
-	}
-	if m.url != nil {
-		fields = append(fields, link.FieldURL)
-	}
-	if m.title != nil {
-		fields = append(fields, link.FieldTitle)
-	}
-	if m.description != nil {
-		fields = append(fields, link.FieldDescription)
-	}
+func (m *SiteMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m.domain != nil {
-		fields = append(fields, link.FieldDomain)
-	}
-	if m.og_image != nil {
-		fields = append(fields, link.FieldOgImage)
-	}
-	if m.page_url != nil {
-		fields = append(fields, link.FieldPageURL)
-	}
-	if m.note != nil {
-		fields = append(fields, link.FieldNote)
+		fields = append(fields, site.FieldDomain)
 	}
-	if m.tags != nil {
-		fields = append(fields, link.FieldTags)
+	if m.name != nil {
+		fields = append(fields, site.FieldName)
 	}
-	if m.metadata != nil {
-		fields = append(fields, link.FieldMetadata)
+	if m.icon_url != nil {
+		fields = append(fields, site.FieldIconURL)
 	}
-	if m.saved_at != nil {
-		fields = append(fields, link.FieldSavedAt)
+	if m.fetched_at != nil {
+		fields = append(fields, site.FieldFetchedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, link.FieldCreatedAt)
+		fields = append(fields, site.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, site.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -786,32 +5304,20 @@ func (m *LinkMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LinkMutation) Field(name string) (ent.Value, bool) {
+func (m *SiteMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case link.FieldUserID:
-		return m.UserID()
-	case link.FieldURL:
-		return m.URL()
-	case link.FieldTitle:
-		return m.Title()
-	case link.FieldDescription:
-		return m.Description()
-	case link.FieldDomain:
+	case site.FieldDomain:
 		return m.Domain()
-	case link.FieldOgImage:
-		return m.OgImage()
-	case link.FieldPageURL:
-		return m.PageURL()
-	case link.FieldNote:
-		return m.Note()
-	case link.FieldTags:
-		return m.Tags()
-	case link.FieldMetadata:
-		return m.Metadata()
-	case link.FieldSavedAt:
-		return m.SavedAt()
-	case link.FieldCreatedAt:
+	case site.FieldName:
+		return m.Name()
+	case site.FieldIconURL:
+		return m.IconURL()
+	case site.FieldFetchedAt:
+		return m.FetchedAt()
+	case site.FieldCreatedAt:
 		return m.CreatedAt()
+	case site.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -819,309 +5325,243 @@ func (m *LinkMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SiteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case link.FieldUserID:
-		return m.OldUserID(ctx)
-	case link.FieldURL:
-		return m.OldURL(ctx)
-	case link.FieldTitle:
-		return m.OldTitle(ctx)
-	case link.FieldDescription:
-		return m.OldDescription(ctx)
-	case link.FieldDomain:
+	case site.FieldDomain:
 		return m.OldDomain(ctx)
-	case link.FieldOgImage:
-		return m.OldOgImage(ctx)
-	case link.FieldPageURL:
-		return m.OldPageURL(ctx)
-	case link.FieldNote:
-		return m.OldNote(ctx)
-	case link.FieldTags:
-		return m.OldTags(ctx)
-	case link.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case link.FieldSavedAt:
-		return m.OldSavedAt(ctx)
-	case link.FieldCreatedAt:
+	case site.FieldName:
+		return m.OldName(ctx)
+	case site.FieldIconURL:
+		return m.OldIconURL(ctx)
+	case site.FieldFetchedAt:
+		return m.OldFetchedAt(ctx)
+	case site.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case site.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Link field %s", name)
+	return nil, fmt.Errorf("unknown Site field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LinkMutation) SetField(name string, value ent.Value) error {
+func (m *SiteMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case link.FieldUserID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case link.FieldURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetURL(v)
-		return nil
-	case link.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case link.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case link.FieldDomain:
+	case site.FieldDomain:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDomain(v)
 		return nil
-	case link.FieldOgImage:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOgImage(v)
-		return nil
-	case link.FieldPageURL:
+	case site.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPageURL(v)
+		m.SetName(v)
 		return nil
-	case link.FieldNote:
+	case site.FieldIconURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNote(v)
-		return nil
-	case link.FieldTags:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTags(v)
+		m.SetIconURL(v)
 		return nil
-	case link.FieldMetadata:
-		v, ok := value.(map[string]interface{})
+	case site.FieldFetchedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetFetchedAt(v)
 		return nil
-	case link.FieldSavedAt:
+	case site.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSavedAt(v)
+		m.SetCreatedAt(v)
 		return nil
-	case link.FieldCreatedAt:
+	case site.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Link field %s", name)
+	return fmt.Errorf("unknown Site field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LinkMutation) AddedFields() []string {
+func (m *SiteMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LinkMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SiteMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LinkMutation) AddField(name string, value ent.Value) error {
+func (m *SiteMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Link numeric field %s", name)
+	return fmt.Errorf("unknown Site numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LinkMutation) ClearedFields() []string {
+func (m *SiteMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(link.FieldUserID) {
-		fields = append(fields, link.FieldUserID)
-	}
-	if m.FieldCleared(link.FieldTitle) {
-		fields = append(fields, link.FieldTitle)
-	}
-	if m.FieldCleared(link.FieldDescription) {
-		fields = append(fields, link.FieldDescription)
-	}
-	if m.FieldCleared(link.FieldDomain) {
-		fields = append(fields, link.FieldDomain)
-	}
-	if m.FieldCleared(link.FieldOgImage) {
-		fields = append(fields, link.FieldOgImage)
+	if m.FieldCleared(site.FieldName) {
+		fields = append(fields, site.FieldName)
 	}
-	if m.FieldCleared(link.FieldPageURL) {
-		fields = append(fields, link.FieldPageURL)
-	}
-	if m.FieldCleared(link.FieldNote) {
-		fields = append(fields, link.FieldNote)
+	if m.FieldCleared(site.FieldIconURL) {
+		fields = append(fields, site.FieldIconURL)
 	}
-	if m.FieldCleared(link.FieldTags) {
-		fields = append(fields, link.FieldTags)
+	if m.FieldCleared(site.FieldFetchedAt) {
+		fields = append(fields, site.FieldFetchedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LinkMutation) FieldCleared(name string) bool {
+func (m *SiteMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LinkMutation) ClearField(name string) error {
+func (m *SiteMutation) ClearField(name string) error {
 	switch name {
-	case link.FieldUserID:
-		m.ClearUserID()
-		return nil
-	case link.FieldTitle:
-		m.ClearTitle()
-		return nil
-	case link.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case link.FieldDomain:
-		m.ClearDomain()
+	case site.FieldName:
+		m.ClearName()
 		return nil
-	case link.FieldOgImage:
-		m.ClearOgImage()
-		return nil
-	case link.FieldPageURL:
-		m.ClearPageURL()
-		return nil
-	case link.FieldNote:
-		m.ClearNote()
+	case site.FieldIconURL:
+		m.ClearIconURL()
 		return nil
-	case link.FieldTags:
-		m.ClearTags()
+	case site.FieldFetchedAt:
+		m.ClearFetchedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Link nullable field %s", name)
+	return fmt.Errorf("unknown Site nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LinkMutation) ResetField(name string) error {
+func (m *SiteMutation) ResetField(name string) error {
 	switch name {
-	case link.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case link.FieldURL:
-		m.ResetURL()
-		return nil
-	case link.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case link.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case link.FieldDomain:
+	case site.FieldDomain:
 		m.ResetDomain()
 		return nil
-	case link.FieldOgImage:
-		m.ResetOgImage()
-		return nil
-	case link.FieldPageURL:
-		m.ResetPageURL()
-		return nil
-	case link.FieldNote:
-		m.ResetNote()
-		return nil
-	case link.FieldTags:
-		m.ResetTags()
+	case site.FieldName:
+		m.ResetName()
 		return nil
-	case link.FieldMetadata:
-		m.ResetMetadata()
+	case site.FieldIconURL:
+		m.ResetIconURL()
 		return nil
-	case link.FieldSavedAt:
-		m.ResetSavedAt()
+	case site.FieldFetchedAt:
+		m.ResetFetchedAt()
 		return nil
-	case link.FieldCreatedAt:
+	case site.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case site.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown Link field %s", name)
+	return fmt.Errorf("unknown Site field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LinkMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SiteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.links != nil {
+		edges = append(edges, site.EdgeLinks)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LinkMutation) AddedIDs(name string) []ent.Value {
+func (m *SiteMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case site.EdgeLinks:
+		ids := make([]ent.Value, 0, len(m.links))
+		for id := range m.links {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LinkMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SiteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedlinks != nil {
+		edges = append(edges, site.EdgeLinks)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LinkMutation) RemovedIDs(name string) []ent.Value {
+func (m *SiteMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case site.EdgeLinks:
+		ids := make([]ent.Value, 0, len(m.removedlinks))
+		for id := range m.removedlinks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LinkMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SiteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedlinks {
+		edges = append(edges, site.EdgeLinks)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LinkMutation) EdgeCleared(name string) bool {
+func (m *SiteMutation) EdgeCleared(name string) bool {
+	switch name {
+	case site.EdgeLinks:
+		return m.clearedlinks
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LinkMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Link unique edge %s", name)
+func (m *SiteMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Site unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LinkMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Link edge %s", name)
+func (m *SiteMutation) ResetEdge(name string) error {
+	switch name {
+	case site.EdgeLinks:
+		m.ResetLinks()
+		return nil
+	}
+	return fmt.Errorf("unknown Site edge %s", name)
 }
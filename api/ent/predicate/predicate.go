@@ -6,5 +6,20 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Job is the predicate function for job builders.
+type Job func(*sql.Selector)
+
 // Link is the predicate function for link builders.
 type Link func(*sql.Selector)
+
+// LinkCheck is the predicate function for linkcheck builders.
+type LinkCheck func(*sql.Selector)
+
+// LinkContent is the predicate function for linkcontent builders.
+type LinkContent func(*sql.Selector)
+
+// MetadataCache is the predicate function for metadatacache builders.
+type MetadataCache func(*sql.Selector)
+
+// Site is the predicate function for site builders.
+type Site func(*sql.Selector)
@@ -6,14 +6,47 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lvncer/quicklinks/api/ent/job"
 	"github.com/lvncer/quicklinks/api/ent/link"
+	"github.com/lvncer/quicklinks/api/ent/linkcheck"
+	"github.com/lvncer/quicklinks/api/ent/linkcontent"
+	"github.com/lvncer/quicklinks/api/ent/metadatacache"
 	"github.com/lvncer/quicklinks/api/ent/schema"
+	"github.com/lvncer/quicklinks/api/ent/site"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	jobFields := schema.Job{}.Fields()
+	_ = jobFields
+	// jobDescKind is the schema descriptor for kind field.
+	jobDescKind := jobFields[1].Descriptor()
+	// job.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	job.KindValidator = jobDescKind.Validators[0].(func(string) error)
+	// jobDescAttempts is the schema descriptor for attempts field.
+	jobDescAttempts := jobFields[4].Descriptor()
+	// job.DefaultAttempts holds the default value on creation for the attempts field.
+	job.DefaultAttempts = jobDescAttempts.Default.(int)
+	// jobDescRunAt is the schema descriptor for run_at field.
+	jobDescRunAt := jobFields[6].Descriptor()
+	// job.DefaultRunAt holds the default value on creation for the run_at field.
+	job.DefaultRunAt = jobDescRunAt.Default.(func() time.Time)
+	// jobDescCreatedAt is the schema descriptor for created_at field.
+	jobDescCreatedAt := jobFields[8].Descriptor()
+	// job.DefaultCreatedAt holds the default value on creation for the created_at field.
+	job.DefaultCreatedAt = jobDescCreatedAt.Default.(func() time.Time)
+	// jobDescUpdatedAt is the schema descriptor for updated_at field.
+	jobDescUpdatedAt := jobFields[9].Descriptor()
+	// job.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	job.DefaultUpdatedAt = jobDescUpdatedAt.Default.(func() time.Time)
+	// job.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	job.UpdateDefaultUpdatedAt = jobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// jobDescID is the schema descriptor for id field.
+	jobDescID := jobFields[0].Descriptor()
+	// job.DefaultID holds the default value on creation for the id field.
+	job.DefaultID = jobDescID.Default.(func() uuid.UUID)
 	linkFields := schema.Link{}.Fields()
 	_ = linkFields
 	// linkDescURL is the schema descriptor for url field.
@@ -21,19 +54,101 @@ func init() {
 	// link.URLValidator is a validator for the "url" field. It is called by the builders before save.
 	link.URLValidator = linkDescURL.Validators[0].(func(string) error)
 	// linkDescMetadata is the schema descriptor for metadata field.
-	linkDescMetadata := linkFields[10].Descriptor()
+	linkDescMetadata := linkFields[11].Descriptor()
 	// link.DefaultMetadata holds the default value on creation for the metadata field.
 	link.DefaultMetadata = linkDescMetadata.Default.(map[string]interface{})
 	// linkDescSavedAt is the schema descriptor for saved_at field.
-	linkDescSavedAt := linkFields[11].Descriptor()
+	linkDescSavedAt := linkFields[12].Descriptor()
 	// link.DefaultSavedAt holds the default value on creation for the saved_at field.
 	link.DefaultSavedAt = linkDescSavedAt.Default.(func() time.Time)
 	// linkDescCreatedAt is the schema descriptor for created_at field.
-	linkDescCreatedAt := linkFields[12].Descriptor()
+	linkDescCreatedAt := linkFields[13].Descriptor()
 	// link.DefaultCreatedAt holds the default value on creation for the created_at field.
 	link.DefaultCreatedAt = linkDescCreatedAt.Default.(func() time.Time)
 	// linkDescID is the schema descriptor for id field.
 	linkDescID := linkFields[0].Descriptor()
 	// link.DefaultID holds the default value on creation for the id field.
 	link.DefaultID = linkDescID.Default.(func() uuid.UUID)
+	linkcheckFields := schema.LinkCheck{}.Fields()
+	_ = linkcheckFields
+	// linkcheckDescCheckedAt is the schema descriptor for checked_at field.
+	linkcheckDescCheckedAt := linkcheckFields[6].Descriptor()
+	// linkcheck.DefaultCheckedAt holds the default value on creation for the checked_at field.
+	linkcheck.DefaultCheckedAt = linkcheckDescCheckedAt.Default.(func() time.Time)
+	// linkcheckDescID is the schema descriptor for id field.
+	linkcheckDescID := linkcheckFields[0].Descriptor()
+	// linkcheck.DefaultID holds the default value on creation for the id field.
+	linkcheck.DefaultID = linkcheckDescID.Default.(func() uuid.UUID)
+	linkcontentFields := schema.LinkContent{}.Fields()
+	_ = linkcontentFields
+	// linkcontentDescWordCount is the schema descriptor for word_count field.
+	linkcontentDescWordCount := linkcontentFields[3].Descriptor()
+	// linkcontent.DefaultWordCount holds the default value on creation for the word_count field.
+	linkcontent.DefaultWordCount = linkcontentDescWordCount.Default.(int)
+	// linkcontentDescReadingMinutes is the schema descriptor for reading_minutes field.
+	linkcontentDescReadingMinutes := linkcontentFields[4].Descriptor()
+	// linkcontent.DefaultReadingMinutes holds the default value on creation for the reading_minutes field.
+	linkcontent.DefaultReadingMinutes = linkcontentDescReadingMinutes.Default.(int)
+	// linkcontentDescCreatedAt is the schema descriptor for created_at field.
+	linkcontentDescCreatedAt := linkcontentFields[6].Descriptor()
+	// linkcontent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	linkcontent.DefaultCreatedAt = linkcontentDescCreatedAt.Default.(func() time.Time)
+	// linkcontentDescUpdatedAt is the schema descriptor for updated_at field.
+	linkcontentDescUpdatedAt := linkcontentFields[7].Descriptor()
+	// linkcontent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	linkcontent.DefaultUpdatedAt = linkcontentDescUpdatedAt.Default.(func() time.Time)
+	// linkcontent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	linkcontent.UpdateDefaultUpdatedAt = linkcontentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// linkcontentDescID is the schema descriptor for id field.
+	linkcontentDescID := linkcontentFields[0].Descriptor()
+	// linkcontent.DefaultID holds the default value on creation for the id field.
+	linkcontent.DefaultID = linkcontentDescID.Default.(func() uuid.UUID)
+	metadatacacheFields := schema.MetadataCache{}.Fields()
+	_ = metadatacacheFields
+	// metadatacacheDescCanonicalURL is the schema descriptor for canonical_url field.
+	metadatacacheDescCanonicalURL := metadatacacheFields[1].Descriptor()
+	// metadatacache.CanonicalURLValidator is a validator for the "canonical_url" field. It is called by the builders before save.
+	metadatacache.CanonicalURLValidator = metadatacacheDescCanonicalURL.Validators[0].(func(string) error)
+	// metadatacacheDescData is the schema descriptor for data field.
+	metadatacacheDescData := metadatacacheFields[2].Descriptor()
+	// metadatacache.DefaultData holds the default value on creation for the data field.
+	metadatacache.DefaultData = metadatacacheDescData.Default.(map[string]interface{})
+	// metadatacacheDescNegative is the schema descriptor for negative field.
+	metadatacacheDescNegative := metadatacacheFields[3].Descriptor()
+	// metadatacache.DefaultNegative holds the default value on creation for the negative field.
+	metadatacache.DefaultNegative = metadatacacheDescNegative.Default.(bool)
+	// metadatacacheDescCreatedAt is the schema descriptor for created_at field.
+	metadatacacheDescCreatedAt := metadatacacheFields[5].Descriptor()
+	// metadatacache.DefaultCreatedAt holds the default value on creation for the created_at field.
+	metadatacache.DefaultCreatedAt = metadatacacheDescCreatedAt.Default.(func() time.Time)
+	// metadatacacheDescUpdatedAt is the schema descriptor for updated_at field.
+	metadatacacheDescUpdatedAt := metadatacacheFields[6].Descriptor()
+	// metadatacache.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	metadatacache.DefaultUpdatedAt = metadatacacheDescUpdatedAt.Default.(func() time.Time)
+	// metadatacache.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	metadatacache.UpdateDefaultUpdatedAt = metadatacacheDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// metadatacacheDescID is the schema descriptor for id field.
+	metadatacacheDescID := metadatacacheFields[0].Descriptor()
+	// metadatacache.DefaultID holds the default value on creation for the id field.
+	metadatacache.DefaultID = metadatacacheDescID.Default.(func() uuid.UUID)
+	siteFields := schema.Site{}.Fields()
+	_ = siteFields
+	// siteDescDomain is the schema descriptor for domain field.
+	siteDescDomain := siteFields[1].Descriptor()
+	// site.DomainValidator is a validator for the "domain" field. It is called by the builders before save.
+	site.DomainValidator = siteDescDomain.Validators[0].(func(string) error)
+	// siteDescCreatedAt is the schema descriptor for created_at field.
+	siteDescCreatedAt := siteFields[5].Descriptor()
+	// site.DefaultCreatedAt holds the default value on creation for the created_at field.
+	site.DefaultCreatedAt = siteDescCreatedAt.Default.(func() time.Time)
+	// siteDescUpdatedAt is the schema descriptor for updated_at field.
+	siteDescUpdatedAt := siteFields[6].Descriptor()
+	// site.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	site.DefaultUpdatedAt = siteDescUpdatedAt.Default.(func() time.Time)
+	// site.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	site.UpdateDefaultUpdatedAt = siteDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// siteDescID is the schema descriptor for id field.
+	siteDescID := siteFields[0].Descriptor()
+	// site.DefaultID holds the default value on creation for the id field.
+	site.DefaultID = siteDescID.Default.(func() uuid.UUID)
 }
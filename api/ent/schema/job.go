@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Job holds the schema definition for the jobs table, a Postgres-backed
+// background job queue. Workers claim rows with
+// `SELECT ... FOR UPDATE SKIP LOCKED`.
+type Job struct {
+	ent.Schema
+}
+
+// Fields of the Job.
+func (Job) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Annotations(entsql.DefaultExpr("gen_random_uuid()")),
+		// Kind selects the handler, e.g. "fetch_metadata".
+		field.String("kind").
+			NotEmpty().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.UUID("link_id", uuid.UUID{}),
+		field.Enum("status").
+			Values("pending", "running", "done", "failed").
+			Default("pending"),
+		field.Int("attempts").
+			Default(0),
+		field.String("last_error").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		// RunAt is the earliest time the job may be claimed (used for retries).
+		field.Time("run_at").
+			Default(time.Now).
+			Annotations(entsql.DefaultExpr("now()")),
+		// LockedAt is set when a worker claims the job.
+		field.Time("locked_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Annotations(entsql.DefaultExpr("now()")),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Annotations(entsql.DefaultExpr("now()")),
+	}
+}
+
+// Edges of the Job.
+func (Job) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("link", Link.Type).
+			Ref("jobs").
+			Field("link_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Job.
+func (Job) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "run_at").
+			StorageKey("idx_jobs_status_run_at"),
+		index.Fields("link_id").
+			StorageKey("idx_jobs_link_id"),
+		// At most one pending or running job of each kind per link; Enqueue
+		// relies on it with ON CONFLICT DO NOTHING.
+		index.Fields("kind", "link_id").
+			Unique().
+			StorageKey("idx_jobs_kind_link_active").
+			Annotations(entsql.IndexWhere("status IN ('pending', 'running')")),
+	}
+}
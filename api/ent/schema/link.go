@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
@@ -29,6 +30,12 @@ func (Link) Fields() []ent.Field {
 		field.String("url").
 			NotEmpty().
 			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		// Canonical form of url used for duplicate detection (see
+		// service.CanonicalizeURL). NULL for rows saved before it existed.
+		field.String("canonical_url").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
 		field.String("title").
 			Optional().
 			Nillable().
@@ -67,6 +74,44 @@ func (Link) Fields() []ent.Field {
 		field.Time("created_at").
 			Default(time.Now).
 			Annotations(entsql.DefaultExpr("now()")),
+		// Full-text search document over title, description, note and url.
+		// Maintained by the `links_search_vector_update` trigger (see migration
+		// m7); the application never writes it.
+		field.String("search_vector").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "tsvector"}),
+		// The same fields as plain text for substring search of text that
+		// search_vector cannot split into words (Japanese). Maintained by the
+		// same trigger (see migration m16).
+		field.Text("search_text").
+			Optional().
+			Nillable(),
+		// Soft delete marker. Non-nil means the link is in the trash.
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
+		// Site identity for domain; set once the metadata fetch resolved it.
+		field.UUID("site_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Link.
+func (Link) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("site", Site.Type).
+			Ref("links").
+			Field("site_id").
+			Unique(),
+		edge.To("content", LinkContent.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("checks", LinkCheck.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("jobs", Job.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -76,10 +121,26 @@ func (Link) Indexes() []ent.Index {
 		index.Fields("user_id", "saved_at").
 			StorageKey("idx_links_user_saved_at").
 			Annotations(entsql.DescColumns("saved_at")),
+		index.Fields("user_id", "canonical_url").
+			Unique().
+			StorageKey("idx_links_user_canonical_url"),
+		// Cross-user lookups of the same page (e.g. shared page content).
+		index.Fields("canonical_url").
+			StorageKey("idx_links_canonical_url"),
 		index.Fields("domain").
 			StorageKey("idx_links_domain"),
 		index.Fields("tags").
 			StorageKey("idx_links_tags_gin").
 			Annotations(entsql.IndexType("GIN")),
+		index.Fields("search_vector").
+			StorageKey("idx_links_search_vector_gin").
+			Annotations(entsql.IndexType("GIN")),
+		index.Fields("search_text").
+			StorageKey("idx_links_search_text_trgm").
+			Annotations(entsql.IndexType("GIN"), entsql.OpClass("gin_trgm_ops")),
+		index.Fields("deleted_at").
+			StorageKey("idx_links_deleted_at"),
+		index.Fields("site_id").
+			StorageKey("idx_links_site_id"),
 	}
 }
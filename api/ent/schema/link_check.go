@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// LinkCheck holds the schema definition for the link_checks table: the
+// history of periodic health checks of each link's URL. The latest row of a
+// link decides its health.
+type LinkCheck struct {
+	ent.Schema
+}
+
+// Fields of the LinkCheck.
+func (LinkCheck) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Annotations(entsql.DefaultExpr("gen_random_uuid()")),
+		field.UUID("link_id", uuid.UUID{}),
+		// StatusCode is the HTTP status of the final response; NULL when the
+		// request failed without one (DNS, connection, timeout).
+		field.Int("status_code").
+			Optional().
+			Nillable(),
+		// FinalURL is the URL after following redirects.
+		field.String("final_url").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Enum("health").
+			Values("ok", "broken", "redirected", "unknown"),
+		field.String("error").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Time("checked_at").
+			Default(time.Now).
+			Annotations(entsql.DefaultExpr("now()")),
+	}
+}
+
+// Edges of the LinkCheck.
+func (LinkCheck) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("link", Link.Type).
+			Ref("checks").
+			Field("link_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the LinkCheck.
+func (LinkCheck) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("link_id", "checked_at").
+			StorageKey("idx_link_checks_link_checked_at").
+			Annotations(entsql.DescColumns("checked_at")),
+	}
+}
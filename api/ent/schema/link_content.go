@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LinkContent holds the schema definition for the link_contents table: the
+// readable main text of a link's page, kept so the content survives link rot.
+type LinkContent struct {
+	ent.Schema
+}
+
+// Fields of the LinkContent.
+func (LinkContent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Annotations(entsql.DefaultExpr("gen_random_uuid()")),
+		field.UUID("link_id", uuid.UUID{}).
+			Unique(),
+		// Text is the extracted main content as plain text, with paragraphs
+		// separated by blank lines.
+		field.String("text").
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		// WordCount counts words, and each CJK character as one word.
+		field.Int("word_count").
+			Default(0),
+		field.Int("reading_minutes").
+			Default(0),
+		field.Time("extracted_at"),
+		field.Time("created_at").
+			Default(time.Now).
+			Annotations(entsql.DefaultExpr("now()")),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Annotations(entsql.DefaultExpr("now()")),
+	}
+}
+
+// Edges of the LinkContent.
+func (LinkContent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("link", Link.Type).
+			Ref("content").
+			Field("link_id").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// MetadataCache holds the schema definition for the metadata_cache table:
+// fetched page metadata shared across users, keyed by canonical URL.
+type MetadataCache struct {
+	ent.Schema
+}
+
+// Annotations of the MetadataCache.
+func (MetadataCache) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "metadata_cache"},
+	}
+}
+
+// Fields of the MetadataCache.
+func (MetadataCache) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Annotations(entsql.DefaultExpr("gen_random_uuid()")),
+		// See service.CanonicalizeURL.
+		field.String("canonical_url").
+			NotEmpty().
+			Unique().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		// Data is the cached service.Metadata.
+		field.JSON("data", map[string]any{}).
+			Default(map[string]any{}).
+			Annotations(entsql.DefaultExpr("'{}'::jsonb")),
+		// Negative marks a blocked or empty result, cached for a shorter TTL
+		// so the page is not scraped again immediately.
+		field.Bool("negative").
+			Default(false),
+		field.Time("expires_at"),
+		field.Time("created_at").
+			Default(time.Now).
+			Annotations(entsql.DefaultExpr("now()")),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Annotations(entsql.DefaultExpr("now()")),
+	}
+}
+
+// Indexes of the MetadataCache.
+func (MetadataCache) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at").
+			StorageKey("idx_metadata_cache_expires_at"),
+	}
+}
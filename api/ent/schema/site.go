@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Site holds the schema definition for the sites table: the identity
+// (name and favicon) of a domain, stored once and shared by every link on it.
+type Site struct {
+	ent.Schema
+}
+
+// Fields of the Site.
+func (Site) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Annotations(entsql.DefaultExpr("gen_random_uuid()")),
+		// Domain as stored on links (lowercase, without "www.").
+		field.String("domain").
+			NotEmpty().
+			Unique().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("name").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("icon_url").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		// FetchedAt is when the identity was last resolved; used for caching.
+		field.Time("fetched_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Annotations(entsql.DefaultExpr("now()")),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Annotations(entsql.DefaultExpr("now()")),
+	}
+}
+
+// Edges of the Site.
+func (Site) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("links", Link.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
@@ -0,0 +1,626 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lvncer/quicklinks/api/ent/link"
+	"github.com/lvncer/quicklinks/api/ent/predicate"
+	"github.com/lvncer/quicklinks/api/ent/site"
+)
+
+// SiteUpdate is the builder for updating Site entities.
+type SiteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SiteMutation
+}
+
+// Where appends a list predicates to the SiteUpdate builder.
+func (_u *SiteUpdate) Where(ps ...predicate.Site) *SiteUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDomain sets the "domain" field.
+func (_u *SiteUpdate) SetDomain(v string) *SiteUpdate {
+	_u.mutation.SetDomain(v)
+	return _u
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (_u *SiteUpdate) SetNillableDomain(v *string) *SiteUpdate {
+	if v != nil {
+		_u.SetDomain(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SiteUpdate) SetName(v string) *SiteUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SiteUpdate) SetNillableName(v *string) *SiteUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *SiteUpdate) ClearName() *SiteUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetIconURL sets the "icon_url" field.
+func (_u *SiteUpdate) SetIconURL(v string) *SiteUpdate {
+	_u.mutation.SetIconURL(v)
+	return _u
+}
+
+// SetNillableIconURL sets the "icon_url" field if the given value is not nil.
+func (_u *SiteUpdate) SetNillableIconURL(v *string) *SiteUpdate {
+	if v != nil {
+		_u.SetIconURL(*v)
+	}
+	return _u
+}
+
+// ClearIconURL clears the value of the "icon_url" field.
+func (_u *SiteUpdate) ClearIconURL() *SiteUpdate {
+	_u.mutation.ClearIconURL()
+	return _u
+}
+
+// SetFetchedAt sets the "fetched_at" field.
+func (_u *SiteUpdate) SetFetchedAt(v time.Time) *SiteUpdate {
+	_u.mutation.SetFetchedAt(v)
+	return _u
+}
+
+// SetNillableFetchedAt sets the "fetched_at" field if the given value is not nil.
+func (_u *SiteUpdate) SetNillableFetchedAt(v *time.Time) *SiteUpdate {
+	if v != nil {
+		_u.SetFetchedAt(*v)
+	}
+	return _u
+}
+
+// ClearFetchedAt clears the value of the "fetched_at" field.
+func (_u *SiteUpdate) ClearFetchedAt() *SiteUpdate {
+	_u.mutation.ClearFetchedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *SiteUpdate) SetCreatedAt(v time.Time) *SiteUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *SiteUpdate) SetNillableCreatedAt(v *time.Time) *SiteUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SiteUpdate) SetUpdatedAt(v time.Time) *SiteUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddLinkIDs adds the "links" edge to the Link entity by IDs.
+func (_u *SiteUpdate) AddLinkIDs(ids ...uuid.UUID) *SiteUpdate {
+	_u.mutation.AddLinkIDs(ids...)
+	return _u
+}
+
+// AddLinks adds the "links" edges to the Link entity.
+func (_u *SiteUpdate) AddLinks(v ...*Link) *SiteUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLinkIDs(ids...)
+}
+
+// Mutation returns the SiteMutation object of the builder.
+func (_u *SiteUpdate) Mutation() *SiteMutation {
+	return _u.mutation
+}
+
+// ClearLinks clears all "links" edges to the Link entity.
+func (_u *SiteUpdate) ClearLinks() *SiteUpdate {
+	_u.mutation.ClearLinks()
+	return _u
+}
+
+// RemoveLinkIDs removes the "links" edge to Link entities by IDs.
+func (_u *SiteUpdate) RemoveLinkIDs(ids ...uuid.UUID) *SiteUpdate {
+	_u.mutation.RemoveLinkIDs(ids...)
+	return _u
+}
+
+// RemoveLinks removes "links" edges to Link entities.
+func (_u *SiteUpdate) RemoveLinks(v ...*Link) *SiteUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLinkIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SiteUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SiteUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SiteUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SiteUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SiteUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := site.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SiteUpdate) check() error {
+	if v, ok := _u.mutation.Domain(); ok {
+		if err := site.DomainValidator(v); err != nil {
+			return &ValidationError{Name: "domain", err: fmt.Errorf(`ent: validator failed for field "Site.domain": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SiteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(site.Table, site.Columns, sqlgraph.NewFieldSpec(site.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Domain(); ok {
+		_spec.SetField(site.FieldDomain, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(site.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(site.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.IconURL(); ok {
+		_spec.SetField(site.FieldIconURL, field.TypeString, value)
+	}
+	if _u.mutation.IconURLCleared() {
+		_spec.ClearField(site.FieldIconURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.FetchedAt(); ok {
+		_spec.SetField(site.FieldFetchedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FetchedAtCleared() {
+		_spec.ClearField(site.FieldFetchedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(site.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(site.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   site.LinksTable,
+			Columns: []string{site.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(link.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinksIDs(); len(nodes) > 0 && !_u.mutation.LinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   site.LinksTable,
+			Columns: []string{site.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(link.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   site.LinksTable,
+			Columns: []string{site.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(link.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{site.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SiteUpdateOne is the builder for updating a single Site entity.
+type SiteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SiteMutation
+}
+
+// SetDomain sets the "domain" field.
+func (_u *SiteUpdateOne) SetDomain(v string) *SiteUpdateOne {
+	_u.mutation.SetDomain(v)
+	return _u
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (_u *SiteUpdateOne) SetNillableDomain(v *string) *SiteUpdateOne {
+	if v != nil {
+		_u.SetDomain(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SiteUpdateOne) SetName(v string) *SiteUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SiteUpdateOne) SetNillableName(v *string) *SiteUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *SiteUpdateOne) ClearName() *SiteUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetIconURL sets the "icon_url" field.
+func (_u *SiteUpdateOne) SetIconURL(v string) *SiteUpdateOne {
+	_u.mutation.SetIconURL(v)
+	return _u
+}
+
+// SetNillableIconURL sets the "icon_url" field if the given value is not nil.
+func (_u *SiteUpdateOne) SetNillableIconURL(v *string) *SiteUpdateOne {
+	if v != nil {
+		_u.SetIconURL(*v)
+	}
+	return _u
+}
+
+// ClearIconURL clears the value of the "icon_url" field.
+func (_u *SiteUpdateOne) ClearIconURL() *SiteUpdateOne {
+	_u.mutation.ClearIconURL()
+	return _u
+}
+
+// SetFetchedAt sets the "fetched_at" field.
+func (_u *SiteUpdateOne) SetFetchedAt(v time.Time) *SiteUpdateOne {
+	_u.mutation.SetFetchedAt(v)
+	return _u
+}
+
+// SetNillableFetchedAt sets the "fetched_at" field if the given value is not nil.
+func (_u *SiteUpdateOne) SetNillableFetchedAt(v *time.Time) *SiteUpdateOne {
+	if v != nil {
+		_u.SetFetchedAt(*v)
+	}
+	return _u
+}
+
+// ClearFetchedAt clears the value of the "fetched_at" field.
+func (_u *SiteUpdateOne) ClearFetchedAt() *SiteUpdateOne {
+	_u.mutation.ClearFetchedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *SiteUpdateOne) SetCreatedAt(v time.Time) *SiteUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *SiteUpdateOne) SetNillableCreatedAt(v *time.Time) *SiteUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SiteUpdateOne) SetUpdatedAt(v time.Time) *SiteUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddLinkIDs adds the "links" edge to the Link entity by IDs.
+func (_u *SiteUpdateOne) AddLinkIDs(ids ...uuid.UUID) *SiteUpdateOne {
+	_u.mutation.AddLinkIDs(ids...)
+	return _u
+}
+
+// AddLinks adds the "links" edges to the Link entity.
+func (_u *SiteUpdateOne) AddLinks(v ...*Link) *SiteUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLinkIDs(ids...)
+}
+
+// Mutation returns the SiteMutation object of the builder.
+func (_u *SiteUpdateOne) Mutation() *SiteMutation {
+	return _u.mutation
+}
+
+// ClearLinks clears all "links" edges to the Link entity.
+func (_u *SiteUpdateOne) ClearLinks() *SiteUpdateOne {
+	_u.mutation.ClearLinks()
+	return _u
+}
+
+// RemoveLinkIDs removes the "links" edge to Link entities by IDs.
+func (_u *SiteUpdateOne) RemoveLinkIDs(ids ...uuid.UUID) *SiteUpdateOne {
+	_u.mutation.RemoveLinkIDs(ids...)
+	return _u
+}
+
+// RemoveLinks removes "links" edges to Link entities.
+func (_u *SiteUpdateOne) RemoveLinks(v ...*Link) *SiteUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLinkIDs(ids...)
+}
+
+// Where appends a list predicates to the SiteUpdate builder.
+func (_u *SiteUpdateOne) Where(ps ...predicate.Site) *SiteUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SiteUpdateOne) Select(field string, fields ...string) *SiteUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Site entity.
+func (_u *SiteUpdateOne) Save(ctx context.Context) (*Site, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SiteUpdateOne) SaveX(ctx context.Context) *Site {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SiteUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SiteUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SiteUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := site.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SiteUpdateOne) check() error {
+	if v, ok := _u.mutation.Domain(); ok {
+		if err := site.DomainValidator(v); err != nil {
+			return &ValidationError{Name: "domain", err: fmt.Errorf(`ent: validator failed for field "Site.domain": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SiteUpdateOne) sqlSave(ctx context.Context) (_node *Site, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(site.Table, site.Columns, sqlgraph.NewFieldSpec(site.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Site.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, site.FieldID)
+		for _, f := range fields {
+			if !site.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != site.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Domain(); ok {
+		_spec.SetField(site.FieldDomain, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(site.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(site.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.IconURL(); ok {
+		_spec.SetField(site.FieldIconURL, field.TypeString, value)
+	}
+	if _u.mutation.IconURLCleared() {
+		_spec.ClearField(site.FieldIconURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.FetchedAt(); ok {
+		_spec.SetField(site.FieldFetchedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FetchedAtCleared() {
+		_spec.ClearField(site.FieldFetchedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(site.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(site.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   site.LinksTable,
+			Columns: []string{site.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(link.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinksIDs(); len(nodes) > 0 && !_u.mutation.LinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   site.LinksTable,
+			Columns: []string{site.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(link.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   site.LinksTable,
+			Columns: []string{site.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(link.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Site{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{site.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
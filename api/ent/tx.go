@@ -12,8 +12,18 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
 	// Link is the client for interacting with the Link builders.
 	Link *LinkClient
+	// LinkCheck is the client for interacting with the LinkCheck builders.
+	LinkCheck *LinkCheckClient
+	// LinkContent is the client for interacting with the LinkContent builders.
+	LinkContent *LinkContentClient
+	// MetadataCache is the client for interacting with the MetadataCache builders.
+	MetadataCache *MetadataCacheClient
+	// Site is the client for interacting with the Site builders.
+	Site *SiteClient
 
 	// lazily loaded.
 	client     *Client
@@ -145,7 +155,12 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Job = NewJobClient(tx.config)
 	tx.Link = NewLinkClient(tx.config)
+	tx.LinkCheck = NewLinkCheckClient(tx.config)
+	tx.LinkContent = NewLinkContentClient(tx.config)
+	tx.MetadataCache = NewMetadataCacheClient(tx.config)
+	tx.Site = NewSiteClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -155,7 +170,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Link.QueryXXX(), the query will be executed
+// applies a query, for example: Job.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
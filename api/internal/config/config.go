@@ -1,9 +1,13 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/url"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type Config struct {
@@ -12,6 +16,71 @@ type Config struct {
 	ClerkSecretKey string
 	Environment    string
 	AllowedOrigins []string
+	// TrashRetention is how long soft-deleted links are kept before the sweeper
+	// purges them. Zero disables the sweeper.
+	TrashRetention     time.Duration
+	TrashSweepInterval time.Duration
+	// Tag normalization limits applied when links are saved (0 = unlimited).
+	TagMaxLength int
+	TagMaxCount  int
+	// Background metadata fetching (job queue workers).
+	MetadataWorkers         int
+	MetadataJobMaxAttempts  int
+	MetadataJobPollInterval time.Duration
+	// MetadataRefetchInterval is how often links with blocked/empty metadata
+	// are checked for a re-scrape. Zero disables re-scraping.
+	MetadataRefetchInterval time.Duration
+	// JobRetention is how long completed jobs are kept. Zero keeps them.
+	JobRetention time.Duration
+	// SiteRefreshInterval is how long a resolved site identity (name and
+	// favicon) is reused before the domain is fetched again.
+	SiteRefreshInterval time.Duration
+	// MetadataCacheTTL is how long fetched metadata is shared across saves
+	// and previews of the same canonical URL. Zero disables the cache.
+	MetadataCacheTTL time.Duration
+	// MetadataCacheNegativeTTL is the TTL for blocked or empty results. Zero
+	// disables caching them.
+	MetadataCacheNegativeTTL time.Duration
+	// BlobDir is the local directory backing the blob store (image cache).
+	BlobDir string
+	// ImageMaxBytes caps the size of a source image fetched by the image proxy.
+	ImageMaxBytes int64
+	// Page snapshots are opt-in: every link of ArchiveUsers, and links tagged
+	// with any of ArchiveTags, are archived. Both empty disables archiving.
+	ArchiveUsers    []string
+	ArchiveTags     []string
+	ArchiveMaxBytes int64
+	// LinkCheckInterval is how often each saved link is checked for being
+	// broken or redirected. Zero disables link checking.
+	LinkCheckInterval time.Duration
+	// Server-side fetch policy (see service.FetchPolicy).
+	FetchTimeout      time.Duration
+	FetchTotalTimeout time.Duration
+	// FetchUserAgent empty means the built-in browser-like user agent.
+	FetchUserAgent      string
+	FetchAcceptLanguage string
+	// FetchFallbackReaderURL empty disables the reader fallback.
+	FetchFallbackReaderURL string
+	FetchProxyURL          *url.URL
+	// FetchProxyPinAddress resolves hosts locally and tunnels to the
+	// checked IP instead of letting the proxy resolve them.
+	FetchProxyPinAddress bool
+	FetchDomainOverrides map[string]FetchDomainOverride
+	// Per-host fetch throttling (0 disables each limit).
+	FetchHostRate        float64
+	FetchHostBurst       int
+	FetchHostConcurrency int
+	// FetchRespectRobots enables robots.txt checks before fetching a page.
+	FetchRespectRobots   bool
+	FetchRobotsUserAgent string
+	FetchRobotsCacheTTL  time.Duration
+}
+
+// FetchDomainOverride is one entry of FETCH_DOMAIN_OVERRIDES.
+type FetchDomainOverride struct {
+	UserAgent      string `json:"user_agent"`
+	AcceptLanguage string `json:"accept_language"`
+	Cookie         string `json:"cookie"`
 }
 
 func Load() (*Config, error) {
@@ -19,7 +88,120 @@ func Load() (*Config, error) {
 	dbURL := os.Getenv("DATABASE_URL")
 	clerkSecret := os.Getenv("CLERK_SECRET_KEY")
 	env := getenv("ENVIRONMENT", "development")
-	origins := parseAllowedOrigins(os.Getenv("ALLOWED_ORIGINS"))
+	origins := parseList(os.Getenv("ALLOWED_ORIGINS"))
+
+	retentionDays, err := strconv.Atoi(getenv("TRASH_RETENTION_DAYS", "30"))
+	if err != nil || retentionDays < 0 {
+		return nil, fmt.Errorf("TRASH_RETENTION_DAYS must be a non-negative integer")
+	}
+	sweepInterval, err := time.ParseDuration(getenv("TRASH_SWEEP_INTERVAL", "1h"))
+	if err != nil || sweepInterval <= 0 {
+		return nil, fmt.Errorf("TRASH_SWEEP_INTERVAL must be a positive duration (e.g. 1h)")
+	}
+	tagMaxLength, err := strconv.Atoi(getenv("TAG_MAX_LENGTH", "50"))
+	if err != nil || tagMaxLength < 0 {
+		return nil, fmt.Errorf("TAG_MAX_LENGTH must be a non-negative integer")
+	}
+	tagMaxCount, err := strconv.Atoi(getenv("TAG_MAX_COUNT", "20"))
+	if err != nil || tagMaxCount < 0 {
+		return nil, fmt.Errorf("TAG_MAX_COUNT must be a non-negative integer")
+	}
+	metadataWorkers, err := strconv.Atoi(getenv("METADATA_WORKERS", "2"))
+	if err != nil || metadataWorkers < 0 {
+		return nil, fmt.Errorf("METADATA_WORKERS must be a non-negative integer")
+	}
+	metadataMaxAttempts, err := strconv.Atoi(getenv("METADATA_JOB_MAX_ATTEMPTS", "5"))
+	if err != nil || metadataMaxAttempts < 1 {
+		return nil, fmt.Errorf("METADATA_JOB_MAX_ATTEMPTS must be a positive integer")
+	}
+	metadataPollInterval, err := time.ParseDuration(getenv("METADATA_JOB_POLL_INTERVAL", "2s"))
+	if err != nil || metadataPollInterval <= 0 {
+		return nil, fmt.Errorf("METADATA_JOB_POLL_INTERVAL must be a positive duration (e.g. 2s)")
+	}
+	metadataRefetchInterval, err := time.ParseDuration(getenv("METADATA_REFETCH_INTERVAL", "1h"))
+	if err != nil || metadataRefetchInterval < 0 {
+		return nil, fmt.Errorf("METADATA_REFETCH_INTERVAL must be a non-negative duration (e.g. 1h)")
+	}
+	jobRetention, err := time.ParseDuration(getenv("JOB_RETENTION", "168h"))
+	if err != nil || jobRetention < 0 {
+		return nil, fmt.Errorf("JOB_RETENTION must be a non-negative duration (e.g. 168h)")
+	}
+	siteRefreshInterval, err := time.ParseDuration(getenv("SITE_REFRESH_INTERVAL", "168h"))
+	if err != nil || siteRefreshInterval <= 0 {
+		return nil, fmt.Errorf("SITE_REFRESH_INTERVAL must be a positive duration (e.g. 168h)")
+	}
+	metadataCacheTTL, err := time.ParseDuration(getenv("METADATA_CACHE_TTL", "24h"))
+	if err != nil || metadataCacheTTL < 0 {
+		return nil, fmt.Errorf("METADATA_CACHE_TTL must be a non-negative duration (e.g. 24h)")
+	}
+	metadataCacheNegativeTTL, err := time.ParseDuration(getenv("METADATA_CACHE_NEGATIVE_TTL", "30m"))
+	if err != nil || metadataCacheNegativeTTL < 0 {
+		return nil, fmt.Errorf("METADATA_CACHE_NEGATIVE_TTL must be a non-negative duration (e.g. 30m)")
+	}
+	blobDir := getenv("BLOB_DIR", "data/blobs")
+	imageMaxBytes, err := strconv.ParseInt(getenv("IMAGE_MAX_BYTES", "10485760"), 10, 64)
+	if err != nil || imageMaxBytes < 1 {
+		return nil, fmt.Errorf("IMAGE_MAX_BYTES must be a positive integer")
+	}
+	archiveMaxBytes, err := strconv.ParseInt(getenv("ARCHIVE_MAX_BYTES", "20971520"), 10, 64)
+	if err != nil || archiveMaxBytes < 1 {
+		return nil, fmt.Errorf("ARCHIVE_MAX_BYTES must be a positive integer")
+	}
+	linkCheckInterval, err := time.ParseDuration(getenv("LINK_CHECK_INTERVAL", "168h"))
+	if err != nil || linkCheckInterval < 0 {
+		return nil, fmt.Errorf("LINK_CHECK_INTERVAL must be a non-negative duration (e.g. 168h)")
+	}
+	fetchTimeout, err := time.ParseDuration(getenv("FETCH_TIMEOUT", "10s"))
+	if err != nil || fetchTimeout <= 0 {
+		return nil, fmt.Errorf("FETCH_TIMEOUT must be a positive duration (e.g. 10s)")
+	}
+	fetchTotalTimeout, err := time.ParseDuration(getenv("FETCH_TOTAL_TIMEOUT", "15s"))
+	if err != nil || fetchTotalTimeout <= 0 {
+		return nil, fmt.Errorf("FETCH_TOTAL_TIMEOUT must be a positive duration (e.g. 15s)")
+	}
+	fallbackReaderURL := strings.TrimSpace(getenv("FETCH_FALLBACK_READER_URL", "https://r.jina.ai/"))
+	if fallbackReaderURL != "" {
+		if u, err := url.Parse(fallbackReaderURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("FETCH_FALLBACK_READER_URL must be an http(s) URL or empty")
+		}
+	}
+	var proxyURL *url.URL
+	if raw := strings.TrimSpace(firstEnv("HTTPS_PROXY", "https_proxy")); raw != "" {
+		proxyURL, err = url.Parse(raw)
+		if err != nil || (proxyURL.Scheme != "http" && proxyURL.Scheme != "https") || proxyURL.Host == "" {
+			return nil, fmt.Errorf("HTTPS_PROXY must be an http(s) proxy URL (e.g. http://proxy.internal:3128)")
+		}
+	}
+	proxyPinAddress, err := strconv.ParseBool(getenv("FETCH_PROXY_PIN_ADDRESS", "false"))
+	if err != nil {
+		return nil, fmt.Errorf("FETCH_PROXY_PIN_ADDRESS must be true or false")
+	}
+	var domainOverrides map[string]FetchDomainOverride
+	if raw := strings.TrimSpace(getenv("FETCH_DOMAIN_OVERRIDES", "")); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &domainOverrides); err != nil {
+			return nil, fmt.Errorf("FETCH_DOMAIN_OVERRIDES must be a JSON object keyed by domain: %w", err)
+		}
+	}
+	hostRate, err := strconv.ParseFloat(getenv("FETCH_HOST_RATE", "1"), 64)
+	if err != nil || hostRate < 0 {
+		return nil, fmt.Errorf("FETCH_HOST_RATE must be a non-negative number (requests per second)")
+	}
+	hostBurst, err := strconv.Atoi(getenv("FETCH_HOST_BURST", "3"))
+	if err != nil || hostBurst < 0 {
+		return nil, fmt.Errorf("FETCH_HOST_BURST must be a non-negative integer")
+	}
+	hostConcurrency, err := strconv.Atoi(getenv("FETCH_HOST_CONCURRENCY", "2"))
+	if err != nil || hostConcurrency < 0 {
+		return nil, fmt.Errorf("FETCH_HOST_CONCURRENCY must be a non-negative integer")
+	}
+	respectRobots, err := strconv.ParseBool(getenv("FETCH_RESPECT_ROBOTS", "false"))
+	if err != nil {
+		return nil, fmt.Errorf("FETCH_RESPECT_ROBOTS must be true or false")
+	}
+	robotsCacheTTL, err := time.ParseDuration(getenv("FETCH_ROBOTS_CACHE_TTL", "24h"))
+	if err != nil || robotsCacheTTL <= 0 {
+		return nil, fmt.Errorf("FETCH_ROBOTS_CACHE_TTL must be a positive duration (e.g. 24h)")
+	}
 
 	if dbURL == "" {
 		return nil, fmt.Errorf("DATABASE_URL is required")
@@ -34,12 +216,54 @@ func Load() (*Config, error) {
 		ClerkSecretKey: clerkSecret,
 		Environment:    env,
 		AllowedOrigins: origins,
+
+		TrashRetention:     time.Duration(retentionDays) * 24 * time.Hour,
+		TrashSweepInterval: sweepInterval,
+
+		TagMaxLength: tagMaxLength,
+		TagMaxCount:  tagMaxCount,
+
+		MetadataWorkers:         metadataWorkers,
+		MetadataJobMaxAttempts:  metadataMaxAttempts,
+		MetadataJobPollInterval: metadataPollInterval,
+		MetadataRefetchInterval: metadataRefetchInterval,
+		JobRetention:            jobRetention,
+
+		SiteRefreshInterval: siteRefreshInterval,
+
+		MetadataCacheTTL:         metadataCacheTTL,
+		MetadataCacheNegativeTTL: metadataCacheNegativeTTL,
+
+		BlobDir:       blobDir,
+		ImageMaxBytes: imageMaxBytes,
+
+		ArchiveUsers:    parseList(getenv("ARCHIVE_USERS", "")),
+		ArchiveTags:     parseList(getenv("ARCHIVE_TAGS", "")),
+		ArchiveMaxBytes: archiveMaxBytes,
+
+		LinkCheckInterval: linkCheckInterval,
+
+		FetchTimeout:           fetchTimeout,
+		FetchTotalTimeout:      fetchTotalTimeout,
+		FetchUserAgent:         strings.TrimSpace(getenv("FETCH_USER_AGENT", "")),
+		FetchAcceptLanguage:    strings.TrimSpace(getenv("FETCH_ACCEPT_LANGUAGE", "ja,en-US;q=0.9,en;q=0.8")),
+		FetchFallbackReaderURL: fallbackReaderURL,
+		FetchProxyURL:          proxyURL,
+		FetchProxyPinAddress:   proxyPinAddress,
+		FetchDomainOverrides:   domainOverrides,
+
+		FetchHostRate:        hostRate,
+		FetchHostBurst:       hostBurst,
+		FetchHostConcurrency: hostConcurrency,
+		FetchRespectRobots:   respectRobots,
+		FetchRobotsUserAgent: strings.TrimSpace(getenv("FETCH_ROBOTS_USER_AGENT", "QuickLinks")),
+		FetchRobotsCacheTTL:  robotsCacheTTL,
 	}, nil
 }
 
-// parseAllowedOrigins parses a comma-separated ALLOWED_ORIGINS value into a slice.
+// parseList parses a comma-separated value (e.g. ALLOWED_ORIGINS) into a slice.
 // Empty or whitespace-only entries are ignored.
-func parseAllowedOrigins(raw string) []string {
+func parseList(raw string) []string {
 	if raw == "" {
 		return nil
 	}
@@ -55,6 +279,16 @@ func parseAllowedOrigins(raw string) []string {
 	return res
 }
 
+// firstEnv returns the first non-empty value among keys.
+func firstEnv(keys ...string) string {
+	for _, k := range keys {
+		if v := os.Getenv(k); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
 func getenv(key, def string) string {
 	if v, ok := os.LookupEnv(key); ok {
 		return v
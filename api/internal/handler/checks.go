@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lvncer/quicklinks/api/internal/middleware"
+	"github.com/lvncer/quicklinks/api/internal/repository"
+)
+
+type ChecksHandler struct {
+	links  repository.LinkRepository
+	checks repository.LinkCheckRepository
+}
+
+func NewChecksHandler(links repository.LinkRepository, checks repository.LinkCheckRepository) *ChecksHandler {
+	return &ChecksHandler{links: links, checks: checks}
+}
+
+func (h *ChecksHandler) Register(r *gin.Engine, authMiddleware gin.HandlerFunc) {
+	api := r.Group("/api")
+	api.Use(authMiddleware)
+	{
+		api.GET("/links/:id/checks", h.GetChecks)
+	}
+}
+
+// GetChecks returns the link's health check history, newest first.
+func (h *ChecksHandler) GetChecks(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	// Ownership check; check rows are not user-scoped.
+	l, err := h.links.GetLink(ctx, userID, c.Param("id"))
+	if err != nil {
+		if errors.Is(err, repository.ErrLinkNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "link not found"})
+			return
+		}
+		log.Printf("repository error: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch link"})
+		return
+	}
+
+	checks, err := h.checks.ListLinkChecks(ctx, l.ID, 0)
+	if err != nil {
+		log.Printf("repository error: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch checks"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"checks": checks})
+}
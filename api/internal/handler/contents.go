@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lvncer/quicklinks/api/internal/middleware"
+	"github.com/lvncer/quicklinks/api/internal/repository"
+)
+
+type ContentsHandler struct {
+	links    repository.LinkRepository
+	contents repository.ContentRepository
+}
+
+func NewContentsHandler(links repository.LinkRepository, contents repository.ContentRepository) *ContentsHandler {
+	return &ContentsHandler{links: links, contents: contents}
+}
+
+func (h *ContentsHandler) Register(r *gin.Engine, authMiddleware gin.HandlerFunc) {
+	api := r.Group("/api")
+	api.Use(authMiddleware)
+	{
+		api.GET("/links/:id/content", h.GetContent)
+	}
+}
+
+// GetContent returns the readable text extracted from a link's page.
+func (h *ContentsHandler) GetContent(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	// Ownership check; content rows are not user-scoped.
+	l, err := h.links.GetLink(ctx, userID, c.Param("id"))
+	if err != nil {
+		if errors.Is(err, repository.ErrLinkNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "link not found"})
+			return
+		}
+		log.Printf("repository error: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch link"})
+		return
+	}
+
+	content, err := h.contents.GetLinkContent(ctx, l.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrContentNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "content not found"})
+			return
+		}
+		log.Printf("repository error: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch content"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"content": content})
+}
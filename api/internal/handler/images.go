@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lvncer/quicklinks/api/internal/middleware"
+	"github.com/lvncer/quicklinks/api/internal/repository"
+	"github.com/lvncer/quicklinks/api/internal/service"
+)
+
+// imageCacheControl lets browsers reuse a thumbnail for a day and then
+// revalidate it with If-None-Match. Thumbnails are per-user, so shared
+// caches must not store them.
+const imageCacheControl = "private, max-age=86400"
+
+type ImagesHandler struct {
+	links repository.LinkRepository
+	proxy *service.ImageProxy
+}
+
+func NewImagesHandler(links repository.LinkRepository, proxy *service.ImageProxy) *ImagesHandler {
+	return &ImagesHandler{links: links, proxy: proxy}
+}
+
+func (h *ImagesHandler) Register(r *gin.Engine, authMiddleware gin.HandlerFunc) {
+	api := r.Group("/api")
+	api.Use(authMiddleware)
+	{
+		api.GET("/images/:linkID", h.GetImage)
+	}
+}
+
+func (h *ImagesHandler) GetImage(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	size := service.ThumbnailMedium
+	if v := c.Query("size"); v != "" {
+		s, ok := service.ParseThumbnailSize(v)
+		if !ok {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":  "invalid size",
+				"detail": "size must be s, m or l",
+			})
+			return
+		}
+		size = s
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	l, err := h.links.GetLink(ctx, userID, c.Param("linkID"))
+	if err != nil {
+		if errors.Is(err, repository.ErrLinkNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "link not found"})
+			return
+		}
+		log.Printf("repository error: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch link"})
+		return
+	}
+	if l.OGImage == "" {
+		c.JSON(http.StatusNotFound, gin.H{"error": "link has no image"})
+		return
+	}
+
+	// The first request fetches and resizes the original, which can take
+	// longer than a database round trip.
+	imgCtx, imgCancel := context.WithTimeout(c.Request.Context(), 25*time.Second)
+	defer imgCancel()
+
+	thumb, err := h.proxy.Thumbnail(imgCtx, l.OGImage, size)
+	if err != nil {
+		log.Printf("image proxy error for %s: %v", l.ID, err)
+		switch {
+		case errors.Is(err, service.ErrImageTooLarge):
+			c.JSON(http.StatusBadGateway, gin.H{"error": "image too large"})
+		case errors.Is(err, service.ErrUnsupportedImage):
+			c.JSON(http.StatusBadGateway, gin.H{"error": "unsupported image type"})
+		default:
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to fetch image"})
+		}
+		return
+	}
+
+	c.Header("Cache-Control", imageCacheControl)
+	c.Header("ETag", thumb.ETag)
+	if etagMatches(c.GetHeader("If-None-Match"), thumb.ETag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	c.Data(http.StatusOK, thumb.ContentType, thumb.Data)
+}
+
+// etagMatches reports whether an If-None-Match header lists etag. As RFC
+// 9110 requires for If-None-Match, the comparison is weak (a W/ prefix is
+// ignored), and "*" matches any thumbnail.
+func etagMatches(header, etag string) bool {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
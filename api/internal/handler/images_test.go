@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/jpeg"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lvncer/quicklinks/api/internal/middleware"
+	"github.com/lvncer/quicklinks/api/internal/model"
+	"github.com/lvncer/quicklinks/api/internal/repository"
+	"github.com/lvncer/quicklinks/api/internal/service"
+	"github.com/lvncer/quicklinks/api/internal/storage"
+)
+
+// imageLinks serves one link with an og_image.
+type imageLinks struct {
+	repository.LinkRepository
+}
+
+func (imageLinks) GetLink(_ context.Context, userID, id string) (*model.Link, error) {
+	if userID != "user-1" || id != "link-1" {
+		return nil, repository.ErrLinkNotFound
+	}
+	return &model.Link{ID: id, UserID: userID, OGImage: "https://cdn.example.com/og.png"}, nil
+}
+
+// thumbnailStore holds a thumbnail under every key, so no image is fetched.
+type thumbnailStore struct {
+	storage.BlobStore
+	data []byte
+}
+
+func (s thumbnailStore) Get(context.Context, string) (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(s.data)), nil
+}
+
+func TestGetImageConditional(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var thumb bytes.Buffer
+	if err := jpeg.Encode(&thumb, image.NewGray(image.Rect(0, 0, 8, 8)), nil); err != nil {
+		t.Fatal(err)
+	}
+	h := NewImagesHandler(imageLinks{}, service.NewImageProxy(thumbnailStore{data: thumb.Bytes()}, 1<<20))
+	r := gin.New()
+	h.Register(r, func(c *gin.Context) { c.Set(middleware.ContextKeyUserID, "user-1") })
+
+	get := func(path, ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	first := get("/api/images/link-1?size=s", "")
+	if first.Code != http.StatusOK || !bytes.Equal(first.Body.Bytes(), thumb.Bytes()) {
+		t.Fatalf("GET = %d with %d bytes, want the thumbnail", first.Code, first.Body.Len())
+	}
+	etag := first.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("no ETag")
+	}
+	if got := first.Header().Get("Cache-Control"); got != imageCacheControl {
+		t.Errorf("Cache-Control = %q", got)
+	}
+
+	tests := []struct {
+		ifNoneMatch string
+		want        int
+	}{
+		{etag, http.StatusNotModified},
+		{"W/" + etag, http.StatusNotModified},
+		{`"other", ` + etag, http.StatusNotModified},
+		{"*", http.StatusNotModified},
+		{`"other"`, http.StatusOK},
+	}
+	for _, tt := range tests {
+		w := get("/api/images/link-1?size=s", tt.ifNoneMatch)
+		if w.Code != tt.want {
+			t.Errorf("If-None-Match %s: status %d, want %d", tt.ifNoneMatch, w.Code, tt.want)
+		}
+		if tt.want == http.StatusNotModified {
+			if w.Body.Len() != 0 {
+				t.Errorf("If-None-Match %s: 304 with a %d byte body", tt.ifNoneMatch, w.Body.Len())
+			}
+			if w.Header().Get("ETag") != etag {
+				t.Errorf("If-None-Match %s: ETag = %q, want %q", tt.ifNoneMatch, w.Header().Get("ETag"), etag)
+			}
+		}
+	}
+
+	for path, want := range map[string]int{
+		"/api/images/link-1?size=xl": http.StatusBadRequest,
+		"/api/images/link-2":         http.StatusNotFound,
+	} {
+		if w := get(path, ""); w.Code != want {
+			t.Errorf("GET %s = %d, want %d", path, w.Code, want)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lvncer/quicklinks/api/internal/middleware"
+	"github.com/lvncer/quicklinks/api/internal/service"
+)
+
+// maxImportBytes caps an uploaded import file. Browser exports embed
+// favicons, so they are larger than the bookmarks alone.
+const maxImportBytes = 32 << 20
+
+type ImportsHandler struct {
+	importer *service.LinkImporter
+}
+
+func NewImportsHandler(importer *service.LinkImporter) *ImportsHandler {
+	return &ImportsHandler{importer: importer}
+}
+
+func (h *ImportsHandler) Register(r *gin.Engine, authMiddleware gin.HandlerFunc) {
+	api := r.Group("/api")
+	api.Use(authMiddleware)
+	{
+		api.POST("/import/:format", h.Import)
+	}
+}
+
+// Import saves the bookmarks of an export file in the given format (see
+// service.LookupImporter), sent either as the "file" field of a multipart
+// form or as the raw body.
+func (h *ImportsHandler) Import(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	importer := service.LookupImporter(c.Param("format"))
+	if importer == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":  "unknown import format",
+			"detail": "format must be one of " + strings.Join(service.ImporterNames(), ", "),
+		})
+		return
+	}
+
+	body, err := importFile(c)
+	if err != nil {
+		importFileError(c, err)
+		return
+	}
+	defer body.Close()
+
+	items, err := importer.Parse(body)
+	if err != nil {
+		importFileError(c, err)
+		return
+	}
+
+	// Inserting is batched and does not fetch pages, but large files still
+	// take a while.
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 60*time.Second)
+	defer cancel()
+
+	res, err := h.importer.Import(ctx, userID, items)
+	if err != nil {
+		log.Printf("import error: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":             "import incomplete",
+			"inserted":          res.Inserted,
+			"skipped_duplicate": res.Duplicates,
+			"failed":            res.Failed,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"inserted":          res.Inserted,
+		"skipped_duplicate": res.Duplicates,
+		"failed":            res.Failed,
+	})
+}
+
+// importFile returns the uploaded file, limited to maxImportBytes.
+func importFile(c *gin.Context) (io.ReadCloser, error) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportBytes)
+	if !strings.HasPrefix(c.ContentType(), "multipart/") {
+		return c.Request.Body, nil
+	}
+	fh, err := c.FormFile("file")
+	if err != nil {
+		return nil, err
+	}
+	return fh.Open()
+}
+
+// importFileError responds to an unreadable upload.
+func importFileError(c *gin.Context, err error) {
+	var tooLarge *http.MaxBytesError
+	switch {
+	case errors.As(err, &tooLarge):
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "file too large"})
+	case errors.Is(err, service.ErrInvalidImportFile):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid file", "detail": err.Error()})
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request", "detail": err.Error()})
+	}
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"log"
 	"net/http"
 	"net/url"
@@ -17,12 +18,21 @@ import (
 	"github.com/lvncer/quicklinks/api/internal/service"
 )
 
+// maxBulkRefresh caps how many links one bulk refresh request may queue.
+const maxBulkRefresh = 1000
+
 type LinksHandler struct {
-	repo repository.LinkRepository
+	repo      repository.LinkRepository
+	jobs      repository.JobRepository
+	refresher *service.MetadataRefresher
+	cache     *service.MetadataCache
+	archiver  *service.Archiver
+	images    *service.ImageProxy
+	tags      service.TagNormalizer
 }
 
-func NewLinksHandler(repo repository.LinkRepository) *LinksHandler {
-	return &LinksHandler{repo: repo}
+func NewLinksHandler(repo repository.LinkRepository, jobs repository.JobRepository, refresher *service.MetadataRefresher, cache *service.MetadataCache, archiver *service.Archiver, images *service.ImageProxy, tags service.TagNormalizer) *LinksHandler {
+	return &LinksHandler{repo: repo, jobs: jobs, refresher: refresher, cache: cache, archiver: archiver, images: images, tags: tags}
 }
 
 func (h *LinksHandler) Register(r *gin.Engine, authMiddleware gin.HandlerFunc) {
@@ -31,6 +41,14 @@ func (h *LinksHandler) Register(r *gin.Engine, authMiddleware gin.HandlerFunc) {
 	{
 		api.POST("/links", h.CreateLink)
 		api.GET("/links", h.GetLinks)
+		api.GET("/links/:id", h.GetLink)
+		api.PATCH("/links/:id", h.UpdateLink)
+		api.DELETE("/links/:id", h.DeleteLink)
+		api.POST("/links/:id/refresh", h.RefreshLink)
+		api.POST("/links/refresh", h.RefreshLinks)
+		api.GET("/trash", h.GetTrash)
+		api.POST("/trash/:id/restore", h.RestoreLink)
+		api.DELETE("/trash/:id", h.PurgeLink)
 		api.GET("/og", h.GetOGP)
 	}
 }
@@ -57,10 +75,12 @@ func (h *LinksHandler) CreateLink(c *gin.Context) {
 	domain := parsed.Host
 	domain = strings.TrimPrefix(domain, "www.")
 
-	// Fetch OGP metadata
-	// Note: In production, this should probably be done asynchronously
-	// or in a background job to avoid slowing down the save request.
-	// For MVP, we do it synchronously but with a short timeout inside the service.
+	canonicalURL, err := service.CanonicalizeURL(req.URL)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid url"})
+		return
+	}
+
 	description := strings.TrimSpace(req.Description)
 	ogImage := strings.TrimSpace(req.OGImage)
 
@@ -77,45 +97,37 @@ func (h *LinksHandler) CreateLink(c *gin.Context) {
 		}
 	}
 
-	// If client provided OGP fields are missing, fetch metadata server-side.
-	if description == "" || ogImage == "" {
-		meta, err := service.FetchMetadata(req.URL)
-		if err == nil {
-			if description == "" {
-				description = meta.Description
-			}
-			if ogImage == "" {
-				ogImage = meta.Image
-			}
-			// If title was not provided or is just the URL, use OGP title
-			if req.Title == "" || req.Title == req.URL {
-				if meta.Title != "" {
-					req.Title = meta.Title
-				}
-			}
-		} else {
-			log.Printf("failed to fetch metadata for %s: %v", req.URL, err)
-		}
-	}
-
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
 
-	tags := req.Tags
-	if tags == nil {
-		tags = []string{}
-	}
-
-	id, err := h.repo.CreateLink(ctx, repository.CreateLinkInput{
-		UserID:      userID,
-		URL:         req.URL,
-		Title:       req.Title,
-		Description: description,
-		Domain:      domain,
-		OGImage:     ogImage,
-		PageURL:     req.PageURL,
-		Note:        req.Note,
-		Tags:        tags,
+	// Fill missing fields from a recent fetch of the same page by anyone.
+	title := req.Title
+	cached := h.cache.Lookup(ctx, req.URL)
+	if cached != nil {
+		if title == req.URL && cached.Title != "" {
+			title = cached.Title
+		}
+		if description == "" {
+			description = cached.Description
+		}
+		if ogImage == "" {
+			ogImage = cached.Image
+		}
+	}
+
+	tags := h.tags.Normalize(req.Tags)
+
+	id, duplicate, err := h.repo.CreateLink(ctx, repository.CreateLinkInput{
+		UserID:       userID,
+		URL:          req.URL,
+		CanonicalURL: canonicalURL,
+		Title:        title,
+		Description:  description,
+		Domain:       domain,
+		OGImage:      ogImage,
+		PageURL:      req.PageURL,
+		Note:         req.Note,
+		Tags:         tags,
 	})
 	if err != nil {
 		log.Printf("repository error: %v", err)
@@ -123,7 +135,20 @@ func (h *LinksHandler) CreateLink(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"id": id})
+	// If client-provided OGP fields are missing, fetch metadata server-side
+	// in the background so the save itself stays fast. A cache hit is queued
+	// too: the job applies the cached structured data, embed and site
+	// without scraping again.
+	if !duplicate && (cached != nil || description == "" || ogImage == "" || title == req.URL) {
+		if err := h.jobs.Enqueue(ctx, repository.JobKindFetchMetadata, id); err != nil {
+			// The link is saved; it just keeps the client-provided metadata.
+			log.Printf("failed to enqueue metadata job for %s: %v", id, err)
+		}
+	}
+	// Re-saving an archived page refreshes its snapshot.
+	h.enqueueSnapshot(ctx, userID, id, tags)
+
+	c.JSON(http.StatusOK, gin.H{"id": id, "duplicate": duplicate})
 }
 
 func (h *LinksHandler) GetLinks(c *gin.Context) {
@@ -141,6 +166,19 @@ func (h *LinksHandler) GetLinks(c *gin.Context) {
 		limit = 50
 	}
 
+	var cursor *repository.Cursor
+	if cursorStr := c.Query("cursor"); cursorStr != "" {
+		cur, err := repository.DecodeCursor(cursorStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":  "invalid cursor",
+				"detail": "cursor must be a next_cursor value returned by this endpoint",
+			})
+			return
+		}
+		cursor = cur
+	}
+
 	// Parse filter query parameters.
 	var (
 		from *time.Time
@@ -195,46 +233,443 @@ func (h *LinksHandler) GetLinks(c *gin.Context) {
 		return
 	}
 
+	// Publish-date range (from the page's structured data), same format as from/to.
+	var publishedFrom, publishedTo *time.Time
+	if v := c.Query("published_from"); v != "" {
+		t, err := time.ParseInLocation("2006-01-02", v, loc)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":  "invalid published_from",
+				"detail": "published_from must be YYYY-MM-DD",
+			})
+			return
+		}
+		publishedFrom = &t
+	}
+	if v := c.Query("published_to"); v != "" {
+		t, err := time.ParseInLocation("2006-01-02", v, loc)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":  "invalid published_to",
+				"detail": "published_to must be YYYY-MM-DD",
+			})
+			return
+		}
+		t = t.AddDate(0, 0, 1) // exclusive, like `to`
+		publishedTo = &t
+	}
+
+	var sortKey repository.LinkSort
+	switch v := strings.TrimSpace(c.Query("sort")); v {
+	case "", string(repository.SortSavedAt):
+		sortKey = repository.SortSavedAt
+	case string(repository.SortPublishedAt):
+		sortKey = repository.SortPublishedAt
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":  "invalid sort",
+			"detail": "sort must be saved_at or published_at",
+		})
+		return
+	}
+
+	var health repository.LinkHealth
+	switch v := strings.TrimSpace(c.Query("health")); v {
+	case "":
+	case string(repository.HealthOK), string(repository.HealthBroken), string(repository.HealthRedirected):
+		health = repository.LinkHealth(v)
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":  "invalid health",
+			"detail": "health must be ok, broken or redirected",
+		})
+		return
+	}
+
+	author := strings.TrimSpace(c.Query("author"))
+
 	domain := strings.TrimSpace(c.Query("domain"))
 	domain = strings.TrimPrefix(domain, "www.")
 
-	tags := c.QueryArray("tag")
-	if len(tags) > 0 {
-		uniq := make(map[string]struct{}, len(tags))
-		normalized := make([]string, 0, len(tags))
-		for _, t := range tags {
-			t = strings.TrimSpace(t)
-			if t == "" {
-				continue
-			}
-			if _, ok := uniq[t]; ok {
-				continue
-			}
-			uniq[t] = struct{}{}
-			normalized = append(normalized, t)
+	// `tag=-name` is shorthand for `exclude_tag=name`.
+	var tags, excludeTags []string
+	for _, t := range c.QueryArray("tag") {
+		if strings.HasPrefix(t, "-") {
+			excludeTags = append(excludeTags, strings.TrimPrefix(t, "-"))
+			continue
 		}
-		tags = normalized
+		tags = append(tags, t)
+	}
+	excludeTags = append(excludeTags, c.QueryArray("exclude_tag")...)
+	// Stored tags are normalized, so filter values must be too.
+	tags = h.normalizeTagFilter(tags)
+	excludeTags = h.normalizeTagFilter(excludeTags)
+
+	tagMode := repository.TagMatchAny
+	switch strings.TrimSpace(c.Query("tag_mode")) {
+	case "", "any":
+	case "all":
+		tagMode = repository.TagMatchAll
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":  "invalid tag_mode",
+			"detail": "tag_mode must be all or any",
+		})
+		return
+	}
+
+	q := strings.TrimSpace(c.Query("q"))
+	if r := []rune(q); len(r) > 200 {
+		q = string(r[:200])
 	}
 
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
 
-	links, err := h.repo.ListLinks(ctx, userID, repository.ListLinksFilter{
+	links, next, err := h.repo.ListLinks(ctx, userID, repository.ListLinksFilter{
 		Limit:  limit,
+		Cursor: cursor,
 		From:   from,
 		To:     to,
 		Domain: domain,
 		Tags:   tags,
+		Query:  q,
+
+		TagMode:     tagMode,
+		ExcludeTags: excludeTags,
+
+		Author:        author,
+		PublishedFrom: publishedFrom,
+		PublishedTo:   publishedTo,
+		Sort:          sortKey,
+
+		Health: health,
 	})
 	if err != nil {
+		if errors.Is(err, repository.ErrInvalidCursor) {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":  "invalid cursor",
+				"detail": "cursor was issued for a different q or sort",
+			})
+			return
+		}
 		log.Printf("repository error: %v", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch links"})
 		return
 	}
 
+	// next_cursor is null on the last page.
+	var nextCursor *string
+	if next != "" {
+		nextCursor = &next
+	}
+
+	c.JSON(http.StatusOK, gin.H{"links": links, "next_cursor": nextCursor})
+}
+
+func (h *LinksHandler) GetLink(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	l, err := h.repo.GetLink(ctx, userID, c.Param("id"))
+	if err != nil {
+		if errors.Is(err, repository.ErrLinkNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "link not found"})
+			return
+		}
+		log.Printf("repository error: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch link"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"link": l})
+}
+
+func (h *LinksHandler) UpdateLink(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var req model.LinkUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request", "detail": err.Error()})
+		return
+	}
+
+	input := repository.UpdateLinkInput{
+		Title: req.Title,
+		Note:  req.Note,
+		Tags:  req.Tags,
+	}
+	if req.Description != nil {
+		// Same cap as CreateLink.
+		description := strings.TrimSpace(*req.Description)
+		if len(description) > 2000 {
+			description = description[:2000]
+		}
+		input.Description = &description
+	}
+	if req.Tags != nil {
+		tags := h.tags.Normalize(*req.Tags)
+		input.Tags = &tags
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	l, err := h.repo.UpdateLink(ctx, userID, c.Param("id"), input)
+	if err != nil {
+		if errors.Is(err, repository.ErrLinkNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "link not found"})
+			return
+		}
+		log.Printf("repository error: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update link"})
+		return
+	}
+	// Tagging a link with an archive tag snapshots it.
+	if input.Tags != nil {
+		h.enqueueSnapshot(ctx, userID, l.ID, l.Tags)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"link": l})
+}
+
+func (h *LinksHandler) DeleteLink(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	if err := h.repo.DeleteLink(ctx, userID, c.Param("id")); err != nil {
+		if errors.Is(err, repository.ErrLinkNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "link not found"})
+			return
+		}
+		log.Printf("repository error: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete link"})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RefreshLink re-fetches metadata for one link synchronously and returns the
+// updated link.
+func (h *LinksHandler) RefreshLink(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 20*time.Second)
+	defer cancel()
+
+	// Ownership check; the refresher itself is not user-scoped.
+	l, err := h.repo.GetLink(ctx, userID, c.Param("id"))
+	if err != nil {
+		if errors.Is(err, repository.ErrLinkNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "link not found"})
+			return
+		}
+		log.Printf("repository error: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch link"})
+		return
+	}
+
+	updated, err := h.refresher.Refresh(ctx, l.ID, true)
+	if err != nil {
+		if updated != nil {
+			// The fetch failed but the attempt was recorded.
+			log.Printf("failed to fetch metadata for %s: %v", l.URL, err)
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to fetch metadata", "link": updated})
+			return
+		}
+		log.Printf("repository error: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to refresh link"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"link": updated})
+}
+
+// RefreshLinks queues metadata re-fetches for every link matching the filter
+// (up to maxBulkRefresh).
+func (h *LinksHandler) RefreshLinks(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var req model.LinkBulkRefreshRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request", "detail": err.Error()})
+		return
+	}
+
+	tagMode := repository.TagMatchAny
+	switch strings.TrimSpace(req.TagMode) {
+	case "", "any":
+	case "all":
+		tagMode = repository.TagMatchAll
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":  "invalid tag_mode",
+			"detail": "tag_mode must be all or any",
+		})
+		return
+	}
+
+	filter := repository.ListLinksFilter{
+		Limit:       100,
+		Domain:      strings.TrimPrefix(strings.TrimSpace(req.Domain), "www."),
+		Tags:        h.normalizeTagFilter(req.Tags),
+		TagMode:     tagMode,
+		ExcludeTags: h.normalizeTagFilter(req.ExcludeTags),
+		Query:       strings.TrimSpace(req.Query),
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	queued := 0
+	for queued < maxBulkRefresh {
+		links, next, err := h.repo.ListLinks(ctx, userID, filter)
+		if err != nil {
+			log.Printf("repository error: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to queue refresh", "queued": queued})
+			return
+		}
+		for _, l := range links {
+			if queued >= maxBulkRefresh {
+				break
+			}
+			if err := h.jobs.Enqueue(ctx, repository.JobKindRefreshMetadata, l.ID); err != nil {
+				log.Printf("failed to enqueue metadata job for %s: %v", l.ID, err)
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to queue refresh", "queued": queued})
+				return
+			}
+			queued++
+		}
+		if next == "" {
+			break
+		}
+		cur, err := repository.DecodeCursor(next)
+		if err != nil {
+			break
+		}
+		filter.Cursor = cur
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"queued": queued})
+}
+
+func (h *LinksHandler) GetTrash(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit < 1 || limit > 100 {
+		limit = 50
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	links, err := h.repo.ListTrash(ctx, userID, limit)
+	if err != nil {
+		log.Printf("repository error: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch trash"})
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{"links": links})
 }
 
+func (h *LinksHandler) RestoreLink(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	l, err := h.repo.RestoreLink(ctx, userID, c.Param("id"))
+	if err != nil {
+		if errors.Is(err, repository.ErrLinkNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "link not found"})
+			return
+		}
+		log.Printf("repository error: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to restore link"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"link": l})
+}
+
+func (h *LinksHandler) PurgeLink(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	purged, err := h.repo.PurgeLink(ctx, userID, c.Param("id"))
+	if err != nil {
+		if errors.Is(err, repository.ErrLinkNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "link not found"})
+			return
+		}
+		log.Printf("repository error: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to purge link"})
+		return
+	}
+	if err := h.archiver.Delete(ctx, purged.ID); err != nil {
+		log.Printf("failed to delete snapshot of %s: %v", purged.ID, err)
+	}
+	if purged.OGImage != "" {
+		if err := h.images.Delete(ctx, purged.OGImage); err != nil {
+			log.Printf("failed to delete thumbnails of %s: %v", purged.ID, err)
+		}
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// enqueueSnapshot queues an archive_snapshot job if the user or one of the
+// tags opts in to archiving. The link is saved either way.
+func (h *LinksHandler) enqueueSnapshot(ctx context.Context, userID, linkID string, tags []string) {
+	if !h.archiver.Wants(userID, tags) {
+		return
+	}
+	if err := h.jobs.Enqueue(ctx, repository.JobKindArchiveSnapshot, linkID); err != nil {
+		log.Printf("failed to enqueue snapshot job for %s: %v", linkID, err)
+	}
+}
+
 func (h *LinksHandler) GetOGP(c *gin.Context) {
 	// Authentication is already handled by middleware
 	// No need to check user_id for OGP fetching
@@ -252,9 +687,17 @@ func (h *LinksHandler) GetOGP(c *gin.Context) {
 		return
 	}
 
+	// The cache write happens after the fetch, so allow for the full fetch.
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 20*time.Second)
+	defer cancel()
+
 	// Fetch OGP
-	meta, err := service.FetchMetadata(targetURL)
+	meta, err := h.cache.Fetch(ctx, targetURL, true)
 	if err != nil {
+		if errors.Is(err, service.ErrBlockedAddress) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "url not allowed"})
+			return
+		}
 		log.Printf("failed to fetch metadata: %v", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch metadata"})
 		return
@@ -264,11 +707,25 @@ func (h *LinksHandler) GetOGP(c *gin.Context) {
 	if meta != nil && meta.Source != "" {
 		c.Header("X-QuickLinks-OGP-Source", meta.Source)
 	}
+	if meta.Cached {
+		c.Header("X-QuickLinks-OGP-Cache", "hit")
+	} else {
+		c.Header("X-QuickLinks-OGP-Cache", "miss")
+	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"title":       meta.Title,
 		"description": meta.Description,
 		"image":       meta.Image,
 		"blocked":     meta.Blocked,
+		"embed":       meta.Embed,
 	})
 }
+
+// normalizeTagFilter normalizes tag query values without applying the
+// per-link tag count limit.
+func (h *LinksHandler) normalizeTagFilter(tags []string) []string {
+	n := h.tags
+	n.MaxCount = 0
+	return n.Normalize(tags)
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lvncer/quicklinks/api/internal/middleware"
+	"github.com/lvncer/quicklinks/api/internal/repository"
+)
+
+type SitesHandler struct {
+	sites repository.SiteRepository
+	links repository.LinkRepository
+}
+
+func NewSitesHandler(sites repository.SiteRepository, links repository.LinkRepository) *SitesHandler {
+	return &SitesHandler{sites: sites, links: links}
+}
+
+func (h *SitesHandler) Register(r *gin.Engine, authMiddleware gin.HandlerFunc) {
+	api := r.Group("/api")
+	api.Use(authMiddleware)
+	{
+		api.GET("/sites/:domain", h.GetSite)
+	}
+}
+
+func (h *SitesHandler) GetSite(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	domain := strings.ToLower(strings.TrimSpace(c.Param("domain")))
+	domain = strings.TrimPrefix(domain, "www.")
+	if domain == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid domain"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	// Sites are shared across users; only expose domains the user has saved
+	// links on.
+	links, _, err := h.links.ListLinks(ctx, userID, repository.ListLinksFilter{Limit: 1, Domain: domain})
+	if err != nil {
+		log.Printf("repository error: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch site"})
+		return
+	}
+	if len(links) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "site not found"})
+		return
+	}
+
+	site, err := h.sites.GetSite(ctx, domain)
+	if err != nil {
+		if errors.Is(err, repository.ErrSiteNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "site not found"})
+			return
+		}
+		log.Printf("repository error: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch site"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"site": site})
+}
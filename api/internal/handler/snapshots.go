@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lvncer/quicklinks/api/internal/middleware"
+	"github.com/lvncer/quicklinks/api/internal/repository"
+	"github.com/lvncer/quicklinks/api/internal/service"
+	"github.com/lvncer/quicklinks/api/internal/storage"
+)
+
+// snapshotCSP renders snapshots as inert documents: no scripts, forms or
+// plugins (sandbox), and nothing loaded from the network, so viewing a
+// snapshot neither runs third-party code on our origin nor contacts the
+// original site.
+const snapshotCSP = "sandbox; default-src 'none'; img-src data:; style-src 'unsafe-inline'; font-src data:"
+
+type SnapshotsHandler struct {
+	links    repository.LinkRepository
+	archiver *service.Archiver
+}
+
+func NewSnapshotsHandler(links repository.LinkRepository, archiver *service.Archiver) *SnapshotsHandler {
+	return &SnapshotsHandler{links: links, archiver: archiver}
+}
+
+func (h *SnapshotsHandler) Register(r *gin.Engine, authMiddleware gin.HandlerFunc) {
+	api := r.Group("/api")
+	api.Use(authMiddleware)
+	{
+		api.GET("/links/:id/snapshot", h.GetSnapshot)
+	}
+}
+
+// GetSnapshot serves the archived single-file HTML snapshot of a link.
+func (h *SnapshotsHandler) GetSnapshot(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	l, err := h.links.GetLink(ctx, userID, c.Param("id"))
+	if err != nil {
+		if errors.Is(err, repository.ErrLinkNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "link not found"})
+			return
+		}
+		log.Printf("repository error: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch link"})
+		return
+	}
+
+	rc, err := h.archiver.Snapshot(ctx, l.ID)
+	if err != nil {
+		if errors.Is(err, storage.ErrBlobNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "snapshot not found"})
+			return
+		}
+		log.Printf("blob store error: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch snapshot"})
+		return
+	}
+	defer rc.Close()
+
+	c.DataFromReader(http.StatusOK, -1, "text/html; charset=utf-8", rc, map[string]string{
+		"Content-Security-Policy": snapshotCSP,
+		"X-Content-Type-Options":  "nosniff",
+		"Referrer-Policy":         "no-referrer",
+		"Cache-Control":           "private, no-cache",
+	})
+}
@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lvncer/quicklinks/api/internal/middleware"
+	"github.com/lvncer/quicklinks/api/internal/model"
+	"github.com/lvncer/quicklinks/api/internal/repository"
+	"github.com/lvncer/quicklinks/api/internal/service"
+)
+
+type TagsHandler struct {
+	repo repository.TagRepository
+	tags service.TagNormalizer
+}
+
+func NewTagsHandler(repo repository.TagRepository, tags service.TagNormalizer) *TagsHandler {
+	return &TagsHandler{repo: repo, tags: tags}
+}
+
+func (h *TagsHandler) Register(r *gin.Engine, authMiddleware gin.HandlerFunc) {
+	api := r.Group("/api")
+	api.Use(authMiddleware)
+	{
+		api.GET("/tags", h.GetTags)
+		api.POST("/tags/rename", h.RenameTag)
+		api.POST("/tags/merge", h.MergeTags)
+		api.DELETE("/tags/:tag", h.DeleteTag)
+	}
+}
+
+func (h *TagsHandler) GetTags(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	tags, err := h.repo.ListTags(ctx, userID)
+	if err != nil {
+		log.Printf("repository error: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch tags"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"tags": tags})
+}
+
+func (h *TagsHandler) RenameTag(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var req model.TagRenameRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request", "detail": err.Error()})
+		return
+	}
+	// `from` is matched as stored (legacy rows may predate normalization);
+	// `to` is normalized like any newly saved tag.
+	from := strings.TrimSpace(req.From)
+	to := h.tags.NormalizeOne(req.To)
+	if from == "" || to == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request", "detail": "from and to must not be empty"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	n, err := h.repo.RenameTag(ctx, userID, from, to)
+	if err != nil {
+		log.Printf("repository error: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to rename tag"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"updated": n})
+}
+
+func (h *TagsHandler) MergeTags(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var req model.TagMergeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request", "detail": err.Error()})
+		return
+	}
+	sources := uniqueNonEmpty(req.Sources)
+	target := h.tags.NormalizeOne(req.Target)
+	if len(sources) == 0 || target == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request", "detail": "sources and target must not be empty"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	n, err := h.repo.MergeTags(ctx, userID, sources, target)
+	if err != nil {
+		log.Printf("repository error: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to merge tags"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"updated": n})
+}
+
+func (h *TagsHandler) DeleteTag(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	tag := strings.TrimSpace(c.Param("tag"))
+	if tag == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "tag is required"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	n, err := h.repo.DeleteTag(ctx, userID, tag)
+	if err != nil {
+		log.Printf("repository error: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete tag"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"updated": n})
+}
+
+// uniqueNonEmpty trims values and drops empty and duplicate entries, keeping order.
+func uniqueNonEmpty(values []string) []string {
+	if len(values) == 0 {
+		return values
+	}
+	uniq := make(map[string]struct{}, len(values))
+	normalized := make([]string, 0, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		if _, ok := uniq[v]; ok {
+			continue
+		}
+		uniq[v] = struct{}{}
+		normalized = append(normalized, v)
+	}
+	return normalized
+}
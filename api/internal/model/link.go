@@ -14,16 +14,83 @@ type LinkCreateRequest struct {
 	// Note: user_id is now determined by the authenticated user, not from request body
 }
 
+// LinkUpdateRequest is a partial update. Omitted fields are left unchanged.
+type LinkUpdateRequest struct {
+	Title       *string   `json:"title"`
+	Description *string   `json:"description"`
+	Note        *string   `json:"note"`
+	Tags        *[]string `json:"tags"`
+}
+
+// LinkBulkRefreshRequest selects links to re-fetch metadata for. All fields
+// are optional and combine like the GET /api/links filters.
+type LinkBulkRefreshRequest struct {
+	Domain      string   `json:"domain"`
+	Tags        []string `json:"tags"`
+	TagMode     string   `json:"tag_mode"`
+	ExcludeTags []string `json:"exclude_tags"`
+	Query       string   `json:"q"`
+}
+
 type Link struct {
-	ID          string    `json:"id"`
-	URL         string    `json:"url"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	Domain      string    `json:"domain"`
-	OGImage     string    `json:"og_image"`
-	PageURL     string    `json:"page_url"`
-	Note        string    `json:"note"`
-	Tags        []string  `json:"tags"`
-	UserID      string    `json:"user_id"`
-	SavedAt     time.Time `json:"saved_at"`
+	ID          string     `json:"id"`
+	URL         string     `json:"url"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Domain      string     `json:"domain"`
+	OGImage     string     `json:"og_image"`
+	PageURL     string     `json:"page_url"`
+	Note        string     `json:"note"`
+	Tags        []string   `json:"tags"`
+	UserID      string     `json:"user_id"`
+	SavedAt     time.Time  `json:"saved_at"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+	// Structured is schema.org data found on the page, if any.
+	Structured *StructuredData `json:"structured,omitempty"`
+	// Embed is the oEmbed response for rich providers (video, social posts).
+	Embed *Embed `json:"embed,omitempty"`
+	// SiteID references the domain's Site once it has been resolved.
+	SiteID string `json:"site_id,omitempty"`
+	// Site is included where the site was loaded with the link.
+	Site *Site `json:"site,omitempty"`
+}
+
+// StructuredData is schema.org metadata extracted from a page's JSON-LD.
+// It is stored as links.metadata.structured.
+type StructuredData struct {
+	Type        string     `json:"type,omitempty"` // e.g. "NewsArticle"
+	Author      string     `json:"author,omitempty"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	Section     string     `json:"section,omitempty"`
+	Keywords    []string   `json:"keywords,omitempty"`
+	WordCount   int        `json:"word_count,omitempty"`
+	// ReadingMinutes is the page's timeRequired, or estimated from WordCount.
+	ReadingMinutes int `json:"reading_minutes,omitempty"`
+}
+
+// IsZero reports whether no structured data was found.
+func (d StructuredData) IsZero() bool {
+	return d.Type == "" && d.Author == "" && d.PublishedAt == nil && d.Section == "" && len(d.Keywords) == 0 &&
+		d.WordCount == 0 && d.ReadingMinutes == 0
+}
+
+// Embed is the subset of an oEmbed response kept for a link.
+// It is stored as links.metadata.oembed.
+type Embed struct {
+	Type         string `json:"type,omitempty"` // "video", "rich", "photo" or "link"
+	ProviderName string `json:"provider_name,omitempty"`
+	AuthorName   string `json:"author_name,omitempty"`
+	Title        string `json:"title,omitempty"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	// Duration is the media length in seconds, when the provider reports it.
+	Duration int `json:"duration,omitempty"`
+	Width    int `json:"width,omitempty"`
+	Height   int `json:"height,omitempty"`
+	// HTML is the sanitized embed markup.
+	HTML string `json:"html,omitempty"`
+}
+
+// IsZero reports whether the embed carries no data.
+func (e Embed) IsZero() bool {
+	return e == Embed{}
 }
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// LinkCheck is one health check of a link's URL.
+type LinkCheck struct {
+	// StatusCode is nil when the request failed without a response.
+	StatusCode *int    `json:"status_code"`
+	FinalURL   *string `json:"final_url"`
+	// Health is "ok", "broken", "redirected" or "unknown".
+	Health    string    `json:"health"`
+	Error     *string   `json:"error,omitempty"`
+	CheckedAt time.Time `json:"checked_at"`
+}
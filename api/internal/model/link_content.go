@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// LinkContent is the readable main text extracted from a link's page.
+type LinkContent struct {
+	LinkID         string    `json:"link_id"`
+	Text           string    `json:"text"`
+	WordCount      int       `json:"word_count"`
+	ReadingMinutes int       `json:"reading_minutes"`
+	ExtractedAt    time.Time `json:"extracted_at"`
+}
@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// Site is the identity of a domain shared by every link on it.
+type Site struct {
+	ID        string     `json:"id"`
+	Domain    string     `json:"domain"`
+	Name      string     `json:"name"`
+	IconURL   string     `json:"icon_url"`
+	FetchedAt *time.Time `json:"fetched_at,omitempty"`
+}
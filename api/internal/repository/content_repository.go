@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	appent "github.com/lvncer/quicklinks/api/ent"
+	"github.com/lvncer/quicklinks/api/ent/link"
+	"github.com/lvncer/quicklinks/api/ent/linkcontent"
+	"github.com/lvncer/quicklinks/api/internal/model"
+)
+
+// ErrContentNotFound is returned when no content has been extracted for a link.
+var ErrContentNotFound = errors.New("content not found")
+
+// LinkContentInput is extracted page text to store for a link.
+type LinkContentInput struct {
+	Text           string
+	WordCount      int
+	ReadingMinutes int
+	ExtractedAt    time.Time
+}
+
+// ContentRepository stores the readable text of each link's page.
+type ContentRepository interface {
+	GetLinkContent(ctx context.Context, linkID string) (*model.LinkContent, error)
+	// PutLinkContent creates or replaces the content of the link.
+	PutLinkContent(ctx context.Context, linkID string, input LinkContentInput) error
+	// CopyLinkContent gives the link the most recently extracted content of
+	// another link with the same canonical_url, of any user. It returns
+	// ErrContentNotFound if no such link has content.
+	CopyLinkContent(ctx context.Context, linkID string) error
+}
+
+type entContentRepository struct {
+	client *appent.Client
+}
+
+// NewContentRepository creates a new Ent-backed implementation of ContentRepository.
+func NewContentRepository(client *appent.Client) ContentRepository {
+	return &entContentRepository{client: client}
+}
+
+func (r *entContentRepository) GetLinkContent(ctx context.Context, linkID string) (*model.LinkContent, error) {
+	lid, err := uuid.Parse(linkID)
+	if err != nil {
+		return nil, ErrContentNotFound
+	}
+	entity, err := r.client.LinkContent.
+		Query().
+		Where(linkcontent.LinkIDEQ(lid)).
+		Only(ctx)
+	if err != nil {
+		if appent.IsNotFound(err) {
+			return nil, ErrContentNotFound
+		}
+		return nil, err
+	}
+	m := entLinkContentToModel(entity)
+	return &m, nil
+}
+
+func (r *entContentRepository) PutLinkContent(ctx context.Context, linkID string, input LinkContentInput) error {
+	lid, err := uuid.Parse(linkID)
+	if err != nil {
+		return ErrLinkNotFound
+	}
+
+	n, err := r.client.LinkContent.
+		Update().
+		Where(linkcontent.LinkIDEQ(lid)).
+		SetText(input.Text).
+		SetWordCount(input.WordCount).
+		SetReadingMinutes(input.ReadingMinutes).
+		SetExtractedAt(input.ExtractedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update link content: %w", err)
+	}
+	if n > 0 {
+		return nil
+	}
+
+	err = r.client.LinkContent.
+		Create().
+		SetLinkID(lid).
+		SetText(input.Text).
+		SetWordCount(input.WordCount).
+		SetReadingMinutes(input.ReadingMinutes).
+		SetExtractedAt(input.ExtractedAt).
+		Exec(ctx)
+	if appent.IsConstraintError(err) {
+		// Either a concurrent fetch stored content first (equally fresh), or
+		// the link was purged meanwhile.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("create link content: %w", err)
+	}
+	return nil
+}
+
+func (r *entContentRepository) CopyLinkContent(ctx context.Context, linkID string) error {
+	lid, err := uuid.Parse(linkID)
+	if err != nil {
+		return ErrLinkNotFound
+	}
+	l, err := r.client.Link.Get(ctx, lid)
+	if err != nil {
+		if appent.IsNotFound(err) {
+			return ErrLinkNotFound
+		}
+		return err
+	}
+	if l.CanonicalURL == nil {
+		return ErrContentNotFound
+	}
+
+	src, err := r.client.LinkContent.
+		Query().
+		Where(
+			linkcontent.LinkIDNEQ(lid),
+			linkcontent.HasLinkWith(link.CanonicalURLEQ(*l.CanonicalURL)),
+		).
+		Order(linkcontent.ByExtractedAt(sql.OrderDesc())).
+		First(ctx)
+	if err != nil {
+		if appent.IsNotFound(err) {
+			return ErrContentNotFound
+		}
+		return err
+	}
+	return r.PutLinkContent(ctx, linkID, LinkContentInput{
+		Text:           src.Text,
+		WordCount:      src.WordCount,
+		ReadingMinutes: src.ReadingMinutes,
+		ExtractedAt:    src.ExtractedAt,
+	})
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidCursor is returned when a pagination cursor cannot be decoded.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is a keyset position in the `time DESC, id DESC` ordering, or
+// `rank DESC, time DESC, id DESC` for full-text searches, where time is the
+// key selected by Sort. Clients treat its encoded form as opaque.
+type Cursor struct {
+	Sort LinkSort
+	Rank *float64
+	Time time.Time
+	ID   uuid.UUID
+}
+
+type cursorPayload struct {
+	Sort LinkSort  `json:"o,omitempty"`
+	Rank *float64  `json:"r,omitempty"`
+	Time time.Time `json:"s"`
+	ID   uuid.UUID `json:"i"`
+}
+
+// Encode returns the opaque, URL-safe string form of the cursor.
+func (c Cursor) Encode() string {
+	p := cursorPayload{Rank: c.Rank, Time: c.Time.UTC(), ID: c.ID}
+	if c.Sort != SortSavedAt { // omitted for the default sort
+		p.Sort = c.Sort
+	}
+	b, _ := json.Marshal(p)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor parses a cursor produced by Cursor.Encode.
+func DecodeCursor(s string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var p cursorPayload
+	if err := json.Unmarshal(b, &p); err != nil || p.Time.IsZero() || p.ID == uuid.Nil {
+		return nil, ErrInvalidCursor
+	}
+	if p.Sort == "" {
+		p.Sort = SortSavedAt
+	}
+	return &Cursor{Sort: p.Sort, Rank: p.Rank, Time: p.Time, ID: p.ID}, nil
+}
@@ -0,0 +1,252 @@
+package repository
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	appent "github.com/lvncer/quicklinks/api/ent"
+	"github.com/lvncer/quicklinks/api/ent/job"
+	"github.com/lvncer/quicklinks/api/ent/link"
+	"github.com/lvncer/quicklinks/api/ent/predicate"
+)
+
+// Job kinds.
+const (
+	// JobKindFetchMetadata fetches OGP metadata for a saved link and fills
+	// fields that are still empty.
+	JobKindFetchMetadata = "fetch_metadata"
+	// JobKindRefreshMetadata is like JobKindFetchMetadata but replaces the
+	// existing description and og_image (user-requested refresh).
+	JobKindRefreshMetadata = "refresh_metadata"
+	// JobKindArchiveSnapshot stores a single-file snapshot of the link's page.
+	JobKindArchiveSnapshot = "archive_snapshot"
+)
+
+// Job is a claimed background job.
+type Job struct {
+	ID       string
+	Kind     string
+	LinkID   string
+	Attempts int // including the current one
+}
+
+// JobRepository is a Postgres-backed job queue.
+type JobRepository interface {
+	// Enqueue adds a job unless the same kind is already pending or running
+	// for the link. It returns ErrLinkNotFound if the link does not exist.
+	Enqueue(ctx context.Context, kind, linkID string) error
+	// EnqueueBulk adds one job per link, for links that have no jobs yet
+	// (e.g. just imported). The i-th job becomes runnable at
+	// start + i*spacing, so a large batch does not hold up other jobs.
+	// Links that already have an active job of the kind are skipped.
+	EnqueueBulk(ctx context.Context, kind string, linkIDs []string, start time.Time, spacing time.Duration) error
+	// Claim locks the next runnable job and marks it running. Jobs left
+	// running for longer than staleAfter (e.g. after a crash) are reclaimed.
+	// An archive_snapshot job is not runnable while its link has a metadata
+	// job queued, which hands it the fetched page instead (see ClaimForLink).
+	// It returns nil when there is nothing to do.
+	Claim(ctx context.Context, staleAfter time.Duration) (*Job, error)
+	// ClaimForLink locks the link's pending job of the kind, even if it is not
+	// due yet, and marks it running. It returns nil if there is none.
+	ClaimForLink(ctx context.Context, kind, linkID string) (*Job, error)
+	Complete(ctx context.Context, id string) error
+	// Fail records cause. The job is retried at retryAt, or marked failed
+	// for good when retryAt is nil.
+	Fail(ctx context.Context, id string, cause error, retryAt *time.Time) error
+	// PurgeDoneBefore deletes jobs that completed before the given time and
+	// returns how many were deleted. Failed jobs are kept for inspection.
+	PurgeDoneBefore(ctx context.Context, before time.Time) (int, error)
+}
+
+// activeJobConflict makes a job insert a no-op when the link already has a
+// pending or running job of the kind (see idx_jobs_kind_link_active). The
+// predicate must match the index's for Postgres to infer it.
+var activeJobConflict = []sql.ConflictOption{
+	sql.ConflictColumns(job.FieldKind, job.FieldLinkID),
+	sql.ConflictWhere(sql.ExprP(`"status" IN ('pending', 'running')`)),
+}
+
+type entJobRepository struct {
+	client *appent.Client
+}
+
+// NewJobRepository creates a new Ent-backed implementation of JobRepository.
+func NewJobRepository(client *appent.Client) JobRepository {
+	return &entJobRepository{client: client}
+}
+
+func (r *entJobRepository) Enqueue(ctx context.Context, kind, linkID string) error {
+	uid, err := uuid.Parse(linkID)
+	if err != nil {
+		return fmt.Errorf("invalid link id %q: %w", linkID, err)
+	}
+	err = r.client.Job.
+		Create().
+		SetKind(kind).
+		SetLinkID(uid).
+		OnConflict(activeJobConflict...).
+		DoNothing().
+		Exec(ctx)
+	switch {
+	case errors.Is(err, stdsql.ErrNoRows):
+		// Nothing was inserted: the job is already queued.
+		return nil
+	case appent.IsConstraintError(err):
+		return ErrLinkNotFound
+	}
+	return err
+}
+
+func (r *entJobRepository) EnqueueBulk(ctx context.Context, kind string, linkIDs []string, start time.Time, spacing time.Duration) error {
+	if len(linkIDs) == 0 {
+		return nil
+	}
+	builders := make([]*appent.JobCreate, len(linkIDs))
+	for i, id := range linkIDs {
+		uid, err := uuid.Parse(id)
+		if err != nil {
+			return fmt.Errorf("invalid link id %q: %w", id, err)
+		}
+		builders[i] = r.client.Job.
+			Create().
+			SetKind(kind).
+			SetLinkID(uid).
+			SetRunAt(start.Add(time.Duration(i) * spacing))
+	}
+	err := r.client.Job.
+		CreateBulk(builders...).
+		OnConflict(activeJobConflict...).
+		DoNothing().
+		Exec(ctx)
+	if appent.IsConstraintError(err) {
+		return ErrLinkNotFound
+	}
+	return err
+}
+
+// waitingForMetadata matches archive_snapshot jobs whose link has a pending
+// or running metadata job: that job downloads the page anyway.
+var waitingForMetadata = job.And(
+	job.KindEQ(JobKindArchiveSnapshot),
+	job.HasLinkWith(link.HasJobsWith(
+		job.KindIn(JobKindFetchMetadata, JobKindRefreshMetadata),
+		job.StatusIn(job.StatusPending, job.StatusRunning),
+	)),
+)
+
+func (r *entJobRepository) Claim(ctx context.Context, staleAfter time.Duration) (*Job, error) {
+	now := time.Now()
+	return r.claim(ctx, job.Or(
+		job.And(job.StatusEQ(job.StatusPending), job.RunAtLTE(now), job.Not(waitingForMetadata)),
+		job.And(job.StatusEQ(job.StatusRunning), job.LockedAtLT(now.Add(-staleAfter))),
+	))
+}
+
+func (r *entJobRepository) ClaimForLink(ctx context.Context, kind, linkID string) (*Job, error) {
+	uid, err := uuid.Parse(linkID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid link id %q: %w", linkID, err)
+	}
+	return r.claim(ctx, job.And(
+		job.KindEQ(kind),
+		job.LinkIDEQ(uid),
+		job.StatusEQ(job.StatusPending),
+	))
+}
+
+// claim locks the first job matching where, by run_at, and marks it running.
+func (r *entJobRepository) claim(ctx context.Context, where predicate.Job) (*Job, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	j, err := claimTx(ctx, tx, where)
+	if err != nil || j == nil {
+		if rerr := tx.Rollback(); rerr != nil && err != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func claimTx(ctx context.Context, tx *appent.Tx, where predicate.Job) (*Job, error) {
+	entity, err := tx.Job.
+		Query().
+		Where(where).
+		Order(job.ByRunAt()).
+		Limit(1).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		Only(ctx)
+	if err != nil {
+		if appent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	entity, err = entity.Update().
+		SetStatus(job.StatusRunning).
+		SetLockedAt(time.Now()).
+		AddAttempts(1).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Job{
+		ID:       entity.ID.String(),
+		Kind:     entity.Kind,
+		LinkID:   entity.LinkID.String(),
+		Attempts: entity.Attempts,
+	}, nil
+}
+
+func (r *entJobRepository) Complete(ctx context.Context, id string) error {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+	return r.client.Job.
+		UpdateOneID(uid).
+		SetStatus(job.StatusDone).
+		ClearLockedAt().
+		ClearLastError().
+		Exec(ctx)
+}
+
+func (r *entJobRepository) Fail(ctx context.Context, id string, cause error, retryAt *time.Time) error {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+	update := r.client.Job.
+		UpdateOneID(uid).
+		SetLastError(cause.Error()).
+		ClearLockedAt()
+	if retryAt != nil {
+		update.SetStatus(job.StatusPending).SetRunAt(*retryAt)
+	} else {
+		update.SetStatus(job.StatusFailed)
+	}
+	return update.Exec(ctx)
+}
+
+func (r *entJobRepository) PurgeDoneBefore(ctx context.Context, before time.Time) (int, error) {
+	return r.client.Job.
+		Delete().
+		Where(
+			job.StatusEQ(job.StatusDone),
+			job.UpdatedAtLT(before),
+		).
+		Exec(ctx)
+}
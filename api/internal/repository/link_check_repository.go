@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	appent "github.com/lvncer/quicklinks/api/ent"
+	"github.com/lvncer/quicklinks/api/ent/link"
+	"github.com/lvncer/quicklinks/api/ent/linkcheck"
+	"github.com/lvncer/quicklinks/api/internal/model"
+)
+
+// LinkHealth is the outcome of a link check, as used by ListLinksFilter.Health.
+type LinkHealth string
+
+const (
+	HealthOK LinkHealth = "ok"
+	// HealthBroken means the page is gone: an error status or no response.
+	HealthBroken LinkHealth = "broken"
+	// HealthRedirected means the URL now leads somewhere else.
+	HealthRedirected LinkHealth = "redirected"
+	// HealthUnknown means the check was inconclusive (e.g. a timeout, or
+	// access denied to the checker).
+	HealthUnknown LinkHealth = "unknown"
+)
+
+// linkCheckHistory is how many checks are kept per link.
+const linkCheckHistory = 20
+
+// LinkCheckInput is the result of one link check.
+type LinkCheckInput struct {
+	StatusCode int // 0 when there was no response
+	FinalURL   string
+	Health     LinkHealth
+	Error      string
+	CheckedAt  time.Time
+}
+
+// LinkCheckRepository stores the health check history of links.
+type LinkCheckRepository interface {
+	// RecordLinkCheck appends a check to the link's history, dropping the
+	// oldest entries beyond the last linkCheckHistory.
+	RecordLinkCheck(ctx context.Context, linkID string, input LinkCheckInput) error
+	// ListLinkChecks returns the link's checks, newest first.
+	ListLinkChecks(ctx context.Context, linkID string, limit int) ([]model.LinkCheck, error)
+	// ListLinkCheckCandidates returns ids of links (of all users, not in the
+	// trash) that were never checked or last checked before the given time,
+	// least recently checked first.
+	ListLinkCheckCandidates(ctx context.Context, before time.Time, limit int) ([]string, error)
+}
+
+type entLinkCheckRepository struct {
+	client *appent.Client
+}
+
+// NewLinkCheckRepository creates a new Ent-backed implementation of LinkCheckRepository.
+func NewLinkCheckRepository(client *appent.Client) LinkCheckRepository {
+	return &entLinkCheckRepository{client: client}
+}
+
+func (r *entLinkCheckRepository) RecordLinkCheck(ctx context.Context, linkID string, input LinkCheckInput) error {
+	lid, err := uuid.Parse(linkID)
+	if err != nil {
+		return ErrLinkNotFound
+	}
+
+	create := r.client.LinkCheck.
+		Create().
+		SetLinkID(lid).
+		SetHealth(linkcheck.Health(input.Health)).
+		SetCheckedAt(input.CheckedAt)
+	if input.StatusCode != 0 {
+		create.SetStatusCode(input.StatusCode)
+	}
+	if input.FinalURL != "" {
+		create.SetFinalURL(input.FinalURL)
+	}
+	if input.Error != "" {
+		create.SetError(input.Error)
+	}
+	if err := create.Exec(ctx); err != nil {
+		if appent.IsConstraintError(err) {
+			// Purged while it was being checked.
+			return ErrLinkNotFound
+		}
+		return fmt.Errorf("create link check: %w", err)
+	}
+
+	old, err := r.client.LinkCheck.
+		Query().
+		Where(linkcheck.LinkIDEQ(lid)).
+		Order(linkcheck.ByCheckedAt(sql.OrderDesc()), linkcheck.ByID(sql.OrderDesc())).
+		Offset(linkCheckHistory).
+		IDs(ctx)
+	if err != nil || len(old) == 0 {
+		return err
+	}
+	if _, err := r.client.LinkCheck.Delete().Where(linkcheck.IDIn(old...)).Exec(ctx); err != nil {
+		return fmt.Errorf("prune link checks: %w", err)
+	}
+	return nil
+}
+
+func (r *entLinkCheckRepository) ListLinkChecks(ctx context.Context, linkID string, limit int) ([]model.LinkCheck, error) {
+	lid, err := uuid.Parse(linkID)
+	if err != nil {
+		return nil, ErrLinkNotFound
+	}
+	if limit <= 0 {
+		limit = linkCheckHistory
+	}
+	entities, err := r.client.LinkCheck.
+		Query().
+		Where(linkcheck.LinkIDEQ(lid)).
+		Order(linkcheck.ByCheckedAt(sql.OrderDesc()), linkcheck.ByID(sql.OrderDesc())).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	checks := make([]model.LinkCheck, len(entities))
+	for i, e := range entities {
+		checks[i] = entLinkCheckToModel(e)
+	}
+	return checks, nil
+}
+
+func (r *entLinkCheckRepository) ListLinkCheckCandidates(ctx context.Context, before time.Time, limit int) ([]string, error) {
+	uids, err := r.client.Link.
+		Query().
+		Where(link.DeletedAtIsNil()).
+		Where(func(s *sql.Selector) {
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.WriteString("COALESCE(" + latestLinkCheckExpr(s, linkcheck.FieldCheckedAt) + " < ")
+				b.Arg(before)
+				b.WriteString(", TRUE)")
+			}))
+		}).
+		Order(func(s *sql.Selector) {
+			s.OrderExpr(sql.Expr(latestLinkCheckExpr(s, linkcheck.FieldCheckedAt) + " ASC NULLS FIRST"))
+		}).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(uids))
+	for i, id := range uids {
+		ids[i] = id.String()
+	}
+	return ids, nil
+}
+
+// latestLinkCheckExpr returns a subquery selecting column of the latest check
+// of the link row in s (NULL if it was never checked).
+func latestLinkCheckExpr(s *sql.Selector, column string) string {
+	return "(SELECT " + column + " FROM " + linkcheck.Table +
+		" WHERE " + linkcheck.FieldLinkID + " = " + s.C(link.FieldID) +
+		" ORDER BY " + linkcheck.FieldCheckedAt + " DESC LIMIT 1)"
+}
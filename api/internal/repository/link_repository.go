@@ -3,39 +3,189 @@ package repository
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
+	"unicode"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
 	appent "github.com/lvncer/quicklinks/api/ent"
 	"github.com/lvncer/quicklinks/api/ent/link"
+	"github.com/lvncer/quicklinks/api/ent/linkcheck"
 	"github.com/lvncer/quicklinks/api/internal/model"
 )
 
+// ErrLinkNotFound is returned when a link does not exist or is not owned by the caller.
+var ErrLinkNotFound = errors.New("link not found")
+
 // LinkRepository defines persistence operations for links.
 type LinkRepository interface {
-	CreateLink(ctx context.Context, input CreateLinkInput) (string, error)
-	ListLinks(ctx context.Context, userID string, filter ListLinksFilter) ([]model.Link, error)
+	// CreateLink saves a link, or merges it into the user's existing link with
+	// the same CanonicalURL. duplicate reports which one happened.
+	CreateLink(ctx context.Context, input CreateLinkInput) (id string, duplicate bool, err error)
+	// CreateLinksBulk inserts links of one user with a single statement.
+	// Inputs whose CanonicalURL the user already has (in the trash too) or
+	// that repeat an earlier input are skipped rather than merged. Every
+	// input must have a CanonicalURL; their UserID is ignored.
+	CreateLinksBulk(ctx context.Context, userID string, inputs []CreateLinkInput) (BulkCreateResult, error)
+	// ListLinks returns one page of links and the cursor for the next page
+	// ("" when there are no more results).
+	ListLinks(ctx context.Context, userID string, filter ListLinksFilter) ([]model.Link, string, error)
+	GetLink(ctx context.Context, userID, id string) (*model.Link, error)
+	UpdateLink(ctx context.Context, userID, id string, input UpdateLinkInput) (*model.Link, error)
+	DeleteLink(ctx context.Context, userID, id string) error
+	ListTrash(ctx context.Context, userID string, limit int) ([]model.Link, error)
+	RestoreLink(ctx context.Context, userID, id string) (*model.Link, error)
+	// PurgeLink permanently deletes a trashed link and returns what it was,
+	// so callers can clean up data stored outside the database.
+	PurgeLink(ctx context.Context, userID, id string) (*PurgedLink, error)
+	// PurgeDeletedBefore permanently deletes links trashed before the given
+	// time and returns them.
+	PurgeDeletedBefore(ctx context.Context, before time.Time) ([]PurgedLink, error)
+	// FindLinkByID returns a link regardless of owner. For background jobs only.
+	FindLinkByID(ctx context.Context, id string) (*model.Link, error)
+	// ApplyFetchResult stores fetched metadata on a link and records the fetch
+	// (source, time, blocked/empty, consecutive failures) in metadata.fetch.
+	// Title is only filled when empty or equal to the URL, so user edits are
+	// kept. Description and og_image are filled when empty, or replaced by
+	// non-empty fetched values when overwrite is set.
+	ApplyFetchResult(ctx context.Context, id string, res FetchResult, overwrite bool) (*model.Link, error)
+	// ListRefetchCandidates returns ids of links whose last fetch was blocked,
+	// empty or failed and whose backoff (baseDelay * 2^(failures-1)) has
+	// elapsed. Links with maxFailures consecutive failures are given up on.
+	ListRefetchCandidates(ctx context.Context, baseDelay time.Duration, maxFailures, limit int) ([]string, error)
+	// BackfillCanonicalURLs fills canonical_url for rows saved before it
+	// existed. Rows that would collide with an existing canonical URL of the
+	// same user are left NULL and counted as skipped.
+	BackfillCanonicalURLs(ctx context.Context, canonicalize func(string) (string, error)) (updated, skipped int, err error)
 }
 
 // CreateLinkInput represents the data required to create a new link.
 type CreateLinkInput struct {
-	UserID      string
-	URL         string
+	UserID       string
+	URL          string
+	CanonicalURL string
+	Title        string
+	Description  string
+	Domain       string
+	OGImage      string
+	PageURL      string
+	Note         string
+	Tags         []string
+	// SavedAt overrides the save time (e.g. for imports). Zero means now.
+	SavedAt time.Time
+}
+
+// PurgedLink is a permanently deleted link: the keys of its snapshot and
+// image thumbnails, which live outside the database.
+type PurgedLink struct {
+	ID      string
+	OGImage string
+}
+
+// BulkCreateResult is the outcome of CreateLinksBulk.
+type BulkCreateResult struct {
+	// IDs holds one entry per input: the new link's id, or "" if the input
+	// was skipped as a duplicate.
+	IDs        []string
+	Inserted   int
+	Duplicates int
+}
+
+// UpdateLinkInput represents a partial update. Nil fields are left unchanged.
+type UpdateLinkInput struct {
+	Title       *string
+	Description *string
+	Note        *string
+	Tags        *[]string
+}
+
+// TagMatchMode controls how ListLinksFilter.Tags are combined.
+type TagMatchMode string
+
+const (
+	TagMatchAny TagMatchMode = "any"
+	TagMatchAll TagMatchMode = "all"
+)
+
+// FetchResult is the outcome of one server-side metadata fetch.
+type FetchResult struct {
 	Title       string
 	Description string
-	Domain      string
 	OGImage     string
-	PageURL     string
-	Note        string
-	Tags        []string
+	Source      string // e.g. "direct" or "jina"
+	Blocked     bool
+	Structured  model.StructuredData
+	Embed       *model.Embed
+	// Cached is set when the result was served from the metadata cache. A
+	// cached failure repeats an earlier fetch, so it is not counted again.
+	Cached bool
+	// Err is set when the fetch failed; only the bookkeeping is recorded.
+	Err       error
+	FetchedAt time.Time
 }
 
+// Keys inside links.metadata.
+const (
+	// metadataFetchKey holds the last fetch record.
+	metadataFetchKey = "fetch"
+	// metadataStructuredKey holds model.StructuredData.
+	metadataStructuredKey = "structured"
+	// metadataEmbedKey holds model.Embed.
+	metadataEmbedKey = "oembed"
+)
+
 type ListLinksFilter struct {
 	Limit  int
+	Cursor *Cursor    // resume after this position; nil for the first page
 	From   *time.Time // inclusive
 	To     *time.Time // exclusive
 	Domain string
-	Tags   []string // OR semantics (any-match)
+	Tags   []string // matched according to TagMode
+	// TagMode selects any-match (default) or all-match semantics for Tags.
+	TagMode TagMatchMode
+	// ExcludeTags drops links carrying any of these tags.
+	ExcludeTags []string
+	// Query is a websearch-style full-text query. When set, results are
+	// ordered by relevance first. Queries with non-ASCII characters also
+	// match substrings, as Japanese text has no spaces to split words on.
+	Query string
+	// Author matches metadata.structured.author case-insensitively.
+	Author string
+	// PublishedFrom (inclusive) and PublishedTo (exclusive) filter on the
+	// page's own publish date; links without one are excluded.
+	PublishedFrom *time.Time
+	PublishedTo   *time.Time
+	// Sort selects the time key; defaults to SortSavedAt.
+	Sort LinkSort
+	// Health matches the outcome of the link's latest check; links never
+	// checked are excluded.
+	Health LinkHealth
+}
+
+// LinkSort is the time key links are ordered by (newest first).
+type LinkSort string
+
+const (
+	SortSavedAt LinkSort = "saved_at"
+	// SortPublishedAt orders by the page's publish date, falling back to
+	// saved_at for links without one.
+	SortPublishedAt LinkSort = "published_at"
+)
+
+// structuredPublishedAtExpr is the publish date stored by ApplyFetchResult.
+func structuredPublishedAtExpr(s *sql.Selector) string {
+	return "(" + s.C(link.FieldMetadata) + " -> '" + metadataStructuredKey + "' ->> 'published_at')::timestamptz"
+}
+
+// sortTimeExpr returns the SQL expression of the time key for sort.
+func sortTimeExpr(s *sql.Selector, sort LinkSort) string {
+	if sort == SortPublishedAt {
+		return "COALESCE(" + structuredPublishedAtExpr(s) + ", " + s.C(link.FieldSavedAt) + ")"
+	}
+	return s.C(link.FieldSavedAt)
 }
 
 type entLinkRepository struct {
@@ -47,8 +197,15 @@ func NewLinkRepository(client *appent.Client) LinkRepository {
 	return &entLinkRepository{client: client}
 }
 
-func (r *entLinkRepository) CreateLink(ctx context.Context, input CreateLinkInput) (string, error) {
-	linkEntity, err := r.client.Link.
+func (r *entLinkRepository) CreateLink(ctx context.Context, input CreateLinkInput) (string, bool, error) {
+	if input.CanonicalURL != "" {
+		id, ok, err := r.mergeDuplicate(ctx, input)
+		if err != nil || ok {
+			return id, ok, err
+		}
+	}
+
+	create := r.client.Link.
 		Create().
 		SetUserID(input.UserID).
 		SetURL(input.URL).
@@ -58,20 +215,191 @@ func (r *entLinkRepository) CreateLink(ctx context.Context, input CreateLinkInpu
 		SetOgImage(input.OGImage).
 		SetPageURL(input.PageURL).
 		SetNote(input.Note).
-		SetTags(input.Tags).
-		Save(ctx)
+		SetTags(input.Tags)
+	if input.CanonicalURL != "" {
+		create.SetCanonicalURL(input.CanonicalURL)
+	}
+	if !input.SavedAt.IsZero() {
+		create.SetSavedAt(input.SavedAt)
+	}
+	linkEntity, err := create.Save(ctx)
 	if err != nil {
-		return "", err
+		// Lost a race with a concurrent save of the same URL.
+		if appent.IsConstraintError(err) && input.CanonicalURL != "" {
+			id, ok, mergeErr := r.mergeDuplicate(ctx, input)
+			if mergeErr == nil && ok {
+				return id, true, nil
+			}
+		}
+		return "", false, err
 	}
 
-	return linkEntity.ID.String(), nil
+	return linkEntity.ID.String(), false, nil
+}
+
+func (r *entLinkRepository) CreateLinksBulk(ctx context.Context, userID string, inputs []CreateLinkInput) (BulkCreateResult, error) {
+	res, err := r.createLinksBulk(ctx, userID, inputs)
+	if appent.IsConstraintError(err) {
+		// A concurrent save took one of the URLs; skip it as well.
+		res, err = r.createLinksBulk(ctx, userID, inputs)
+	}
+	return res, err
 }
 
-func (r *entLinkRepository) ListLinks(ctx context.Context, userID string, filter ListLinksFilter) ([]model.Link, error) {
+func (r *entLinkRepository) createLinksBulk(ctx context.Context, userID string, inputs []CreateLinkInput) (BulkCreateResult, error) {
+	var res BulkCreateResult
+	if len(inputs) == 0 {
+		return res, nil
+	}
+
+	urls := make([]string, len(inputs))
+	for i, in := range inputs {
+		urls[i] = in.CanonicalURL
+	}
+	existing, err := r.client.Link.
+		Query().
+		Where(link.UserIDEQ(userID), link.CanonicalURLIn(urls...)).
+		Select(link.FieldCanonicalURL).
+		Strings(ctx)
+	if err != nil {
+		return res, err
+	}
+	seen := make(map[string]struct{}, len(inputs)+len(existing))
+	for _, u := range existing {
+		seen[u] = struct{}{}
+	}
+
+	builders := make([]*appent.LinkCreate, 0, len(inputs))
+	created := make([]int, 0, len(inputs)) // input index of each builder
+	for i, in := range inputs {
+		if _, ok := seen[in.CanonicalURL]; ok {
+			res.Duplicates++
+			continue
+		}
+		seen[in.CanonicalURL] = struct{}{}
+
+		create := r.client.Link.
+			Create().
+			SetUserID(userID).
+			SetURL(in.URL).
+			SetCanonicalURL(in.CanonicalURL).
+			SetTitle(in.Title).
+			SetDescription(in.Description).
+			SetDomain(in.Domain).
+			SetOgImage(in.OGImage).
+			SetPageURL(in.PageURL).
+			SetNote(in.Note).
+			SetTags(in.Tags)
+		if !in.SavedAt.IsZero() {
+			create.SetSavedAt(in.SavedAt)
+		}
+		builders = append(builders, create)
+		created = append(created, i)
+	}
+	res.IDs = make([]string, len(inputs))
+	if len(builders) == 0 {
+		return res, nil
+	}
+
+	entities, err := r.client.Link.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return BulkCreateResult{}, err
+	}
+	for i, e := range entities {
+		res.IDs[created[i]] = e.ID.String()
+	}
+	res.Inserted = len(entities)
+	return res, nil
+}
+
+// mergeDuplicate merges input's tags and note into the user's existing link
+// with the same canonical URL. A trashed duplicate is restored. It reports
+// false if there is no such link.
+func (r *entLinkRepository) mergeDuplicate(ctx context.Context, input CreateLinkInput) (string, bool, error) {
+	existing, err := r.client.Link.
+		Query().
+		Where(link.UserIDEQ(input.UserID), link.CanonicalURLEQ(input.CanonicalURL)).
+		Only(ctx)
+	if err != nil {
+		if appent.IsNotFound(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+
+	update := existing.Update().
+		SetTags(mergeTagLists(existing.Tags, input.Tags)).
+		ClearDeletedAt()
+	if note := mergeNotes(existing.Note, input.Note); note != "" {
+		update.SetNote(note)
+	}
+	if err := update.Exec(ctx); err != nil {
+		return "", false, err
+	}
+
+	return existing.ID.String(), true, nil
+}
+
+// mergeTagLists appends tags from incoming that are not already in existing.
+func mergeTagLists(existing, incoming []string) []string {
+	seen := make(map[string]struct{}, len(existing)+len(incoming))
+	out := make([]string, 0, len(existing)+len(incoming))
+	for _, t := range append(append([]string{}, existing...), incoming...) {
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+		out = append(out, t)
+	}
+	return out
+}
+
+// mergeNotes appends incoming to existing on a new paragraph unless it is
+// empty or already contained.
+func mergeNotes(existing *string, incoming string) string {
+	incoming = strings.TrimSpace(incoming)
+	var cur string
+	if existing != nil {
+		cur = *existing
+	}
+	if incoming == "" || strings.Contains(cur, incoming) {
+		return cur
+	}
+	if strings.TrimSpace(cur) == "" {
+		return incoming
+	}
+	return cur + "\n\n" + incoming
+}
+
+func (r *entLinkRepository) ListLinks(ctx context.Context, userID string, filter ListLinksFilter) ([]model.Link, string, error) {
 	limit := filter.Limit
 	if limit <= 0 {
 		limit = 50
 	}
+	sortKey := filter.Sort
+	if sortKey == "" {
+		sortKey = SortSavedAt
+	}
+	// A cursor is only valid for the ordering it was issued under.
+	if filter.Cursor != nil && ((filter.Cursor.Rank != nil) != (filter.Query != "") || filter.Cursor.Sort != sortKey) {
+		return nil, "", ErrInvalidCursor
+	}
+
+	orders := []link.OrderOption{
+		func(s *sql.Selector) {
+			s.OrderExpr(sql.DescExpr(sql.Expr(sortTimeExpr(s, sortKey))))
+		},
+		link.ByID(sql.OrderDesc()),
+	}
+	if filter.Query != "" {
+		rankOrder := func(s *sql.Selector) {
+			rank := searchRankExpr(s, filter.Query)
+			s.AppendSelectExprAs(rank, searchRankColumn)
+			s.OrderExpr(sql.DescExpr(rank))
+		}
+		orders = append([]link.OrderOption{rankOrder}, orders...)
+	}
+
 	entities, err := r.client.Link.
 		Query().
 		Select(
@@ -85,11 +413,80 @@ func (r *entLinkRepository) ListLinks(ctx context.Context, userID string, filter
 			link.FieldPageURL,
 			link.FieldNote,
 			link.FieldTags,
+			link.FieldMetadata,
 			link.FieldSavedAt,
 			link.FieldCreatedAt,
+			link.FieldSiteID,
 		).
-		Where(link.UserIDEQ(userID)).
+		WithSite().
+		Where(link.UserIDEQ(userID), link.DeletedAtIsNil()).
 		Where(func(s *sql.Selector) {
+			// Full-text search.
+			if filter.Query != "" {
+				s.Where(searchMatch(s, filter.Query))
+			}
+
+			// Structured-data filters.
+			if filter.Author != "" {
+				author := filter.Author
+				s.Where(sql.P(func(b *sql.Builder) {
+					b.WriteString("lower(" + s.C(link.FieldMetadata) + " -> '" + metadataStructuredKey + "' ->> 'author') = lower(")
+					b.Arg(author)
+					b.WriteString(")")
+				}))
+			}
+			if filter.PublishedFrom != nil {
+				from := *filter.PublishedFrom
+				s.Where(sql.P(func(b *sql.Builder) {
+					b.WriteString(structuredPublishedAtExpr(s))
+					b.WriteString(" >= ")
+					b.Arg(from)
+				}))
+			}
+			if filter.PublishedTo != nil {
+				to := *filter.PublishedTo
+				s.Where(sql.P(func(b *sql.Builder) {
+					b.WriteString(structuredPublishedAtExpr(s))
+					b.WriteString(" < ")
+					b.Arg(to)
+				}))
+			}
+
+			// Latest link check.
+			if filter.Health != "" {
+				health := string(filter.Health)
+				s.Where(sql.P(func(b *sql.Builder) {
+					b.WriteString(latestLinkCheckExpr(s, linkcheck.FieldHealth))
+					b.WriteString(" = ")
+					b.Arg(health)
+				}))
+			}
+
+			// Keyset pagination: rows strictly after the cursor in
+			// ([rank DESC,] time key DESC, id DESC) order.
+			if filter.Cursor != nil {
+				cur := *filter.Cursor
+				s.Where(sql.P(func(b *sql.Builder) {
+					b.WriteString("(")
+					if cur.Rank != nil {
+						b.Join(searchRankExpr(s, filter.Query))
+						b.WriteString(", ")
+					}
+					b.WriteString(sortTimeExpr(s, sortKey))
+					b.WriteString(", ")
+					b.WriteString(s.C(link.FieldID))
+					b.WriteString(") < (")
+					if cur.Rank != nil {
+						b.Arg(*cur.Rank)
+						b.WriteString(", ")
+					}
+					b.Arg(cur.Time)
+					b.WriteString(", ")
+					b.Arg(cur.ID)
+					b.WriteString(")")
+				}))
+			}
+
 			// Domain filter.
 			if filter.Domain != "" {
 				s.Where(sql.EQ(s.C(link.FieldDomain), filter.Domain))
@@ -115,32 +512,283 @@ func (r *entLinkRepository) ListLinks(ctx context.Context, userID string, filter
 				}
 			}
 
-			// Tag filter (jsonb array contains). OR semantics.
+			// Tag filter (jsonb array contains). Every predicate uses `@>` so
+			// the positive filters can use idx_links_tags_gin.
 			if len(filter.Tags) > 0 {
 				col := s.C(link.FieldTags)
-				preds := make([]*sql.Predicate, 0, len(filter.Tags))
-				for _, t := range filter.Tags {
-					// Build a one-element JSON array: ["tag"].
-					b, err := json.Marshal([]string{t})
-					if err != nil {
-						// Should never happen for string inputs; ignore this tag.
-						continue
+				if filter.TagMode == TagMatchAll {
+					// One containment check against the whole set: ["a","b"].
+					if jsonArr, err := jsonTagArray(filter.Tags...); err == nil {
+						s.Where(tagContains(col, jsonArr))
+					}
+				} else {
+					preds := make([]*sql.Predicate, 0, len(filter.Tags))
+					for _, t := range filter.Tags {
+						// Build a one-element JSON array: ["tag"].
+						jsonArr, err := jsonTagArray(t)
+						if err != nil {
+							// Should never happen for string inputs; ignore this tag.
+							continue
+						}
+						preds = append(preds, tagContains(col, jsonArr))
+					}
+					if len(preds) > 0 {
+						s.Where(sql.Or(preds...))
 					}
-					jsonArr := string(b)
-					preds = append(preds, sql.P(func(b *sql.Builder) {
-						b.WriteString(col)
-						b.WriteString(" @> ")
-						b.Arg(jsonArr)
-						b.WriteString("::jsonb")
-					}))
 				}
-				if len(preds) > 0 {
-					s.Where(sql.Or(preds...))
+			}
+
+			// Excluded tags. Links without tags (NULL) are kept.
+			for _, t := range filter.ExcludeTags {
+				col := s.C(link.FieldTags)
+				jsonArr, err := jsonTagArray(t)
+				if err != nil {
+					continue
 				}
+				s.Where(sql.Or(sql.IsNull(col), sql.Not(tagContains(col, jsonArr))))
 			}
 		}).
+		Order(orders...).
+		// Fetch one extra row to learn whether another page exists.
+		Limit(limit + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if len(entities) > limit {
+		entities = entities[:limit]
+		last := entities[limit-1]
+		cur := Cursor{Sort: sortKey, Time: last.SavedAt, ID: last.ID}
+		if sortKey == SortPublishedAt {
+			if sd := structuredFromMetadata(last.Metadata); sd != nil && sd.PublishedAt != nil {
+				cur.Time = *sd.PublishedAt
+			}
+		}
+		if filter.Query != "" {
+			rank, err := searchRankOf(last)
+			if err != nil {
+				return nil, "", err
+			}
+			cur.Rank = &rank
+		}
+		next = cur.Encode()
+	}
+
+	return entLinksToModels(entities), next, nil
+}
+
+// jsonTagArray encodes tags as a JSON array literal for jsonb containment.
+func jsonTagArray(tags ...string) (string, error) {
+	b, err := json.Marshal(tags)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// tagContains builds `col @> $arr::jsonb`.
+func tagContains(col, jsonArr string) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		b.WriteString(col)
+		b.WriteString(" @> ")
+		b.Arg(jsonArr)
+		b.WriteString("::jsonb")
+	})
+}
+
+// searchRankColumn is the alias of the relevance score selected alongside
+// links when ListLinksFilter.Query is set.
+const searchRankColumn = "search_rank"
+
+// searchMatch matches links against the full-text query q. The 'simple'
+// configuration only splits words on spaces and punctuation, so a query with
+// non-ASCII characters also matches links whose search_text contains each of
+// its terms (see searchSubstringTerms).
+func searchMatch(s *sql.Selector, q string) *sql.Predicate {
+	fullText := sql.P(func(b *sql.Builder) {
+		b.WriteString(s.C(link.FieldSearchVector))
+		b.WriteString(" @@ websearch_to_tsquery('simple', ")
+		b.Arg(q)
+		b.WriteString(")")
+	})
+	if !searchNeedsSubstring(q) {
+		return fullText
+	}
+	include, exclude := searchSubstringTerms(q)
+	if len(include) == 0 {
+		return fullText
+	}
+	preds := make([]*sql.Predicate, 0, len(include)+len(exclude))
+	for _, t := range include {
+		preds = append(preds, searchTextLike(s, t, false))
+	}
+	for _, t := range exclude {
+		preds = append(preds, searchTextLike(s, t, true))
+	}
+	return sql.Or(fullText, sql.And(preds...))
+}
+
+// searchNeedsSubstring reports whether q has characters outside ASCII.
+func searchNeedsSubstring(q string) bool {
+	return strings.IndexFunc(q, func(r rune) bool { return r > unicode.MaxASCII }) >= 0
+}
+
+// searchSubstringTerms splits q into the terms every substring match must
+// contain and those it must not ("-term"). Quotes are dropped and "OR" is
+// ignored, so the terms of a phrase or an OR are all required.
+func searchSubstringTerms(q string) (include, exclude []string) {
+	for _, t := range strings.Fields(strings.ReplaceAll(q, `"`, " ")) {
+		switch {
+		case t == "OR":
+		case strings.HasPrefix(t, "-"):
+			if t = t[1:]; t != "" {
+				exclude = append(exclude, t)
+			}
+		default:
+			include = append(include, t)
+		}
+	}
+	return include, exclude
+}
+
+// searchLikeEscaper escapes the LIKE wildcards of a search term.
+var searchLikeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// searchTextLike builds `search_text [NOT] ILIKE '%term%'`, which the
+// trigram index serves. Links whose search_text is NULL never match.
+func searchTextLike(s *sql.Selector, term string, not bool) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		b.WriteString(s.C(link.FieldSearchText))
+		if not {
+			b.WriteString(" NOT")
+		}
+		b.WriteString(" ILIKE ")
+		b.Arg("%" + searchLikeEscaper.Replace(term) + "%")
+	})
+}
+
+// searchRankExpr returns the relevance expression for q. For queries matched
+// by substring it adds the trigram word similarity, as ts_rank is zero for
+// links only the substring match found. It is cast to float8 so that the
+// value round-trips exactly through a cursor.
+func searchRankExpr(s *sql.Selector, q string) sql.Querier {
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.WriteString("(ts_rank(")
+		b.WriteString(s.C(link.FieldSearchVector))
+		b.WriteString(", websearch_to_tsquery('simple', ")
+		b.Arg(q)
+		b.WriteString("))")
+		if searchNeedsSubstring(q) {
+			b.WriteString(" + coalesce(word_similarity(")
+			b.Arg(q)
+			b.WriteString(", ")
+			b.WriteString(s.C(link.FieldSearchText))
+			b.WriteString("), 0)")
+		}
+		b.WriteString(")::float8")
+	})
+}
+
+func searchRankOf(l *appent.Link) (float64, error) {
+	v, err := l.Value(searchRankColumn)
+	if err != nil {
+		return 0, err
+	}
+	switch rank := v.(type) {
+	case float64:
+		return rank, nil
+	case float32:
+		return float64(rank), nil
+	default:
+		return 0, fmt.Errorf("unexpected type %T for %s", v, searchRankColumn)
+	}
+}
+
+func (r *entLinkRepository) GetLink(ctx context.Context, userID, id string) (*model.Link, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrLinkNotFound
+	}
+	entity, err := r.client.Link.
+		Query().
+		Where(link.ID(uid), link.UserIDEQ(userID), link.DeletedAtIsNil()).
+		WithSite().
+		Only(ctx)
+	if err != nil {
+		if appent.IsNotFound(err) {
+			return nil, ErrLinkNotFound
+		}
+		return nil, err
+	}
+
+	m := entLinkToModel(entity)
+	return &m, nil
+}
+
+func (r *entLinkRepository) UpdateLink(ctx context.Context, userID, id string, input UpdateLinkInput) (*model.Link, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrLinkNotFound
+	}
+
+	update := r.client.Link.
+		Update().
+		Where(link.ID(uid), link.UserIDEQ(userID), link.DeletedAtIsNil())
+	if input.Title != nil {
+		update.SetTitle(*input.Title)
+	}
+	if input.Description != nil {
+		update.SetDescription(*input.Description)
+	}
+	if input.Note != nil {
+		update.SetNote(*input.Note)
+	}
+	if input.Tags != nil {
+		update.SetTags(*input.Tags)
+	}
+
+	n, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrLinkNotFound
+	}
+
+	return r.GetLink(ctx, userID, id)
+}
+
+// DeleteLink moves a link to the trash. Use PurgeLink to remove it permanently.
+func (r *entLinkRepository) DeleteLink(ctx context.Context, userID, id string) error {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return ErrLinkNotFound
+	}
+	n, err := r.client.Link.
+		Update().
+		Where(link.ID(uid), link.UserIDEQ(userID), link.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrLinkNotFound
+	}
+	return nil
+}
+
+func (r *entLinkRepository) ListTrash(ctx context.Context, userID string, limit int) ([]model.Link, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	entities, err := r.client.Link.
+		Query().
+		Where(link.UserIDEQ(userID), link.DeletedAtNotNil()).
 		Order(
-			link.BySavedAt(sql.OrderDesc()),
+			link.ByDeletedAt(sql.OrderDesc()),
 			link.ByID(sql.OrderDesc()),
 		).
 		Limit(limit).
@@ -151,3 +799,303 @@ func (r *entLinkRepository) ListLinks(ctx context.Context, userID string, filter
 
 	return entLinksToModels(entities), nil
 }
+
+func (r *entLinkRepository) RestoreLink(ctx context.Context, userID, id string) (*model.Link, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrLinkNotFound
+	}
+	n, err := r.client.Link.
+		Update().
+		Where(link.ID(uid), link.UserIDEQ(userID), link.DeletedAtNotNil()).
+		ClearDeletedAt().
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrLinkNotFound
+	}
+
+	return r.GetLink(ctx, userID, id)
+}
+
+// PurgeLink permanently deletes a link that is already in the trash.
+func (r *entLinkRepository) PurgeLink(ctx context.Context, userID, id string) (*PurgedLink, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrLinkNotFound
+	}
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	purged, err := purgeLinkTx(ctx, tx, userID, uid)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return purged, nil
+}
+
+func purgeLinkTx(ctx context.Context, tx *appent.Tx, userID string, id uuid.UUID) (*PurgedLink, error) {
+	entity, err := tx.Link.
+		Query().
+		Where(link.ID(id), link.UserIDEQ(userID), link.DeletedAtNotNil()).
+		Select(link.FieldID, link.FieldOgImage).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if appent.IsNotFound(err) {
+			return nil, ErrLinkNotFound
+		}
+		return nil, err
+	}
+	if err := tx.Link.DeleteOneID(id).Exec(ctx); err != nil {
+		return nil, err
+	}
+	purged := newPurgedLink(entity)
+	return &purged, nil
+}
+
+func newPurgedLink(l *appent.Link) PurgedLink {
+	p := PurgedLink{ID: l.ID.String()}
+	if l.OgImage != nil {
+		p.OGImage = *l.OgImage
+	}
+	return p
+}
+
+// PurgeDeletedBefore permanently deletes trashed links of all users whose
+// deleted_at is older than before. It returns the deleted rows, so callers
+// can clean up data stored outside the database.
+func (r *entLinkRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) ([]PurgedLink, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	purged, err := purgeDeletedBeforeTx(ctx, tx, before)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return purged, nil
+}
+
+func purgeDeletedBeforeTx(ctx context.Context, tx *appent.Tx, before time.Time) ([]PurgedLink, error) {
+	// Lock the rows so a concurrent restore cannot slip in between the
+	// select and the delete.
+	entities, err := tx.Link.
+		Query().
+		Where(link.DeletedAtLT(before)).
+		Select(link.FieldID, link.FieldOgImage).
+		ForUpdate().
+		All(ctx)
+	if err != nil || len(entities) == 0 {
+		return nil, err
+	}
+	uids := make([]uuid.UUID, len(entities))
+	purged := make([]PurgedLink, len(entities))
+	for i, e := range entities {
+		uids[i] = e.ID
+		purged[i] = newPurgedLink(e)
+	}
+	if _, err := tx.Link.Delete().Where(link.IDIn(uids...)).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return purged, nil
+}
+
+func (r *entLinkRepository) BackfillCanonicalURLs(ctx context.Context, canonicalize func(string) (string, error)) (int, int, error) {
+	const batchSize = 500
+
+	var updated, skipped int
+	var after *uuid.UUID
+	for {
+		q := r.client.Link.
+			Query().
+			Select(link.FieldID, link.FieldURL).
+			Where(link.CanonicalURLIsNil()).
+			Order(link.ByID()).
+			Limit(batchSize)
+		if after != nil {
+			q = q.Where(link.IDGT(*after))
+		}
+		entities, err := q.All(ctx)
+		if err != nil {
+			return updated, skipped, err
+		}
+
+		for _, l := range entities {
+			canonical, err := canonicalize(l.URL)
+			if err != nil {
+				skipped++
+				continue
+			}
+			err = r.client.Link.UpdateOneID(l.ID).SetCanonicalURL(canonical).Exec(ctx)
+			if appent.IsConstraintError(err) {
+				skipped++
+				continue
+			}
+			if err != nil {
+				return updated, skipped, err
+			}
+			updated++
+		}
+
+		if len(entities) < batchSize {
+			return updated, skipped, nil
+		}
+		last := entities[len(entities)-1].ID
+		after = &last
+	}
+}
+
+func (r *entLinkRepository) FindLinkByID(ctx context.Context, id string) (*model.Link, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrLinkNotFound
+	}
+	entity, err := r.client.Link.Get(ctx, uid)
+	if err != nil {
+		if appent.IsNotFound(err) {
+			return nil, ErrLinkNotFound
+		}
+		return nil, err
+	}
+
+	m := entLinkToModel(entity)
+	return &m, nil
+}
+
+func (r *entLinkRepository) ApplyFetchResult(ctx context.Context, id string, res FetchResult, overwrite bool) (*model.Link, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrLinkNotFound
+	}
+	entity, err := r.client.Link.Get(ctx, uid)
+	if err != nil {
+		if appent.IsNotFound(err) {
+			return nil, ErrLinkNotFound
+		}
+		return nil, err
+	}
+	current := entLinkToModel(entity)
+
+	update := entity.Update()
+	if res.Err == nil {
+		if res.Title != "" && (current.Title == "" || current.Title == current.URL) {
+			update.SetTitle(res.Title)
+		}
+		if res.Description != "" && (overwrite || current.Description == "") {
+			update.SetDescription(res.Description)
+		}
+		if res.OGImage != "" && (overwrite || current.OGImage == "") {
+			update.SetOgImage(res.OGImage)
+		}
+	}
+
+	empty := res.Title == "" && res.Description == "" && res.OGImage == ""
+	failed := res.Err != nil || res.Blocked || empty
+	failures := 0
+	if failed {
+		failures = previousFetchFailures(entity.Metadata)
+		if !res.Cached || failures == 0 {
+			failures++
+		}
+	}
+	record := map[string]any{
+		"source":     res.Source,
+		"blocked":    res.Blocked,
+		"empty":      res.Err == nil && empty,
+		"fetched_at": res.FetchedAt.UTC().Format(time.RFC3339Nano),
+		"failures":   failures,
+	}
+	if res.Cached {
+		record["cached"] = true
+	}
+	if res.Err != nil {
+		record["error"] = res.Err.Error()
+	}
+	metadata := make(map[string]any, len(entity.Metadata)+1)
+	for k, v := range entity.Metadata {
+		metadata[k] = v
+	}
+	metadata[metadataFetchKey] = record
+	if res.Err == nil && !res.Structured.IsZero() {
+		structured, err := toMetadataMap(res.Structured)
+		if err != nil {
+			return nil, err
+		}
+		metadata[metadataStructuredKey] = structured
+	}
+	if res.Err == nil && res.Embed != nil && !res.Embed.IsZero() {
+		embed, err := toMetadataMap(res.Embed)
+		if err != nil {
+			return nil, err
+		}
+		metadata[metadataEmbedKey] = embed
+	}
+	update.SetMetadata(metadata)
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m := entLinkToModel(updated)
+	return &m, nil
+}
+
+func previousFetchFailures(metadata map[string]any) int {
+	record, ok := metadata[metadataFetchKey].(map[string]any)
+	if !ok {
+		return 0
+	}
+	// JSON numbers decode as float64.
+	if n, ok := record["failures"].(float64); ok {
+		return int(n)
+	}
+	return 0
+}
+
+func (r *entLinkRepository) ListRefetchCandidates(ctx context.Context, baseDelay time.Duration, maxFailures, limit int) ([]string, error) {
+	ids, err := r.client.Link.
+		Query().
+		Where(link.DeletedAtIsNil()).
+		Where(func(s *sql.Selector) {
+			col := s.C(link.FieldMetadata)
+			s.Where(sql.P(func(b *sql.Builder) {
+				failures := "COALESCE((" + col + " -> 'fetch' ->> 'failures')::int, 0)"
+				b.WriteString(failures + " > 0 AND " + failures + " < ")
+				b.Arg(maxFailures)
+				b.WriteString(" AND (" + col + " -> 'fetch' ->> 'fetched_at')::timestamptz")
+				b.WriteString(" + make_interval(secs => ")
+				b.Arg(baseDelay.Seconds())
+				b.WriteString(" * power(2, " + failures + " - 1)) <= now()")
+			}))
+		}).
+		Order(link.BySavedAt(sql.OrderDesc())).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, id.String())
+	}
+	return result, nil
+}
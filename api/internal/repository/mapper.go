@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"encoding/json"
+
 	appent "github.com/lvncer/quicklinks/api/ent"
 	"github.com/lvncer/quicklinks/api/internal/model"
 )
@@ -45,6 +47,18 @@ func entLinkToModel(l *appent.Link) model.Link {
 		tags = []string{}
 	}
 
+	var (
+		siteID string
+		site   *model.Site
+	)
+	if l.SiteID != nil {
+		siteID = l.SiteID.String()
+	}
+	if l.Edges.Site != nil {
+		s := entSiteToModel(l.Edges.Site)
+		site = &s
+	}
+
 	return model.Link{
 		ID:          l.ID.String(),
 		URL:         l.URL,
@@ -57,7 +71,94 @@ func entLinkToModel(l *appent.Link) model.Link {
 		Tags:        tags,
 		UserID:      userID,
 		SavedAt:     l.SavedAt,
+		DeletedAt:   l.DeletedAt,
+		Structured:  structuredFromMetadata(l.Metadata),
+		Embed:       embedFromMetadata(l.Metadata),
+		SiteID:      siteID,
+		Site:        site,
+	}
+}
+
+// entSiteToModel converts an Ent Site entity to the public DTO model.Site.
+func entSiteToModel(s *appent.Site) model.Site {
+	m := model.Site{
+		ID:        s.ID.String(),
+		Domain:    s.Domain,
+		FetchedAt: s.FetchedAt,
+	}
+	if s.Name != nil {
+		m.Name = *s.Name
+	}
+	if s.IconURL != nil {
+		m.IconURL = *s.IconURL
+	}
+	return m
+}
+
+func entLinkContentToModel(c *appent.LinkContent) model.LinkContent {
+	return model.LinkContent{
+		LinkID:         c.LinkID.String(),
+		Text:           c.Text,
+		WordCount:      c.WordCount,
+		ReadingMinutes: c.ReadingMinutes,
+		ExtractedAt:    c.ExtractedAt,
+	}
+}
+
+func entLinkCheckToModel(c *appent.LinkCheck) model.LinkCheck {
+	return model.LinkCheck{
+		StatusCode: c.StatusCode,
+		FinalURL:   c.FinalURL,
+		Health:     string(c.Health),
+		Error:      c.Error,
+		CheckedAt:  c.CheckedAt,
+	}
+}
+
+// structuredFromMetadata decodes links.metadata.structured. Missing or
+// malformed data yields nil.
+func structuredFromMetadata(metadata map[string]any) *model.StructuredData {
+	var sd model.StructuredData
+	if !fromMetadataMap(metadata, metadataStructuredKey, &sd) || sd.IsZero() {
+		return nil
+	}
+	return &sd
+}
+
+// embedFromMetadata decodes links.metadata.oembed. Missing or malformed data
+// yields nil.
+func embedFromMetadata(metadata map[string]any) *model.Embed {
+	var e model.Embed
+	if !fromMetadataMap(metadata, metadataEmbedKey, &e) || e.IsZero() {
+		return nil
+	}
+	return &e
+}
+
+// fromMetadataMap decodes metadata[key] into dst, reporting success.
+func fromMetadataMap(metadata map[string]any, key string, dst any) bool {
+	raw, ok := metadata[key]
+	if !ok {
+		return false
+	}
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(b, dst) == nil
+}
+
+// toMetadataMap encodes v for storage in links.metadata.
+func toMetadataMap(v any) (map[string]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
 	}
+	return m, nil
 }
 
 // entLinksToModels converts a slice of Ent Link entities to a slice of DTOs.
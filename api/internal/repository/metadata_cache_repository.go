@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	appent "github.com/lvncer/quicklinks/api/ent"
+	"github.com/lvncer/quicklinks/api/ent/metadatacache"
+)
+
+// ErrMetadataCacheMiss is returned when no unexpired cache entry exists.
+var ErrMetadataCacheMiss = errors.New("metadata cache miss")
+
+// MetadataCacheEntry is one cached metadata fetch.
+type MetadataCacheEntry struct {
+	CanonicalURL string
+	// Data is the encoded service.Metadata.
+	Data map[string]any
+	// Negative marks a blocked or empty result.
+	Negative  bool
+	ExpiresAt time.Time
+}
+
+// MetadataCacheRepository stores fetched page metadata shared by all users,
+// keyed by canonical URL.
+type MetadataCacheRepository interface {
+	// GetCachedMetadata returns the entry for canonicalURL unless it has
+	// expired.
+	GetCachedMetadata(ctx context.Context, canonicalURL string) (*MetadataCacheEntry, error)
+	// PutCachedMetadata creates or replaces the entry for entry.CanonicalURL.
+	PutCachedMetadata(ctx context.Context, entry MetadataCacheEntry) error
+	// PurgeExpiredMetadata deletes entries that expired before now and
+	// returns how many were removed.
+	PurgeExpiredMetadata(ctx context.Context, now time.Time) (int, error)
+}
+
+type entMetadataCacheRepository struct {
+	client *appent.Client
+}
+
+// NewMetadataCacheRepository creates a new Ent-backed implementation of MetadataCacheRepository.
+func NewMetadataCacheRepository(client *appent.Client) MetadataCacheRepository {
+	return &entMetadataCacheRepository{client: client}
+}
+
+func (r *entMetadataCacheRepository) GetCachedMetadata(ctx context.Context, canonicalURL string) (*MetadataCacheEntry, error) {
+	entity, err := r.client.MetadataCache.
+		Query().
+		Where(
+			metadatacache.CanonicalURLEQ(canonicalURL),
+			metadatacache.ExpiresAtGT(time.Now()),
+		).
+		Only(ctx)
+	if err != nil {
+		if appent.IsNotFound(err) {
+			return nil, ErrMetadataCacheMiss
+		}
+		return nil, err
+	}
+	return &MetadataCacheEntry{
+		CanonicalURL: entity.CanonicalURL,
+		Data:         entity.Data,
+		Negative:     entity.Negative,
+		ExpiresAt:    entity.ExpiresAt,
+	}, nil
+}
+
+func (r *entMetadataCacheRepository) PutCachedMetadata(ctx context.Context, entry MetadataCacheEntry) error {
+	data := entry.Data
+	if data == nil {
+		data = map[string]any{}
+	}
+
+	n, err := r.client.MetadataCache.
+		Update().
+		Where(metadatacache.CanonicalURLEQ(entry.CanonicalURL)).
+		SetData(data).
+		SetNegative(entry.Negative).
+		SetExpiresAt(entry.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update metadata cache: %w", err)
+	}
+	if n > 0 {
+		return nil
+	}
+
+	err = r.client.MetadataCache.
+		Create().
+		SetCanonicalURL(entry.CanonicalURL).
+		SetData(data).
+		SetNegative(entry.Negative).
+		SetExpiresAt(entry.ExpiresAt).
+		Exec(ctx)
+	if appent.IsConstraintError(err) {
+		// Another request cached the same URL concurrently; either result
+		// is equally fresh.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("create metadata cache: %w", err)
+	}
+	return nil
+}
+
+func (r *entMetadataCacheRepository) PurgeExpiredMetadata(ctx context.Context, now time.Time) (int, error) {
+	return r.client.MetadataCache.
+		Delete().
+		Where(metadatacache.ExpiresAtLTE(now)).
+		Exec(ctx)
+}
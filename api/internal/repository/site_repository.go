@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	appent "github.com/lvncer/quicklinks/api/ent"
+	"github.com/lvncer/quicklinks/api/ent/link"
+	"github.com/lvncer/quicklinks/api/ent/site"
+	"github.com/lvncer/quicklinks/api/internal/model"
+)
+
+// ErrSiteNotFound is returned when no site exists for a domain.
+var ErrSiteNotFound = errors.New("site not found")
+
+// SiteInput is a resolved site identity.
+type SiteInput struct {
+	Domain    string
+	Name      string
+	IconURL   string
+	FetchedAt time.Time
+}
+
+// SiteRepository stores one identity (name, favicon) per domain.
+type SiteRepository interface {
+	GetSite(ctx context.Context, domain string) (*model.Site, error)
+	// UpsertSite creates or replaces the site for input.Domain. Empty name
+	// or icon values keep what is already stored.
+	UpsertSite(ctx context.Context, input SiteInput) (*model.Site, error)
+	// AttachLink points the link at the site.
+	AttachLink(ctx context.Context, linkID, siteID string) error
+}
+
+type entSiteRepository struct {
+	client *appent.Client
+}
+
+// NewSiteRepository creates a new Ent-backed implementation of SiteRepository.
+func NewSiteRepository(client *appent.Client) SiteRepository {
+	return &entSiteRepository{client: client}
+}
+
+func (r *entSiteRepository) GetSite(ctx context.Context, domain string) (*model.Site, error) {
+	entity, err := r.client.Site.
+		Query().
+		Where(site.DomainEQ(domain)).
+		Only(ctx)
+	if err != nil {
+		if appent.IsNotFound(err) {
+			return nil, ErrSiteNotFound
+		}
+		return nil, err
+	}
+	m := entSiteToModel(entity)
+	return &m, nil
+}
+
+func (r *entSiteRepository) UpsertSite(ctx context.Context, input SiteInput) (*model.Site, error) {
+	existing, err := r.client.Site.
+		Query().
+		Where(site.DomainEQ(input.Domain)).
+		Only(ctx)
+	if appent.IsNotFound(err) {
+		create := r.client.Site.
+			Create().
+			SetDomain(input.Domain).
+			SetFetchedAt(input.FetchedAt)
+		if input.Name != "" {
+			create.SetName(input.Name)
+		}
+		if input.IconURL != "" {
+			create.SetIconURL(input.IconURL)
+		}
+		created, err := create.Save(ctx)
+		if err == nil {
+			m := entSiteToModel(created)
+			return &m, nil
+		}
+		if !appent.IsConstraintError(err) {
+			return nil, fmt.Errorf("create site %q: %w", input.Domain, err)
+		}
+		// Another worker created the site concurrently; update it instead.
+		existing, err = r.client.Site.
+			Query().
+			Where(site.DomainEQ(input.Domain)).
+			Only(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	update := existing.Update().SetFetchedAt(input.FetchedAt)
+	if input.Name != "" {
+		update.SetName(input.Name)
+	}
+	if input.IconURL != "" {
+		update.SetIconURL(input.IconURL)
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("update site %q: %w", input.Domain, err)
+	}
+	m := entSiteToModel(updated)
+	return &m, nil
+}
+
+func (r *entSiteRepository) AttachLink(ctx context.Context, linkID, siteID string) error {
+	lid, err := uuid.Parse(linkID)
+	if err != nil {
+		return ErrLinkNotFound
+	}
+	sid, err := uuid.Parse(siteID)
+	if err != nil {
+		return ErrSiteNotFound
+	}
+	return r.client.Link.
+		Update().
+		Where(link.ID(lid)).
+		SetSiteID(sid).
+		Exec(ctx)
+}
@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	appent "github.com/lvncer/quicklinks/api/ent"
+	"github.com/lvncer/quicklinks/api/ent/link"
+	"github.com/lvncer/quicklinks/api/internal/model"
+)
+
+// TagRepository defines operations on the tag vocabulary of a user.
+//
+// Tags are stored as a jsonb array on each link, so bulk changes rewrite the
+// affected links inside a single transaction. They also apply to links in the
+// trash, so restoring a link does not bring back an old tag name.
+type TagRepository interface {
+	ListTags(ctx context.Context, userID string) ([]model.TagCount, error)
+	// RenameTag, MergeTags and DeleteTag return the number of links changed.
+	RenameTag(ctx context.Context, userID, from, to string) (int, error)
+	MergeTags(ctx context.Context, userID string, sources []string, target string) (int, error)
+	DeleteTag(ctx context.Context, userID, tag string) (int, error)
+	// NormalizeAllTags rewrites the tags of every link (all users) with
+	// normalize, skipping links whose tags would not change.
+	NormalizeAllTags(ctx context.Context, normalize func([]string) []string) (int, error)
+}
+
+type entTagRepository struct {
+	client *appent.Client
+}
+
+// NewTagRepository creates a new Ent-backed implementation of TagRepository.
+func NewTagRepository(client *appent.Client) TagRepository {
+	return &entTagRepository{client: client}
+}
+
+// ListTags counts the tags of the user's links in the database, most used
+// first and ties by name for a stable order.
+func (r *entTagRepository) ListTags(ctx context.Context, userID string) ([]model.TagCount, error) {
+	var result []model.TagCount
+	err := r.client.Link.
+		Query().
+		Where(link.UserIDEQ(userID), link.DeletedAtIsNil()).
+		Aggregate(func(s *sql.Selector) string {
+			// One row per (link, tag); the function may refer to links
+			// without LATERAL.
+			s.AppendFromExpr(sql.Expr(fmt.Sprintf("jsonb_array_elements_text(%s) AS t(tag)", s.C(link.FieldTags))))
+			s.GroupBy("tag").OrderBy(sql.Desc("count"), "tag")
+			return `"tag", COUNT(*) AS "count"`
+		}).
+		Scan(ctx, &result)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		result = []model.TagCount{}
+	}
+	return result, nil
+}
+
+func (r *entTagRepository) RenameTag(ctx context.Context, userID, from, to string) (int, error) {
+	return r.MergeTags(ctx, userID, []string{from}, to)
+}
+
+func (r *entTagRepository) MergeTags(ctx context.Context, userID string, sources []string, target string) (int, error) {
+	return r.rewriteTags(ctx, userID, sources, func(tags []string) []string {
+		return replaceTags(tags, sources, target)
+	})
+}
+
+func (r *entTagRepository) DeleteTag(ctx context.Context, userID, tag string) (int, error) {
+	return r.rewriteTags(ctx, userID, []string{tag}, func(tags []string) []string {
+		return replaceTags(tags, []string{tag}, "")
+	})
+}
+
+func (r *entTagRepository) NormalizeAllTags(ctx context.Context, normalize func([]string) []string) (int, error) {
+	const batchSize = 500
+
+	updated := 0
+	var after *uuid.UUID
+	for {
+		q := r.client.Link.
+			Query().
+			Select(link.FieldID, link.FieldTags).
+			Order(link.ByID()).
+			Limit(batchSize)
+		if after != nil {
+			q = q.Where(link.IDGT(*after))
+		}
+		entities, err := q.All(ctx)
+		if err != nil {
+			return updated, err
+		}
+
+		for _, l := range entities {
+			tags := normalize(l.Tags)
+			if slices.Equal(tags, l.Tags) {
+				continue
+			}
+			if err := r.client.Link.UpdateOneID(l.ID).SetTags(tags).Exec(ctx); err != nil {
+				return updated, err
+			}
+			updated++
+		}
+
+		if len(entities) < batchSize {
+			return updated, nil
+		}
+		last := entities[len(entities)-1].ID
+		after = &last
+	}
+}
+
+// rewriteTags applies fn to the tags of every link of userID that carries
+// any of match, in one transaction.
+func (r *entTagRepository) rewriteTags(ctx context.Context, userID string, match []string, fn func([]string) []string) (int, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := rewriteTagsTx(ctx, tx, userID, match, fn)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+func rewriteTagsTx(ctx context.Context, tx *appent.Tx, userID string, match []string, fn func([]string) []string) (int, error) {
+	entities, err := tx.Link.
+		Query().
+		Select(link.FieldID, link.FieldTags).
+		Where(link.UserIDEQ(userID)).
+		Where(func(s *sql.Selector) {
+			col := s.C(link.FieldTags)
+			preds := make([]*sql.Predicate, 0, len(match))
+			for _, t := range match {
+				jsonArr, err := jsonTagArray(t)
+				if err != nil {
+					continue
+				}
+				preds = append(preds, tagContains(col, jsonArr))
+			}
+			s.Where(sql.Or(preds...))
+		}).
+		// Lock the rows so a concurrent edit of a link's tags is not
+		// overwritten with the tags read here.
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, l := range entities {
+		if err := tx.Link.UpdateOneID(l.ID).SetTags(fn(l.Tags)).Exec(ctx); err != nil {
+			return 0, err
+		}
+	}
+	return len(entities), nil
+}
+
+// replaceTags replaces every tag in sources with target (or drops it when
+// target is empty), keeping the original order and removing duplicates.
+func replaceTags(tags, sources []string, target string) []string {
+	drop := make(map[string]struct{}, len(sources))
+	for _, s := range sources {
+		drop[s] = struct{}{}
+	}
+
+	seen := make(map[string]struct{}, len(tags))
+	out := make([]string, 0, len(tags))
+	for _, t := range tags {
+		if _, ok := drop[t]; ok {
+			if target == "" {
+				continue
+			}
+			t = target
+		}
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+		out = append(out, t)
+	}
+	return out
+}
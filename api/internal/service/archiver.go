@@ -0,0 +1,421 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/lvncer/quicklinks/api/internal/repository"
+	"github.com/lvncer/quicklinks/api/internal/storage"
+)
+
+// ErrNotArchivable is returned for pages that cannot be snapshotted (not
+// HTML, or disallowed by robots.txt). Retrying will not help.
+var ErrNotArchivable = errors.New("page cannot be archived")
+
+// errFetchTooLarge is returned by fetchLimited when the body exceeds the limit.
+var errFetchTooLarge = errors.New("response too large")
+
+const (
+	// archiveAssetTimeout bounds inlining the assets of one page. Assets not
+	// fetched by then are left out; the snapshot is still stored.
+	archiveAssetTimeout = 90 * time.Second
+	// archiveMaxAssets caps the number of stylesheets and images inlined.
+	archiveMaxAssets = 100
+	// Per-asset size caps.
+	archiveMaxStylesheet = 1 << 20
+	archiveMaxImage      = 2 << 20
+)
+
+// ArchivePolicy selects which links are archived. A link is archived if its
+// owner is listed in Users or it carries any of Tags.
+type ArchivePolicy struct {
+	Users []string
+	Tags  []string
+	// MaxBytes caps a snapshot, including inlined assets. Assets that would
+	// exceed it are left as (blocked) remote references.
+	MaxBytes int64
+}
+
+// Archiver stores single-file HTML snapshots of saved pages in a BlobStore,
+// so their content survives link rot. Stylesheets and images are inlined;
+// scripts, frames and other active content are dropped.
+type Archiver struct {
+	links    repository.LinkRepository
+	store    storage.BlobStore
+	users    map[string]struct{}
+	tags     map[string]struct{}
+	maxBytes int64
+}
+
+func NewArchiver(links repository.LinkRepository, store storage.BlobStore, policy ArchivePolicy) *Archiver {
+	a := &Archiver{
+		links:    links,
+		store:    store,
+		users:    make(map[string]struct{}, len(policy.Users)),
+		tags:     make(map[string]struct{}, len(policy.Tags)),
+		maxBytes: policy.MaxBytes,
+	}
+	for _, u := range policy.Users {
+		a.users[u] = struct{}{}
+	}
+	for _, t := range (TagNormalizer{}).Normalize(policy.Tags) {
+		a.tags[t] = struct{}{}
+	}
+	return a
+}
+
+// Wants reports whether a link of userID with the given (normalized) tags
+// should be archived.
+func (a *Archiver) Wants(userID string, tags []string) bool {
+	if a == nil {
+		return false
+	}
+	if _, ok := a.users[userID]; ok {
+		return true
+	}
+	for _, t := range tags {
+		if _, ok := a.tags[t]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Snapshot opens the stored snapshot of the link. It returns
+// storage.ErrBlobNotFound if there is none.
+func (a *Archiver) Snapshot(ctx context.Context, linkID string) (io.ReadCloser, error) {
+	return a.store.Get(ctx, snapshotKey(linkID))
+}
+
+// Delete removes the snapshot of the link, if any.
+func (a *Archiver) Delete(ctx context.Context, linkID string) error {
+	return a.store.Delete(ctx, snapshotKey(linkID))
+}
+
+// Archive fetches the link's page and stores a snapshot of it, replacing any
+// earlier one.
+func (a *Archiver) Archive(ctx context.Context, linkID string) error {
+	l, err := a.links.FindLinkByID(ctx, linkID)
+	if err != nil {
+		return err
+	}
+
+	policy := currentFetchPolicy()
+	client := newFetchClient(policy.RequestTimeout)
+	pageCtx, cancel := context.WithTimeout(ctx, policy.TotalTimeout)
+	defer cancel()
+
+	if !robotsAllowed(pageCtx, client, l.URL) {
+		return fmt.Errorf("%w: disallowed by robots.txt", ErrNotArchivable)
+	}
+	body, contentType, finalURL, err := fetchLimited(pageCtx, client, l.URL, "text/html,application/xhtml+xml", a.maxBytes)
+	if errors.Is(err, errFetchTooLarge) {
+		return fmt.Errorf("%w: %v", ErrNotArchivable, err)
+	}
+	if err != nil {
+		return err
+	}
+	if !isHTMLMediaType(contentType) {
+		mediaType, _, _ := mime.ParseMediaType(contentType)
+		return fmt.Errorf("%w: content type %q", ErrNotArchivable, mediaType)
+	}
+	return a.snapshot(ctx, client, linkID, finalURL, decodeToUTF8(body, contentType))
+}
+
+// ArchivePage stores a snapshot of a page already fetched for the link's
+// metadata, replacing any earlier one. Only its assets are downloaded.
+func (a *Archiver) ArchivePage(ctx context.Context, linkID string, page *FetchedPage) error {
+	if a.maxBytes > 0 && int64(len(page.Body)) > a.maxBytes {
+		return fmt.Errorf("%w: %v", ErrNotArchivable, errFetchTooLarge)
+	}
+	client := newFetchClient(currentFetchPolicy().RequestTimeout)
+	return a.snapshot(ctx, client, linkID, page.URL, page.Body)
+}
+
+// snapshot inlines the assets of the UTF-8 page body fetched from finalURL
+// and saves the snapshot.
+func (a *Archiver) snapshot(ctx context.Context, client *http.Client, linkID string, finalURL *url.URL, body []byte) error {
+	assetCtx, cancelAssets := context.WithTimeout(ctx, archiveAssetTimeout)
+	defer cancelAssets()
+	snapshot, err := a.buildSnapshot(assetCtx, client, finalURL, body)
+	if err != nil {
+		return err
+	}
+	if err := a.store.Put(ctx, snapshotKey(linkID), bytes.NewReader(snapshot)); err != nil {
+		return fmt.Errorf("store snapshot: %w", err)
+	}
+	return nil
+}
+
+// snapshotKey is the blob key of a link's snapshot.
+func snapshotKey(linkID string) string {
+	return "snapshots/" + linkID + ".html"
+}
+
+// archiveDroppedElements are removed from snapshots: active content, and
+// elements that would fetch or navigate on their own.
+const archiveDroppedElements = "script, noscript, iframe, frame, frameset, object, embed, applet, " +
+	"base, meta[http-equiv], meta[charset], link:not([rel~='stylesheet']), picture > source"
+
+var reCSSURL = regexp.MustCompile(`url\(\s*(['"]?)([^'")]+)(['"]?)\s*\)`)
+
+// snapshotBuilder inlines assets into one page while tracking the budget.
+type snapshotBuilder struct {
+	ctx    context.Context
+	client *http.Client
+	size   int64
+	max    int64
+	assets int
+	inline map[string]string // absolute URL -> data URI, for repeated images
+}
+
+func (a *Archiver) buildSnapshot(ctx context.Context, client *http.Client, pageURL *url.URL, body []byte) ([]byte, error) {
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	b := &snapshotBuilder{
+		ctx:    ctx,
+		client: client,
+		size:   int64(len(body)),
+		max:    a.maxBytes,
+		inline: make(map[string]string),
+	}
+
+	doc.Find(archiveDroppedElements).Remove()
+	doc.Find("*").Each(func(_ int, s *goquery.Selection) {
+		n := s.Nodes[0]
+		kept := n.Attr[:0]
+		for _, attr := range n.Attr {
+			name := strings.ToLower(attr.Key)
+			value := strings.ToLower(strings.TrimSpace(attr.Val))
+			if strings.HasPrefix(name, "on") || name == "srcdoc" || name == "formaction" ||
+				strings.HasPrefix(value, "javascript:") {
+				continue
+			}
+			kept = append(kept, attr)
+		}
+		n.Attr = kept
+	})
+
+	doc.Find("link[rel~='stylesheet'][href]").Each(func(_ int, s *goquery.Selection) {
+		b.inlineStylesheet(pageURL, s)
+	})
+	doc.Find("style").Each(func(_ int, s *goquery.Selection) {
+		setRawText(s.Nodes[0], escapeStyleText(absolutizeCSSURLs(pageURL, s.Text())))
+	})
+	doc.Find("img").Each(func(_ int, s *goquery.Selection) {
+		b.inlineImage(pageURL, s)
+	})
+
+	// Relative links keep pointing at the original site.
+	head := doc.Find("head").First()
+	head.PrependHtml(fmt.Sprintf(
+		`<meta charset="utf-8"><base href="%s"><meta name="quicklinks-archived-from" content="%s"><meta name="quicklinks-archived-at" content="%s">`,
+		html.EscapeString(pageURL.String()),
+		html.EscapeString(pageURL.String()),
+		time.Now().UTC().Format(time.RFC3339),
+	))
+
+	var buf bytes.Buffer
+	if err := html.Render(&buf, doc.Nodes[0]); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// reserve claims budget for an asset of n bytes.
+func (b *snapshotBuilder) reserve(n int64) bool {
+	if b.max > 0 && b.size+n > b.max {
+		return false
+	}
+	b.size += n
+	return true
+}
+
+// remaining is the largest asset that still fits, capped at limit.
+func (b *snapshotBuilder) remaining(limit int64) int64 {
+	if b.max <= 0 {
+		return limit
+	}
+	return min(limit, b.max-b.size)
+}
+
+// inlineStylesheet replaces <link rel=stylesheet> with a <style> element.
+// Unreachable stylesheets are dropped, since the snapshot cannot load them.
+func (b *snapshotBuilder) inlineStylesheet(pageURL *url.URL, s *goquery.Selection) {
+	href, err := pageURL.Parse(s.AttrOr("href", ""))
+	if err != nil || !isHTTPURL(href.String()) || b.assets >= archiveMaxAssets {
+		s.Remove()
+		return
+	}
+	b.assets++
+
+	limit := b.remaining(archiveMaxStylesheet)
+	if limit <= 0 {
+		s.Remove()
+		return
+	}
+	body, contentType, finalURL, err := fetchLimited(b.ctx, b.client, href.String(), "text/css,*/*;q=0.1", limit)
+	if err != nil || !b.reserve(int64(len(body))) {
+		s.Remove()
+		return
+	}
+	css := escapeStyleText(absolutizeCSSURLs(finalURL, string(decodeToUTF8(body, contentType))))
+
+	style := &html.Node{Type: html.ElementNode, DataAtom: atom.Style, Data: "style"}
+	if media := s.AttrOr("media", ""); media != "" {
+		style.Attr = []html.Attribute{{Key: "media", Val: media}}
+	}
+	setRawText(style, css)
+	s.ReplaceWithNodes(style)
+}
+
+// inlineImage replaces an <img> source with a data: URI. Lazy-loading
+// attributes are resolved first, and srcset is dropped so the inlined
+// source is the one displayed.
+func (b *snapshotBuilder) inlineImage(pageURL *url.URL, s *goquery.Selection) {
+	src := strings.TrimSpace(s.AttrOr("src", ""))
+	if src == "" || strings.HasPrefix(src, "data:") {
+		for _, attr := range []string{"data-src", "data-lazy-src", "data-original"} {
+			if v := strings.TrimSpace(s.AttrOr(attr, "")); v != "" {
+				src = v
+				break
+			}
+		}
+	}
+	s.RemoveAttr("srcset")
+	s.RemoveAttr("sizes")
+	s.RemoveAttr("loading")
+	if src == "" || strings.HasPrefix(src, "data:") {
+		return
+	}
+
+	abs, err := pageURL.Parse(src)
+	if err != nil || !isHTTPURL(abs.String()) {
+		s.RemoveAttr("src")
+		return
+	}
+	// Whatever happens below, the reference must survive as an absolute URL.
+	s.SetAttr("src", abs.String())
+
+	if dataURI, ok := b.inline[abs.String()]; ok {
+		s.SetAttr("src", dataURI)
+		return
+	}
+	if b.assets >= archiveMaxAssets {
+		return
+	}
+	b.assets++
+
+	limit := b.remaining(archiveMaxImage)
+	if limit <= 0 {
+		return
+	}
+	body, contentType, _, err := fetchLimited(b.ctx, b.client, abs.String(), "image/webp,image/png,image/jpeg,image/gif,image/svg+xml;q=0.9", limit)
+	if err != nil {
+		return
+	}
+	mediaType := http.DetectContentType(body)
+	if _, ok := imageContentTypes[mediaType]; !ok {
+		// SVG sniffs as text/xml; trust the header for it. Scripts in SVG do
+		// not run when loaded through <img>.
+		if mt, _, _ := mime.ParseMediaType(contentType); mt == "image/svg+xml" {
+			mediaType = mt
+		} else {
+			return
+		}
+	}
+
+	dataURI := "data:" + mediaType + ";base64," + base64.StdEncoding.EncodeToString(body)
+	if !b.reserve(int64(len(dataURI))) {
+		return
+	}
+	b.inline[abs.String()] = dataURI
+	s.SetAttr("src", dataURI)
+}
+
+// absolutizeCSSURLs resolves url(...) references in css against base, so
+// they stay meaningful once the stylesheet is inlined elsewhere.
+func absolutizeCSSURLs(base *url.URL, css string) string {
+	return reCSSURL.ReplaceAllStringFunc(css, func(m string) string {
+		parts := reCSSURL.FindStringSubmatch(m)
+		ref := strings.TrimSpace(parts[2])
+		if strings.HasPrefix(ref, "data:") || strings.HasPrefix(ref, "#") {
+			return m
+		}
+		u, err := base.Parse(ref)
+		if err != nil {
+			return m
+		}
+		return `url("` + strings.ReplaceAll(u.String(), `"`, `%22`) + `")`
+	})
+}
+
+// setRawText replaces the children of n with text. Unlike goquery's SetText,
+// it does not re-parse, which raw-text elements such as <style> require.
+func setRawText(n *html.Node, text string) {
+	for c := n.FirstChild; c != nil; c = n.FirstChild {
+		n.RemoveChild(c)
+	}
+	n.AppendChild(&html.Node{Type: html.TextNode, Data: text})
+}
+
+var reStyleEnd = regexp.MustCompile(`(?i)</style`)
+
+// escapeStyleText keeps CSS from closing its <style> element early; style
+// text is rendered without escaping.
+func escapeStyleText(css string) string {
+	return reStyleEnd.ReplaceAllString(css, `<\/style`)
+}
+
+// fetchLimited GETs target with the fetch policy's headers and returns at
+// most limit bytes of a 200 response, its Content-Type and the final URL
+// after redirects.
+func fetchLimited(ctx context.Context, client *http.Client, target, accept string, limit int64) ([]byte, string, *url.URL, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", target, nil)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	applyBrowserHeaders(req)
+	req.Header.Set("Accept", accept)
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, "", nil, fmt.Errorf("fetch %s returned %d", target, res.StatusCode)
+	}
+	if limit > 0 && res.ContentLength > limit {
+		return nil, "", nil, fmt.Errorf("fetch %s: %w", target, errFetchTooLarge)
+	}
+
+	r := io.Reader(res.Body)
+	if limit > 0 {
+		r = io.LimitReader(res.Body, limit+1)
+	}
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	if limit > 0 && int64(len(body)) > limit {
+		return nil, "", nil, fmt.Errorf("fetch %s: %w", target, errFetchTooLarge)
+	}
+	return body, res.Header.Get("Content-Type"), res.Request.URL, nil
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/lvncer/quicklinks/api/internal/storage"
+)
+
+func TestFetchAndParseKeepsPage(t *testing.T) {
+	const page = `<html><head><title>Kept</title></head><body><p>Body</p></body></html>`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/old":
+			http.Redirect(w, r, "/page", http.StatusMovedPermanently)
+		case "/page":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = io.WriteString(w, page)
+		case "/missing":
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, page)
+		case "/text":
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = io.WriteString(w, page)
+		case "/challenge":
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = io.WriteString(w, `<title>Just a moment...</title>`)
+		case "/huge":
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = io.WriteString(w, "<title>Huge</title>"+strings.Repeat("x", maxPageBytes))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	meta, _, err := fetchAndParse(context.Background(), srv.Client(), srv.URL+"/old")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.Page == nil {
+		t.Fatal("Page = nil")
+	}
+	if got := meta.Page.URL.String(); got != srv.URL+"/page" {
+		t.Errorf("URL = %q, want the final URL", got)
+	}
+	if string(meta.Page.Body) != page {
+		t.Errorf("Body = %q", meta.Page.Body)
+	}
+
+	for _, path := range []string{"/missing", "/text", "/challenge", "/huge"} {
+		meta, _, err := fetchAndParse(context.Background(), srv.Client(), srv.URL+path)
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		if meta.Page != nil {
+			t.Errorf("%s: Page kept, want nil", path)
+		}
+	}
+}
+
+func TestArchivePage(t *testing.T) {
+	store, err := storage.NewLocalBlobStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := NewArchiver(nil, store, ArchivePolicy{MaxBytes: 1 << 10})
+	pageURL, _ := url.Parse("https://example.com/articles/1")
+	ctx := context.Background()
+
+	err = a.ArchivePage(ctx, "link-1", &FetchedPage{
+		URL:  pageURL,
+		Body: []byte(`<html><head><title>Archived</title><script>alert(1)</script></head><body><a href="/next" onclick="x()">next</a></body></html>`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rc, err := a.Snapshot(ctx, "link-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+	b, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	snapshot := string(b)
+	for _, want := range []string{`<base href="https://example.com/articles/1"/>`, "<title>Archived</title>", `<a href="/next">next</a>`} {
+		if !strings.Contains(snapshot, want) {
+			t.Errorf("snapshot lacks %q:\n%s", want, snapshot)
+		}
+	}
+	for _, unwanted := range []string{"<script", "onclick"} {
+		if strings.Contains(snapshot, unwanted) {
+			t.Errorf("snapshot contains %q:\n%s", unwanted, snapshot)
+		}
+	}
+
+	err = a.ArchivePage(ctx, "link-2", &FetchedPage{URL: pageURL, Body: []byte(strings.Repeat("x", 2<<10))})
+	if !errors.Is(err, ErrNotArchivable) {
+		t.Errorf("oversized page: err = %v, want ErrNotArchivable", err)
+	}
+	if _, err := a.Snapshot(ctx, "link-2"); !errors.Is(err, storage.ErrBlobNotFound) {
+		t.Errorf("oversized page stored: err = %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// trackingParams are query parameters dropped by CanonicalizeURL in addition
+// to every `utm_*` parameter.
+var trackingParams = map[string]struct{}{
+	"fbclid": {},
+}
+
+// CanonicalizeURL returns the form of raw used to detect duplicate saves:
+//   - scheme and host are lowercased, default ports are dropped
+//   - the fragment is removed
+//   - `utm_*` and `fbclid` query parameters are removed; the rest are sorted
+//   - a trailing slash is removed from non-root paths
+func CanonicalizeURL(raw string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return "", err
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("url has no host: %q", raw)
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	host := strings.ToLower(u.Hostname())
+	port := u.Port()
+	if (u.Scheme == "http" && port == "80") || (u.Scheme == "https" && port == "443") {
+		port = ""
+	}
+	if port != "" {
+		u.Host = net.JoinHostPort(host, port)
+	} else if strings.Contains(host, ":") {
+		u.Host = "[" + host + "]" // IPv6 literal
+	} else {
+		u.Host = host
+	}
+
+	u.Fragment = ""
+	u.RawFragment = ""
+
+	if u.RawQuery != "" {
+		q := u.Query()
+		for k := range q {
+			if _, ok := trackingParams[strings.ToLower(k)]; ok || strings.HasPrefix(strings.ToLower(k), "utm_") {
+				q.Del(k)
+			}
+		}
+		u.RawQuery = q.Encode() // sorted by key
+	}
+
+	if u.Path == "" {
+		u.Path = "/"
+		u.RawPath = ""
+	} else if u.Path != "/" && strings.HasSuffix(u.Path, "/") {
+		u.Path = strings.TrimRight(u.Path, "/")
+		if u.Path == "" {
+			u.Path = "/"
+		}
+		u.RawPath = strings.TrimRight(u.RawPath, "/")
+	}
+
+	return u.String(), nil
+}
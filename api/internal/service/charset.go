@@ -0,0 +1,58 @@
+package service
+
+import (
+	"bytes"
+	"log"
+	"unicode/utf8"
+
+	"golang.org/x/net/html/charset"
+)
+
+// utf8BOM is stripped from UTF-8 bodies so it does not end up in the first
+// text node.
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// decodeToUTF8 transcodes a fetched body to UTF-8. The encoding is taken from
+// a BOM, then the Content-Type charset, then a <meta charset> or
+// <meta http-equiv="Content-Type"> in the first 1024 bytes, as browsers do.
+// Undeclared bodies that are not valid UTF-8 are decoded as windows-1252.
+//
+// Servers often label every response with a default Latin-1 charset; a body
+// that is valid UTF-8 despite such a label is kept as UTF-8, since Latin-1
+// text is practically never valid UTF-8 by accident.
+//
+// Bodies are cut at the fetch size limit, which can split the last UTF-8
+// character; that partial character is dropped rather than letting it make
+// the body invalid.
+func decodeToUTF8(body []byte, contentType string) []byte {
+	enc, name, _ := charset.DetermineEncoding(body, contentType)
+	if name == "utf-8" {
+		return trimIncompleteRune(bytes.TrimPrefix(body, utf8BOM))
+	}
+	if name == "windows-1252" {
+		if trimmed := trimIncompleteRune(body); utf8.Valid(trimmed) {
+			return trimmed
+		}
+	}
+
+	decoded, err := enc.NewDecoder().Bytes(body)
+	if err != nil {
+		log.Printf("failed to decode %s body: %v", name, err)
+		return body
+	}
+	return decoded
+}
+
+// trimIncompleteRune drops a UTF-8 sequence cut short at the end of b.
+func trimIncompleteRune(b []byte) []byte {
+	// Only the last utf8.UTFMax-1 bytes can start an incomplete sequence.
+	for i := len(b) - 1; i >= 0 && i > len(b)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(b[i]) {
+			if !utf8.FullRune(b[i:]) {
+				return b[:i]
+			}
+			return b
+		}
+	}
+	return b
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func readCharsetFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", "charset", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+func TestDecodeToUTF8(t *testing.T) {
+	tests := []struct {
+		name        string
+		fixture     string
+		contentType string
+		want        []string
+	}{
+		{
+			name:        "shift_jis meta charset",
+			fixture:     "shift_jis.html",
+			contentType: "text/html",
+			want:        []string{"<title>日本語のページ｜サンプル</title>", "シフトJISで書かれた説明文"},
+		},
+		{
+			name:        "shift_jis content-type",
+			fixture:     "shift_jis.html",
+			contentType: "text/html; charset=Shift_JIS",
+			want:        []string{"日本語のページ"},
+		},
+		{
+			name:        "euc-jp http-equiv",
+			fixture:     "euc_jp.html",
+			contentType: "text/html",
+			want:        []string{"<title>EUC-JPのページ</title>", "旧来の日本語サイト"},
+		},
+		{
+			// The header wins over the <meta> in the body.
+			name:        "euc-jp content-type",
+			fixture:     "euc_jp.html",
+			contentType: "text/html; charset=euc-jp",
+			want:        []string{"EUC-JPのページ"},
+		},
+		{
+			name:        "undeclared windows-1252",
+			fixture:     "windows-1252.html",
+			contentType: "text/html",
+			want:        []string{"<title>Café “Société” – Menu</title>", "Crème brûlée for 5€."},
+		},
+		{
+			name:        "latin-1 label on windows-1252",
+			fixture:     "windows-1252.html",
+			contentType: "text/html; charset=ISO-8859-1",
+			want:        []string{"Café “Société”", "5€"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := decodeToUTF8(readCharsetFixture(t, tt.fixture), tt.contentType)
+			if !utf8.Valid(got) {
+				t.Fatalf("result is not valid UTF-8: %q", got)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(string(got), want) {
+					t.Errorf("result lacks %q:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeToUTF8KeepsUTF8(t *testing.T) {
+	body := []byte("<title>日本語 – Café</title>")
+
+	if got := decodeToUTF8(append(append([]byte{}, utf8BOM...), body...), "text/html"); !bytes.Equal(got, body) {
+		t.Errorf("BOM: got %q, want %q", got, body)
+	}
+	// A default Latin-1 label does not override a body that is UTF-8.
+	if got := decodeToUTF8(body, "text/html; charset=iso-8859-1"); !bytes.Equal(got, body) {
+		t.Errorf("Latin-1 label: got %q, want %q", got, body)
+	}
+}
+
+func TestDecodeToUTF8TruncatedRune(t *testing.T) {
+	// The size limit cut "語" (3 bytes) after its first two bytes.
+	full := []byte("<title>日本語</title><p>" + strings.Repeat("本文", 10) + "語")
+	cut := full[:len(full)-1]
+
+	for _, contentType := range []string{"text/html; charset=utf-8", "text/html; charset=iso-8859-1", "text/html"} {
+		got := decodeToUTF8(cut, contentType)
+		if want := full[:len(full)-len("語")]; !bytes.Equal(got, want) {
+			t.Errorf("%s: got %q, want %q", contentType, got, want)
+		}
+	}
+}
+
+func TestTrimIncompleteRune(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"", ""},
+		{"abc", "abc"},
+		{"ab語", "ab語"},
+		{"ab\xe8\xaa", "ab"},
+		{"ab\xe8", "ab"},
+		{"ab😀", "ab😀"},
+		{"ab\xf0\x9f\x98", "ab"},
+		{"ab\xf0\x9f", "ab"},
+		{"Café", "Café"},
+		{"ab\xe9", "ab"},     // a lone Latin-1 byte looks like a cut lead byte
+		{"ab\x80", "ab\x80"}, // stray continuation bytes are left to utf8.Valid
+	}
+	for _, tt := range tests {
+		if got := string(trimIncompleteRune([]byte(tt.in))); got != tt.want {
+			t.Errorf("trimIncompleteRune(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// embedAllowedTags are the elements kept in embed HTML. Everything else is
+// unwrapped (its text is kept), except embedDroppedTags.
+var embedAllowedTags = map[string]struct{}{
+	"iframe": {}, "blockquote": {}, "p": {}, "a": {}, "br": {}, "div": {},
+	"span": {}, "em": {}, "strong": {}, "b": {}, "i": {}, "img": {},
+}
+
+// embedDroppedTags are removed together with their content.
+var embedDroppedTags = map[string]struct{}{
+	"script": {}, "style": {}, "noscript": {}, "object": {}, "embed": {},
+	"form": {}, "input": {}, "button": {}, "textarea": {}, "select": {},
+	"link": {}, "meta": {}, "base": {}, "svg": {}, "math": {}, "template": {},
+}
+
+// embedVoidTags are the dropped elements that have no end tag, so they do
+// not open a dropped region.
+var embedVoidTags = map[string]struct{}{
+	"embed": {}, "input": {}, "link": {}, "meta": {}, "base": {},
+}
+
+// embedAllowedAttrs are the attributes kept on allowed elements. URL
+// attributes are additionally checked by sanitizeEmbedURL. The iframe
+// "allow" attribute (permissions such as camera or autoplay) is not kept.
+var embedAllowedAttrs = map[string]struct{}{
+	"src": {}, "href": {}, "width": {}, "height": {}, "title": {}, "alt": {},
+	"class": {}, "lang": {}, "dir": {}, "frameborder": {},
+	"allowfullscreen": {}, "loading": {}, "referrerpolicy": {}, "cite": {},
+}
+
+// embedFrameSandbox is set on every iframe, replacing any sandbox given by
+// the provider. Players need scripts and their own origin's storage; they
+// never get top navigation, forms or the permissions of "allow".
+const embedFrameSandbox = "allow-scripts allow-same-origin allow-popups allow-popups-to-escape-sandbox allow-presentation"
+
+// SanitizeEmbedHTML reduces provider-supplied embed HTML to a small
+// allowlist: scripts, styles, event handlers and non-http(s) URLs are
+// removed, and links open with rel="noopener". Iframes must be https and
+// point at the provider itself (providerURL's host or a subdomain of it) or
+// at a known oEmbed provider; they are always sandboxed.
+//
+// Note: Providers such as X ship a <blockquote> plus a widget script; only
+// the blockquote survives, so clients must load the widget themselves.
+func SanitizeEmbedHTML(raw, providerURL string) string {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	z := html.NewTokenizer(strings.NewReader(raw))
+	dropDepth := 0      // >0 while inside an embedDroppedTags element
+	skipIframe := false // inside a rejected iframe (raw text until </iframe>)
+	var open []string   // emitted elements still awaiting their end tag
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			break
+		}
+		tok := z.Token()
+		name := tok.Data
+
+		switch tt {
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if _, drop := embedDroppedTags[name]; drop {
+				if _, void := embedVoidTags[name]; tt == html.StartTagToken && !void {
+					dropDepth++
+				}
+				continue
+			}
+			if dropDepth > 0 || skipIframe {
+				continue
+			}
+			if _, ok := embedAllowedTags[name]; !ok {
+				continue
+			}
+			attrs, ok := sanitizeEmbedAttrs(name, tok.Attr, providerURL)
+			if !ok {
+				skipIframe = name == "iframe" && tt == html.StartTagToken
+				continue
+			}
+			tok.Attr = attrs
+			if name == "br" || name == "img" {
+				tok.Type = html.SelfClosingTagToken
+				b.WriteString(tok.String())
+				continue
+			}
+			tok.Type = html.StartTagToken
+			b.WriteString(tok.String())
+			open = append(open, name)
+			if tt == html.SelfClosingTagToken {
+				b.WriteString("</" + name + ">")
+				open = open[:len(open)-1]
+			}
+		case html.EndTagToken:
+			if _, drop := embedDroppedTags[name]; drop {
+				if _, void := embedVoidTags[name]; !void && dropDepth > 0 {
+					dropDepth--
+				}
+				continue
+			}
+			if skipIframe {
+				skipIframe = name != "iframe"
+				continue
+			}
+			if dropDepth > 0 {
+				continue
+			}
+			// Close up to the matching emitted element, ignoring stray end tags.
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] != name {
+					continue
+				}
+				for j := len(open) - 1; j >= i; j-- {
+					b.WriteString("</" + open[j] + ">")
+				}
+				open = open[:i]
+				break
+			}
+		case html.TextToken:
+			if dropDepth == 0 && !skipIframe {
+				b.WriteString(html.EscapeString(tok.Data))
+			}
+		}
+	}
+	for i := len(open) - 1; i >= 0; i-- {
+		b.WriteString("</" + open[i] + ">")
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// sanitizeEmbedAttrs filters attributes of an allowed element. It reports
+// false when the element must be dropped (e.g. an iframe without a safe src).
+func sanitizeEmbedAttrs(tag string, attrs []html.Attribute, providerURL string) ([]html.Attribute, bool) {
+	out := make([]html.Attribute, 0, len(attrs)+1)
+	hasSrc := false
+	for _, a := range attrs {
+		key := strings.ToLower(a.Key)
+		if a.Namespace != "" {
+			continue
+		}
+		if _, ok := embedAllowedAttrs[key]; !ok {
+			continue
+		}
+		if key == "src" || key == "href" || key == "cite" {
+			v, ok := sanitizeEmbedURL(a.Val, tag == "iframe")
+			if !ok || (tag == "iframe" && key == "src" && !embedFrameAllowed(v, providerURL)) {
+				continue
+			}
+			a.Val = v
+			if key == "src" {
+				hasSrc = true
+			}
+		}
+		a.Key = key
+		out = append(out, a)
+	}
+
+	switch tag {
+	case "iframe":
+		if !hasSrc {
+			return nil, false
+		}
+		out = append(out, html.Attribute{Key: "sandbox", Val: embedFrameSandbox})
+	case "img":
+		if !hasSrc {
+			return nil, false
+		}
+	case "a":
+		out = append(out,
+			html.Attribute{Key: "rel", Val: "noopener noreferrer"},
+			html.Attribute{Key: "target", Val: "_blank"},
+		)
+	}
+	return out, true
+}
+
+// embedFrameAllowed reports whether an iframe may load frameURL: it must be
+// served by the provider (providerURL's host or a subdomain of it) or by one
+// of the known oEmbed providers.
+func embedFrameAllowed(frameURL, providerURL string) bool {
+	u, err := url.Parse(frameURL)
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	if p, err := url.Parse(providerURL); err == nil && p.Hostname() != "" {
+		if hostWithin(host, strings.TrimPrefix(strings.ToLower(p.Hostname()), "www.")) {
+			return true
+		}
+	}
+	for _, p := range oEmbedProviders {
+		for _, h := range p.Hosts {
+			if hostWithin(host, h) {
+				return true
+			}
+		}
+		for _, h := range p.FrameHosts {
+			if hostWithin(host, h) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hostWithin reports whether host is domain or a subdomain of it.
+func hostWithin(host, domain string) bool {
+	return host == domain || strings.HasSuffix(host, "."+domain)
+}
+
+// sanitizeEmbedURL accepts absolute http(s) URLs (https only when
+// httpsOnly) and protocol-relative URLs, which are upgraded to https.
+func sanitizeEmbedURL(raw string, httpsOnly bool) (string, bool) {
+	raw = strings.TrimSpace(raw)
+	if strings.HasPrefix(raw, "//") {
+		raw = "https:" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return "", false
+	}
+	switch u.Scheme {
+	case "https":
+	case "http":
+		if httpsOnly {
+			return "", false
+		}
+	default:
+		return "", false
+	}
+	return u.String(), true
+}
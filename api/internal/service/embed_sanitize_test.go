@@ -0,0 +1,93 @@
+package service
+
+import "testing"
+
+func TestSanitizeEmbedHTML(t *testing.T) {
+	const sandbox = ` sandbox="` + embedFrameSandbox + `"`
+	tests := []struct {
+		name     string
+		raw      string
+		provider string
+		want     string
+	}{
+		{
+			name:     "provider iframe",
+			raw:      `<iframe width="560" height="315" src="https://www.youtube.com/embed/abc" frameborder="0" allow="autoplay; camera; microphone" allowfullscreen></iframe>`,
+			provider: "https://www.youtube.com/oembed?url=x",
+			want:     `<iframe width="560" height="315" src="https://www.youtube.com/embed/abc" frameborder="0" allowfullscreen=""` + sandbox + `></iframe>`,
+		},
+		{
+			name:     "provider subdomain",
+			raw:      `<iframe src="https://player.vimeo.com/video/1"></iframe>`,
+			provider: "https://vimeo.com/api/oembed.json",
+			want:     `<iframe src="https://player.vimeo.com/video/1"` + sandbox + `></iframe>`,
+		},
+		{
+			name:     "known provider frame host",
+			raw:      `<iframe src="https://www.youtube-nocookie.com/embed/abc"></iframe>`,
+			provider: "https://oembed.example.com/oembed",
+			want:     `<iframe src="https://www.youtube-nocookie.com/embed/abc"` + sandbox + `></iframe>`,
+		},
+		{
+			name:     "provider sandbox replaced",
+			raw:      `<iframe src="https://w.soundcloud.com/player/?url=x" sandbox="allow-top-navigation allow-forms"></iframe>`,
+			provider: "https://soundcloud.com/oembed",
+			want:     `<iframe src="https://w.soundcloud.com/player/?url=x"` + sandbox + `></iframe>`,
+		},
+		{
+			name:     "foreign iframe dropped",
+			raw:      `<p>before</p><iframe src="https://evil.example.net/phish">fallback text</iframe><p>after</p>`,
+			provider: "https://www.youtube.com/oembed",
+			want:     `<p>before</p><p>after</p>`,
+		},
+		{
+			name:     "lookalike host dropped",
+			raw:      `<iframe src="https://notyoutube.com/embed/abc"></iframe>`,
+			provider: "https://www.youtube.com/oembed",
+			want:     ``,
+		},
+		{
+			name:     "http iframe dropped",
+			raw:      `<iframe src="http://www.youtube.com/embed/abc"></iframe>`,
+			provider: "https://www.youtube.com/oembed",
+			want:     ``,
+		},
+		{
+			name:     "protocol-relative iframe upgraded",
+			raw:      `<iframe src="//www.youtube.com/embed/abc"></iframe>`,
+			provider: "https://www.youtube.com/oembed",
+			want:     `<iframe src="https://www.youtube.com/embed/abc"` + sandbox + `></iframe>`,
+		},
+		{
+			name:     "scripts and handlers",
+			raw:      `<blockquote class="twitter-tweet" onclick="steal()"><p lang="en">Hello <a href="https://t.co/x" onmouseover="x()">link</a></p></blockquote><script async src="https://platform.twitter.com/widgets.js"></script>`,
+			provider: "https://publish.twitter.com/oembed",
+			want:     `<blockquote class="twitter-tweet"><p lang="en">Hello <a href="https://t.co/x" rel="noopener noreferrer" target="_blank">link</a></p></blockquote>`,
+		},
+		{
+			name:     "unsafe urls",
+			raw:      `<a href="javascript:alert(1)">x</a><img src="data:image/png;base64,AAAA"><img src="https://i.example.com/a.png" alt="a">`,
+			provider: "https://oembed.example.com/",
+			want:     `<a rel="noopener noreferrer" target="_blank">x</a><img src="https://i.example.com/a.png" alt="a"/>`,
+		},
+		{
+			name:     "dropped and unknown elements",
+			raw:      `<div><style>p{}</style><form><input></form><section>kept <b>text</b></section></div>`,
+			provider: "https://oembed.example.com/",
+			want:     `<div>kept <b>text</b></div>`,
+		},
+		{
+			name:     "unclosed elements",
+			raw:      `<div><p>open`,
+			provider: "https://oembed.example.com/",
+			want:     `<div><p>open</p></div>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SanitizeEmbedHTML(tt.raw, tt.provider); got != tt.want {
+				t.Errorf("SanitizeEmbedHTML(%q)\n got %q\nwant %q", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Document is a fetched page handed to each Extractor.
+type Document struct {
+	// URL is the fetched URL, used to resolve relative references.
+	URL string
+	// Body is the raw response body (HTML, or plain text/Markdown from the
+	// reader proxy).
+	Body []byte
+	// HTML is the parsed body. It is never nil; non-HTML bodies simply have
+	// no matching elements.
+	HTML *goquery.Document
+}
+
+// Extractor pulls metadata out of a Document.
+//
+// Extractors run in registration order and must only fill fields of m that
+// are still empty, so that earlier (more specific) sources win.
+type Extractor interface {
+	Name() string
+	Extract(doc *Document, m *Metadata)
+}
+
+// extractors is the ordered extractor chain used by ExtractMetadata.
+// JSON-LD comes after the HTML head so it only adds structured data and fills
+// what the meta tags and <title> leave empty (typically the image).
+var extractors = []Extractor{
+	openGraphExtractor{},
+	twitterCardExtractor{},
+	htmlHeadExtractor{},
+	jsonLDExtractor{},
+	oEmbedDiscoveryExtractor{},
+	iconExtractor{},
+	jinaMarkdownExtractor{},
+	readabilityExtractor{},
+}
+
+// RegisterExtractor appends e to the extractor chain. It is not safe for
+// concurrent use and should be called from init functions only.
+func RegisterExtractor(e Extractor) {
+	extractors = append(extractors, e)
+}
+
+// ExtractMetadata runs the extractor chain over a fetched body.
+func ExtractMetadata(pageURL string, body []byte) *Metadata {
+	m := &Metadata{}
+
+	html, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		// goquery only fails on reader errors; fall back to an empty document.
+		html, _ = goquery.NewDocumentFromReader(strings.NewReader(""))
+	}
+	doc := &Document{URL: pageURL, Body: body, HTML: html}
+
+	for _, e := range extractors {
+		e.Extract(doc, m)
+	}
+	return m
+}
+
+// metaContent returns the content of <meta property=key> or <meta name=key>.
+// Sites use either attribute for OG and Twitter tags.
+func metaContent(doc *goquery.Document, key string) string {
+	keyEsc := strings.ReplaceAll(key, "'", "\\'")
+	if v := strings.TrimSpace(doc.Find("meta[property='"+keyEsc+"']").AttrOr("content", "")); v != "" {
+		return v
+	}
+	if v := strings.TrimSpace(doc.Find("meta[name='"+keyEsc+"']").AttrOr("content", "")); v != "" {
+		return v
+	}
+	return ""
+}
+
+// setIfEmpty sets *dst to the first non-empty value unless it is already set.
+func setIfEmpty(dst *string, values ...string) {
+	if *dst != "" {
+		return
+	}
+	*dst = firstNonEmpty(values...)
+}
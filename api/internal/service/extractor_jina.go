@@ -0,0 +1,47 @@
+package service
+
+import (
+	"regexp"
+	"strings"
+)
+
+// jinaMarkdownExtractor reads the plain-text/Markdown output of the r.jina.ai
+// reader proxy ("Title: ..." header and Markdown images). It only runs when
+// no image was found, as the HTML extractors are more precise.
+type jinaMarkdownExtractor struct{}
+
+func (jinaMarkdownExtractor) Name() string { return "jina" }
+
+func (jinaMarkdownExtractor) Extract(doc *Document, m *Metadata) {
+	if m.Image != "" {
+		return
+	}
+	text := string(doc.Body)
+	setIfEmpty(&m.Title, parseJinaTitle(text))
+	setIfEmpty(&m.Image, extractFirstImageURL(text))
+}
+
+func parseJinaTitle(text string) string {
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "Title:") {
+			return strings.TrimSpace(strings.TrimPrefix(line, "Title:"))
+		}
+	}
+	return ""
+}
+
+var (
+	reMarkdownImage = regexp.MustCompile(`!\[[^\]]*\]\((https?://[^)\s]+)\)`)
+	reImageURL      = regexp.MustCompile(`https?://[^\s)]+?\.(?:png|jpe?g|webp)(?:\?[^\s)]*)?`)
+)
+
+func extractFirstImageURL(text string) string {
+	if m := reMarkdownImage.FindStringSubmatch(text); len(m) == 2 {
+		return strings.TrimRight(m[1], ")")
+	}
+	if m := reImageURL.FindString(text); m != "" {
+		return strings.TrimRight(m, ")")
+	}
+	return ""
+}
@@ -0,0 +1,257 @@
+package service
+
+import (
+	"encoding/json"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// jsonLDTypes are the schema.org types whose name/description/image are used.
+var jsonLDTypes = map[string]struct{}{
+	"Article":          {},
+	"NewsArticle":      {},
+	"BlogPosting":      {},
+	"TechArticle":      {},
+	"ScholarlyArticle": {},
+	"Report":           {},
+	"Product":          {},
+	"VideoObject":      {},
+}
+
+// jsonLDExtractor reads schema.org structured data from
+// <script type="application/ld+json">, including @graph containers.
+type jsonLDExtractor struct{}
+
+func (jsonLDExtractor) Name() string { return "jsonld" }
+
+func (jsonLDExtractor) Extract(doc *Document, m *Metadata) {
+	for _, node := range jsonLDNodes(doc.HTML) {
+		typ := jsonLDMatchType(node, jsonLDTypes)
+		if typ == "" {
+			continue
+		}
+		sd := &m.Structured
+		setIfEmpty(&sd.Type, typ)
+		setIfEmpty(&sd.Author, jsonLDAuthor(node["author"]), jsonLDAuthor(node["creator"]))
+		setIfEmpty(&sd.Section, jsonLDString(node["articleSection"]), jsonLDString(node["genre"]))
+		if sd.PublishedAt == nil {
+			sd.PublishedAt = parseJSONLDDate(firstNonEmpty(jsonLDString(node["datePublished"]), jsonLDString(node["uploadDate"])))
+		}
+		if len(sd.Keywords) == 0 {
+			sd.Keywords = jsonLDKeywords(node["keywords"])
+		}
+		if sd.WordCount == 0 {
+			sd.WordCount = jsonLDInt(node["wordCount"])
+		}
+		if sd.ReadingMinutes == 0 {
+			sd.ReadingMinutes = durationMinutes(parseJSONLDDuration(jsonLDString(node["timeRequired"])))
+		}
+
+		setIfEmpty(&m.Title, jsonLDString(node["headline"]), jsonLDString(node["name"]))
+		setIfEmpty(&m.Description, jsonLDString(node["description"]))
+		setIfEmpty(&m.Image, resolveMaybeRelativeURL(doc.URL, firstNonEmpty(
+			jsonLDImage(node["image"]),
+			jsonLDImage(node["thumbnailUrl"]),
+		)))
+	}
+
+	// Pages state a word count more often than a reading time.
+	if sd := &m.Structured; sd.ReadingMinutes == 0 && sd.WordCount > 0 {
+		sd.ReadingMinutes = max(1, int(math.Ceil(float64(sd.WordCount)/wordsPerMinute)))
+	}
+}
+
+// jsonLDNodes returns every JSON object found in the page's JSON-LD scripts,
+// flattening top-level arrays and @graph containers. Invalid scripts are skipped.
+func jsonLDNodes(doc *goquery.Document) []map[string]any {
+	var nodes []map[string]any
+	var walk func(v any)
+	walk = func(v any) {
+		switch t := v.(type) {
+		case []any:
+			for _, e := range t {
+				walk(e)
+			}
+		case map[string]any:
+			if graph, ok := t["@graph"]; ok {
+				walk(graph)
+			}
+			nodes = append(nodes, t)
+		}
+	}
+
+	doc.Find("script[type='application/ld+json']").Each(func(_ int, s *goquery.Selection) {
+		var v any
+		if err := json.Unmarshal([]byte(strings.TrimSpace(s.Text())), &v); err != nil {
+			return
+		}
+		walk(v)
+	})
+	return nodes
+}
+
+// jsonLDMatchType returns the first of node's @type values (a string or an
+// array) that is in types, or "".
+func jsonLDMatchType(node map[string]any, types map[string]struct{}) string {
+	for _, t := range jsonLDStrings(node["@type"]) {
+		// Types are sometimes written as full IRIs.
+		t = strings.TrimPrefix(strings.TrimPrefix(t, "http://schema.org/"), "https://schema.org/")
+		if _, ok := types[t]; ok {
+			return t
+		}
+	}
+	return ""
+}
+
+// jsonLDAuthor handles a Person/Organization object, a plain name, or an
+// array of either, returning the first name.
+func jsonLDAuthor(v any) string {
+	switch t := v.(type) {
+	case string:
+		return strings.TrimSpace(t)
+	case map[string]any:
+		return jsonLDString(t["name"])
+	case []any:
+		for _, e := range t {
+			if s := jsonLDAuthor(e); s != "" {
+				return s
+			}
+		}
+	}
+	return ""
+}
+
+// jsonLDKeywords accepts an array of strings or a comma-separated string.
+func jsonLDKeywords(v any) []string {
+	var raw []string
+	if s, ok := v.(string); ok {
+		raw = strings.Split(s, ",")
+	} else {
+		raw = jsonLDStrings(v)
+	}
+	out := make([]string, 0, len(raw))
+	for _, k := range raw {
+		if k = strings.TrimSpace(k); k != "" {
+			out = append(out, k)
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// jsonLDDateLayouts are the ISO 8601 forms seen in the wild, most specific first.
+var jsonLDDateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// parseJSONLDDate parses an ISO 8601 date. Values without a zone are taken as
+// UTC. The result is truncated to seconds so it compares exactly once stored.
+func parseJSONLDDate(s string) *time.Time {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	for _, layout := range jsonLDDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			t = t.UTC().Truncate(time.Second)
+			return &t
+		}
+	}
+	return nil
+}
+
+// reJSONLDDuration matches the ISO 8601 durations used for timeRequired,
+// e.g. "PT5M", "PT1H30M" or "P0DT4M30S".
+var reJSONLDDuration = regexp.MustCompile(`(?i)^P(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// parseJSONLDDuration parses an ISO 8601 duration of days, hours, minutes and
+// seconds. Invalid values yield 0.
+func parseJSONLDDuration(s string) time.Duration {
+	sub := reJSONLDDuration.FindStringSubmatch(strings.TrimSpace(s))
+	if sub == nil {
+		return 0
+	}
+	var d time.Duration
+	for i, unit := range []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second} {
+		if n, err := strconv.ParseFloat(sub[i+1], 64); err == nil {
+			d += time.Duration(n * float64(unit))
+		}
+	}
+	return d
+}
+
+// durationMinutes rounds d up to whole minutes.
+func durationMinutes(d time.Duration) int {
+	return int(math.Ceil(d.Minutes()))
+}
+
+// jsonLDInt returns a non-negative integer given as a JSON number or a
+// string such as "1,234", or 0.
+func jsonLDInt(v any) int {
+	switch t := v.(type) {
+	case float64:
+		if t > 0 {
+			return int(t)
+		}
+	case string:
+		n, err := strconv.Atoi(strings.ReplaceAll(strings.TrimSpace(t), ",", ""))
+		if err == nil && n > 0 {
+			return n
+		}
+	}
+	return 0
+}
+
+// jsonLDString returns v if it is a string, or the first string of an array.
+func jsonLDString(v any) string {
+	if s := jsonLDStrings(v); len(s) > 0 {
+		return strings.TrimSpace(s[0])
+	}
+	return ""
+}
+
+func jsonLDStrings(v any) []string {
+	switch t := v.(type) {
+	case string:
+		return []string{t}
+	case []any:
+		out := make([]string, 0, len(t))
+		for _, e := range t {
+			if s, ok := e.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+// jsonLDImage handles the image shapes schema.org allows: a URL string, an
+// ImageObject ({"url": ...}), or an array of either.
+func jsonLDImage(v any) string {
+	switch t := v.(type) {
+	case string:
+		return strings.TrimSpace(t)
+	case map[string]any:
+		return firstNonEmpty(jsonLDString(t["url"]), jsonLDString(t["contentUrl"]))
+	case []any:
+		for _, e := range t {
+			if s := jsonLDImage(e); s != "" {
+				return s
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,329 @@
+package service
+
+import (
+	"bytes"
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+)
+
+// Content is the readable main text of a page.
+type Content struct {
+	Text           string `json:"text"`
+	WordCount      int    `json:"word_count"`
+	ReadingMinutes int    `json:"reading_minutes"`
+}
+
+const (
+	// minContentRunes is the shortest text kept as content; shorter results
+	// are usually navigation or an error page rather than an article.
+	minContentRunes = 250
+	// maxContentRunes caps the stored text.
+	maxContentRunes = 100_000
+
+	// Reading speeds used for ReadingMinutes.
+	wordsPerMinute    = 200
+	cjkRunesPerMinute = 500
+)
+
+// readabilityExtractor finds the main content of an HTML page, in the spirit
+// of Mozilla's Readability: boilerplate elements are dropped, paragraphs are
+// scored by length and punctuation, scores flow up to their ancestors, and
+// the best-scoring container (discounted by link density) wins.
+//
+// For reader-proxy output, the Markdown body after "Markdown Content:" is
+// used as is.
+type readabilityExtractor struct{}
+
+func (readabilityExtractor) Name() string { return "readability" }
+
+func (readabilityExtractor) Extract(doc *Document, m *Metadata) {
+	if m.Content != nil {
+		return
+	}
+
+	var text string
+	if _, md, ok := bytes.Cut(doc.Body, []byte("\nMarkdown Content:\n")); ok {
+		text = strings.TrimSpace(string(md))
+	} else {
+		text = readableText(doc.HTML)
+	}
+	m.Content = newContent(text)
+}
+
+// newContent wraps text as Content, or returns nil if it is too short.
+func newContent(text string) *Content {
+	if utf8.RuneCountInString(text) < minContentRunes {
+		return nil
+	}
+	text = truncateRunes(text, maxContentRunes)
+
+	words, cjk := countWords(text)
+	minutes := int(math.Ceil(float64(words)/wordsPerMinute + float64(cjk)/cjkRunesPerMinute))
+	return &Content{
+		Text:           text,
+		WordCount:      words + cjk,
+		ReadingMinutes: max(1, minutes),
+	}
+}
+
+var (
+	// Elements that never hold article text.
+	readabilityJunk = "script, style, noscript, template, iframe, object, embed, form, " +
+		"nav, header, footer, aside, svg, canvas, button, input, select, textarea, " +
+		"figure, [hidden], [aria-hidden='true'], [role='navigation'], [role='complementary']"
+
+	reUnlikelyCandidate = regexp.MustCompile(`(?i)banner|breadcrumb|combx|comment|community|cookie|disqus|extra|footer|gdpr|header|menu|modal|nav|pager|pagination|popup|promo|related|remark|share|shoutbox|sidebar|skyscraper|social|sponsor|subscribe|tags|tool|widget`)
+	reMaybeCandidate    = regexp.MustCompile(`(?i)and|article|body|column|content|main|shadow|post|entry|story|text`)
+	rePositiveClass     = regexp.MustCompile(`(?i)article|body|content|entry|hentry|main|page|post|text|blog|story`)
+	reNegativeClass     = regexp.MustCompile(`(?i)comment|com-|contact|foot|footer|footnote|masthead|media|meta|outbrain|promo|related|scroll|shoutbox|sidebar|sponsor|shopping|tags|tool|widget|hidden|share`)
+
+	// Sentence punctuation; more of it suggests prose.
+	reProsePunct = regexp.MustCompile(`[,、。，.!?！？]`)
+)
+
+// blockTags start a new paragraph in the extracted text.
+var blockTags = map[string]bool{
+	"address": true, "article": true, "blockquote": true, "dd": true, "div": true,
+	"dl": true, "dt": true, "figcaption": true, "h1": true, "h2": true, "h3": true,
+	"h4": true, "h5": true, "h6": true, "hr": true, "li": true, "main": true,
+	"ol": true, "p": true, "pre": true, "section": true, "table": true, "td": true,
+	"th": true, "tr": true, "ul": true,
+}
+
+// readableText returns the main text of an HTML document, or "" if none is
+// found. doc is not modified.
+func readableText(doc *goquery.Document) string {
+	body := doc.Find("body").First()
+	if body.Length() == 0 {
+		return ""
+	}
+	body = body.Clone()
+
+	body.Find(readabilityJunk).Remove()
+	body.Find("*").Each(func(_ int, s *goquery.Selection) {
+		switch goquery.NodeName(s) {
+		case "article", "main":
+			return
+		}
+		match := s.AttrOr("class", "") + " " + s.AttrOr("id", "")
+		if reUnlikelyCandidate.MatchString(match) && !reMaybeCandidate.MatchString(match) {
+			s.Remove()
+		}
+	})
+
+	top := body
+	if n := topCandidate(body); n != nil {
+		top = goquery.NewDocumentFromNode(n).Selection
+	}
+	// Drop link lists and similar clutter left inside the container.
+	top.Find("ul, ol, div, section, table").Each(func(_ int, s *goquery.Selection) {
+		if linkDensity(s) > 0.5 {
+			s.Remove()
+		}
+	})
+	return renderText(top.Nodes[0])
+}
+
+// topCandidate scores paragraph-like elements and returns the container with
+// the highest score, or nil if there is no paragraph text at all.
+func topCandidate(body *goquery.Selection) *html.Node {
+	scores := make(map[*html.Node]float64)
+	var order []*html.Node // for deterministic tie-breaking
+	addScore := func(s *goquery.Selection, score float64) {
+		if s.Length() == 0 {
+			return
+		}
+		n := s.Nodes[0]
+		if _, ok := scores[n]; !ok {
+			scores[n] = initialScore(s)
+			order = append(order, n)
+		}
+		scores[n] += score
+	}
+
+	body.Find("p, pre, td, blockquote, div").Each(func(_ int, s *goquery.Selection) {
+		// A div counts as a paragraph only if it holds text directly.
+		if goquery.NodeName(s) == "div" && s.Find("p, div, pre, table, ul, ol, blockquote").Length() > 0 {
+			return
+		}
+		text := collapseSpace(s.Text())
+		runes := utf8.RuneCountInString(text)
+		if runes < 25 {
+			return
+		}
+		score := 1 + float64(len(reProsePunct.FindAllStringIndex(text, -1))) + math.Min(float64(runes)/100, 3)
+		addScore(s.Parent(), score)
+		addScore(s.Parent().Parent(), score/2)
+	})
+
+	var best *html.Node
+	bestScore := 0.0
+	for _, n := range order {
+		s := goquery.NewDocumentFromNode(n).Selection
+		score := scores[n] * (1 - linkDensity(s))
+		if best == nil || score > bestScore {
+			best, bestScore = n, score
+		}
+	}
+	return best
+}
+
+// initialScore weighs a candidate by its tag and class/id names.
+func initialScore(s *goquery.Selection) float64 {
+	var score float64
+	switch goquery.NodeName(s) {
+	case "article", "main":
+		score = 10
+	case "div":
+		score = 5
+	case "pre", "td", "blockquote":
+		score = 3
+	case "address", "ol", "ul", "dl", "dd", "dt", "li", "form":
+		score = -3
+	case "h1", "h2", "h3", "h4", "h5", "h6", "th":
+		score = -5
+	}
+	for _, v := range []string{s.AttrOr("class", ""), s.AttrOr("id", "")} {
+		if v == "" {
+			continue
+		}
+		if reNegativeClass.MatchString(v) {
+			score -= 25
+		}
+		if rePositiveClass.MatchString(v) {
+			score += 25
+		}
+	}
+	return score
+}
+
+// linkDensity is the share of s's text that is inside links.
+func linkDensity(s *goquery.Selection) float64 {
+	total := utf8.RuneCountInString(collapseSpace(s.Text()))
+	if total == 0 {
+		return 0
+	}
+	var linked int
+	s.Find("a").Each(func(_ int, a *goquery.Selection) {
+		linked += utf8.RuneCountInString(collapseSpace(a.Text()))
+	})
+	return float64(linked) / float64(total)
+}
+
+// renderText flattens n to plain text: one paragraph per block element,
+// separated by blank lines, with whitespace collapsed (except in <pre>).
+func renderText(n *html.Node) string {
+	var (
+		paragraphs []string
+		cur        strings.Builder
+	)
+	space := func() {
+		if s := cur.String(); s != "" && !strings.HasSuffix(s, " ") && !strings.HasSuffix(s, "\n") {
+			cur.WriteByte(' ')
+		}
+	}
+	flush := func() {
+		if p := strings.TrimSpace(cur.String()); p != "" {
+			paragraphs = append(paragraphs, p)
+		}
+		cur.Reset()
+	}
+
+	var walk func(n *html.Node, pre bool)
+	walk = func(n *html.Node, pre bool) {
+		switch n.Type {
+		case html.TextNode:
+			if pre {
+				cur.WriteString(n.Data)
+				return
+			}
+			// Keep one space at the edges of inline runs ("a <b>b</b> c").
+			if strings.TrimLeftFunc(n.Data, unicode.IsSpace) != n.Data {
+				space()
+			}
+			if t := collapseSpace(n.Data); t != "" {
+				cur.WriteString(t)
+				if strings.TrimRightFunc(n.Data, unicode.IsSpace) != n.Data {
+					space()
+				}
+			}
+			return
+		case html.ElementNode:
+			if n.Data == "br" {
+				cur.WriteByte('\n')
+				return
+			}
+		}
+
+		block := n.Type == html.ElementNode && blockTags[n.Data]
+		if block {
+			flush()
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c, pre || n.Data == "pre")
+		}
+		if block {
+			flush()
+		}
+	}
+	walk(n, false)
+	flush()
+
+	for i, p := range paragraphs {
+		lines := strings.Split(p, "\n")
+		for j, l := range lines {
+			lines[j] = strings.TrimRightFunc(l, unicode.IsSpace)
+		}
+		paragraphs[i] = strings.Join(lines, "\n")
+	}
+	return strings.Join(paragraphs, "\n\n")
+}
+
+// collapseSpace replaces runs of whitespace with single spaces.
+func collapseSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// countWords counts space-separated words and, separately, CJK characters
+// (which are written without spaces).
+func countWords(text string) (words, cjk int) {
+	inWord := false
+	for _, r := range text {
+		switch {
+		// The prolonged sound mark (ー, ｰ) belongs to no script but is part
+		// of katakana words.
+		case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul), r == 'ー', r == 'ｰ':
+			cjk++
+			inWord = false
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if !inWord {
+				words++
+			}
+			inWord = true
+		default:
+			inWord = false
+		}
+	}
+	return words, cjk
+}
+
+// truncateRunes cuts s to at most n runes.
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	i := 0
+	for j := range s {
+		if i == n {
+			return s[:j]
+		}
+		i++
+	}
+	return s
+}
@@ -0,0 +1,365 @@
+package service
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+const articleURL = "https://news.example.com/2024/story"
+
+// loadDocument parses testdata/extract/name as if fetched from pageURL.
+func loadDocument(t *testing.T, name, pageURL string) *Document {
+	t.Helper()
+	body := readExtractFixture(t, name)
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("parse %s: %v", name, err)
+	}
+	return &Document{URL: pageURL, Body: body, HTML: doc}
+}
+
+func readExtractFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", "extract", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+func TestExtractorsOnArticle(t *testing.T) {
+	tests := []struct {
+		extractor Extractor
+		want      Metadata
+	}{
+		{
+			extractor: openGraphExtractor{},
+			want: Metadata{
+				Title:       "OG Title",
+				Description: "OG description.",
+				Image:       "https://news.example.com/images/og.png",
+				SiteName:    "Example News",
+			},
+		},
+		{
+			extractor: twitterCardExtractor{},
+			want: Metadata{
+				Title:       "Twitter Title",
+				Description: "Twitter description.",
+				Image:       "https://cdn.example.com/twitter.png",
+			},
+		},
+		{
+			extractor: htmlHeadExtractor{},
+			want: Metadata{
+				Title:       "Head Title | Example News",
+				Description: "Head description.",
+			},
+		},
+		{
+			extractor: oEmbedDiscoveryExtractor{},
+			want: Metadata{
+				OEmbedURL: "https://news.example.com/oembed?url=https%3A%2F%2Fnews.example.com%2F2024%2Fstory",
+			},
+		},
+		{
+			extractor: iconExtractor{},
+			want: Metadata{
+				Icon: "https://news.example.com/favicon.ico",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.extractor.Name(), func(t *testing.T) {
+			doc := loadDocument(t, "article.html", articleURL)
+			var got Metadata
+			tt.extractor.Extract(doc, &got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONLDExtractor(t *testing.T) {
+	doc := loadDocument(t, "article.html", articleURL)
+	var got Metadata
+	jsonLDExtractor{}.Extract(doc, &got)
+
+	if got.Title != "JSON-LD Headline" {
+		t.Errorf("Title = %q", got.Title)
+	}
+	if got.Description != "JSON-LD description." {
+		t.Errorf("Description = %q", got.Description)
+	}
+	if got.Image != "https://cdn.example.com/jsonld.png" {
+		t.Errorf("Image = %q", got.Image)
+	}
+
+	sd := got.Structured
+	if sd.Type != "NewsArticle" {
+		t.Errorf("Type = %q, want NewsArticle (WebSite is not a known type)", sd.Type)
+	}
+	if sd.Author != "Jane Doe" {
+		t.Errorf("Author = %q, want the first author", sd.Author)
+	}
+	if sd.Section != "Science" {
+		t.Errorf("Section = %q", sd.Section)
+	}
+	if want := []string{"space", "telescopes"}; !reflect.DeepEqual(sd.Keywords, want) {
+		t.Errorf("Keywords = %q, want %q", sd.Keywords, want)
+	}
+	want := time.Date(2024, 3, 5, 0, 30, 0, 0, time.UTC)
+	if sd.PublishedAt == nil || !sd.PublishedAt.Equal(want) {
+		t.Errorf("PublishedAt = %v, want %v", sd.PublishedAt, want)
+	}
+	// No timeRequired: estimated from wordCount at 200 words per minute.
+	if sd.WordCount != 1250 || sd.ReadingMinutes != 7 {
+		t.Errorf("WordCount = %d, ReadingMinutes = %d, want 1250 and 7", sd.WordCount, sd.ReadingMinutes)
+	}
+}
+
+func TestJSONLDExtractorTimeRequired(t *testing.T) {
+	doc := loadDocument(t, "head_only.html", "https://site.example.org/posts/1")
+	var got Metadata
+	jsonLDExtractor{}.Extract(doc, &got)
+
+	// timeRequired wins over the word count estimate (10 minutes).
+	if sd := got.Structured; sd.WordCount != 2000 || sd.ReadingMinutes != 4 {
+		t.Errorf("WordCount = %d, ReadingMinutes = %d, want 2000 and 4", sd.WordCount, sd.ReadingMinutes)
+	}
+}
+
+func TestParseJSONLDDuration(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"PT5M", 5 * time.Minute},
+		{"PT1H30M", 90 * time.Minute},
+		{"P0DT4M30S", 4*time.Minute + 30*time.Second},
+		{"pt90s", 90 * time.Second},
+		{"PT1.5S", 1500 * time.Millisecond},
+		{"P1D", 24 * time.Hour},
+		{" PT2M ", 2 * time.Minute},
+		{"5 minutes", 0},
+		{"PT", 0},
+		{"", 0},
+	}
+	for _, tt := range tests {
+		if got := parseJSONLDDuration(tt.in); got != tt.want {
+			t.Errorf("parseJSONLDDuration(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestJSONLDExtractorKeepsEarlierFields(t *testing.T) {
+	doc := loadDocument(t, "article.html", articleURL)
+	got := Metadata{Title: "Earlier", Image: "https://example.com/earlier.png"}
+	jsonLDExtractor{}.Extract(doc, &got)
+
+	if got.Title != "Earlier" || got.Image != "https://example.com/earlier.png" {
+		t.Errorf("overwrote earlier fields: %+v", got)
+	}
+	if got.Description != "JSON-LD description." {
+		t.Errorf("Description = %q, want the empty field filled", got.Description)
+	}
+}
+
+func TestJinaMarkdownExtractor(t *testing.T) {
+	doc := loadDocument(t, "reader.txt", "https://blog.example.net/post")
+	var got Metadata
+	jinaMarkdownExtractor{}.Extract(doc, &got)
+
+	if got.Title != "Reader Proxy Title" {
+		t.Errorf("Title = %q", got.Title)
+	}
+	if got.Image != "https://blog.example.net/media/cover.webp" {
+		t.Errorf("Image = %q", got.Image)
+	}
+
+	// HTML extractors are preferred: an image found earlier skips it.
+	got = Metadata{Image: "https://example.com/og.png"}
+	jinaMarkdownExtractor{}.Extract(doc, &got)
+	if got.Title != "" {
+		t.Errorf("Title = %q, want the extractor skipped", got.Title)
+	}
+}
+
+func TestReadabilityExtractor(t *testing.T) {
+	t.Run("html", func(t *testing.T) {
+		doc := loadDocument(t, "article.html", articleURL)
+		var got Metadata
+		readabilityExtractor{}.Extract(doc, &got)
+		if got.Content == nil {
+			t.Fatal("Content = nil")
+		}
+		if !strings.Contains(got.Content.Text, "Astronomers announced") {
+			t.Errorf("Text = %.80q, want the article body", got.Content.Text)
+		}
+		for _, boilerplate := range []string{"Copyright", "Home"} {
+			if strings.Contains(got.Content.Text, boilerplate) {
+				t.Errorf("Text contains boilerplate %q", boilerplate)
+			}
+		}
+		if got.Content.WordCount == 0 || got.Content.ReadingMinutes == 0 {
+			t.Errorf("WordCount = %d, ReadingMinutes = %d", got.Content.WordCount, got.Content.ReadingMinutes)
+		}
+	})
+
+	t.Run("reader", func(t *testing.T) {
+		doc := loadDocument(t, "reader.txt", "https://blog.example.net/post")
+		var got Metadata
+		readabilityExtractor{}.Extract(doc, &got)
+		if got.Content == nil {
+			t.Fatal("Content = nil")
+		}
+		if !strings.HasPrefix(got.Content.Text, "![cover]") {
+			t.Errorf("Text starts with %.40q, want the Markdown body", got.Content.Text)
+		}
+		for _, header := range []string{"Title:", "URL Source:", "Markdown Content:"} {
+			if strings.Contains(got.Content.Text, header) {
+				t.Errorf("Text contains the reader header %q", header)
+			}
+		}
+		if !strings.HasSuffix(got.Content.Text, "rather than an error message.") {
+			t.Errorf("Text ends with %.40q, want the whole body, trimmed", got.Content.Text[max(0, len(got.Content.Text)-40):])
+		}
+		if got.Content.WordCount != 81 || got.Content.ReadingMinutes != 1 {
+			t.Errorf("WordCount = %d, ReadingMinutes = %d, want 81, 1", got.Content.WordCount, got.Content.ReadingMinutes)
+		}
+	})
+
+	t.Run("blog", func(t *testing.T) {
+		// No <article>: the post is a div among a sidebar, related posts and
+		// comments.
+		doc := loadDocument(t, "blog.html", "https://notes.example.dev/posts/profiling")
+		var got Metadata
+		readabilityExtractor{}.Extract(doc, &got)
+		if got.Content == nil {
+			t.Fatal("Content = nil")
+		}
+		text := got.Content.Text
+		if !strings.HasPrefix(text, "Profiling a slow HTTP handler\n\nLast week one of our endpoints") {
+			t.Errorf("Text starts with %.80q, want the heading and first paragraph", text)
+		}
+		for _, want := range []string{
+			"go tool pprof -http=:8080 cpu.out\ntop 10", // <pre> keeps its lines
+			"memory use dropped by half as a side effect.",
+		} {
+			if !strings.Contains(text, want) {
+				t.Errorf("Text lacks %q", want)
+			}
+		}
+		for _, boilerplate := range []string{"Popular posts", "Share on", "Related", "Distributed tracing", "Great write-up", "comments", "Copyright", "Archive"} {
+			if strings.Contains(text, boilerplate) {
+				t.Errorf("Text contains boilerplate %q", boilerplate)
+			}
+		}
+	})
+
+	t.Run("nav-heavy", func(t *testing.T) {
+		// A portal page of link lists has no article.
+		doc := loadDocument(t, "nav_heavy.html", "https://portal.example.com/")
+		var got Metadata
+		readabilityExtractor{}.Extract(doc, &got)
+		if got.Content != nil {
+			t.Errorf("Content = %.80q, want nil for a page of links", got.Content.Text)
+		}
+	})
+
+	t.Run("japanese", func(t *testing.T) {
+		doc := loadDocument(t, "japanese.html", "https://town.example.jp/a/42")
+		var got Metadata
+		readabilityExtractor{}.Extract(doc, &got)
+		if got.Content == nil {
+			t.Fatal("Content = nil")
+		}
+		text := got.Content.Text
+		if !strings.HasPrefix(text, "図書館の本を電子化する取り組み\n\n市立図書館は今年の春から") {
+			t.Errorf("Text starts with %.40q, want the heading and first paragraph", text)
+		}
+		for _, boilerplate := range []string{"ニュース", "よく読まれている記事", "編集部"} {
+			if strings.Contains(text, boilerplate) {
+				t.Errorf("Text contains boilerplate %q", boilerplate)
+			}
+		}
+		// 505 kana and kanji (including the ー of ページ) and no spaced
+		// words; punctuation does not count. At 500 characters a minute
+		// that is two minutes.
+		if got.Content.WordCount != 505 || got.Content.ReadingMinutes != 2 {
+			t.Errorf("WordCount = %d, ReadingMinutes = %d, want 505, 2", got.Content.WordCount, got.Content.ReadingMinutes)
+		}
+	})
+
+	t.Run("short", func(t *testing.T) {
+		doc := loadDocument(t, "head_only.html", "https://site.example.org/posts/1")
+		var got Metadata
+		readabilityExtractor{}.Extract(doc, &got)
+		if got.Content != nil {
+			t.Errorf("Content = %+v, want nil for a page without an article", got.Content)
+		}
+	})
+}
+
+func TestCountWords(t *testing.T) {
+	tests := []struct {
+		text       string
+		words, cjk int
+	}{
+		{"Hello, world!", 2, 0},
+		{"don't stop-believing 2024", 5, 0},
+		{"日本語の文章。", 0, 6},
+		{"ページ", 0, 3},
+		{"Go 言語で API を書く", 2, 6},
+		{"한국어 text", 1, 3},
+	}
+	for _, tt := range tests {
+		if words, cjk := countWords(tt.text); words != tt.words || cjk != tt.cjk {
+			t.Errorf("countWords(%q) = %d, %d, want %d, %d", tt.text, words, cjk, tt.words, tt.cjk)
+		}
+	}
+}
+
+func TestExtractMetadataPrecedence(t *testing.T) {
+	t.Run("meta tags first", func(t *testing.T) {
+		got := ExtractMetadata(articleURL, readExtractFixture(t, "article.html"))
+		if got.Title != "OG Title" || got.Description != "OG description." {
+			t.Errorf("Title = %q, Description = %q, want the og:* values", got.Title, got.Description)
+		}
+		if got.Image != "https://news.example.com/images/og.png" {
+			t.Errorf("Image = %q", got.Image)
+		}
+		if got.Structured.Type != "NewsArticle" {
+			t.Errorf("Structured.Type = %q", got.Structured.Type)
+		}
+	})
+
+	// JSON-LD runs after <title>, so adding it did not change the title and
+	// description of pages without OG tags; it only fills what is missing.
+	t.Run("head before json-ld", func(t *testing.T) {
+		got := ExtractMetadata("https://site.example.org/posts/1", readExtractFixture(t, "head_only.html"))
+		if got.Title != "Plain Page Title" {
+			t.Errorf("Title = %q, want the <title>", got.Title)
+		}
+		if got.Description != "Plain description." {
+			t.Errorf("Description = %q, want the meta description", got.Description)
+		}
+		if got.Image != "https://site.example.org/posts/img/cover.jpg" {
+			t.Errorf("Image = %q, want the JSON-LD image", got.Image)
+		}
+		if got.Structured.Type != "BlogPosting" || got.Structured.Author != "Alice" {
+			t.Errorf("Structured = %+v", got.Structured)
+		}
+		if got.Icon != "https://static.example.org/icon.svg" {
+			t.Errorf("Icon = %q", got.Icon)
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// openGraphExtractor reads og:* meta tags.
+type openGraphExtractor struct{}
+
+func (openGraphExtractor) Name() string { return "opengraph" }
+
+func (openGraphExtractor) Extract(doc *Document, m *Metadata) {
+	setIfEmpty(&m.Title, metaContent(doc.HTML, "og:title"))
+	setIfEmpty(&m.Description, metaContent(doc.HTML, "og:description"))
+	setIfEmpty(&m.Image, resolveMaybeRelativeURL(doc.URL, firstNonEmpty(
+		metaContent(doc.HTML, "og:image"),
+		metaContent(doc.HTML, "og:image:url"),
+	)))
+	setIfEmpty(&m.SiteName, metaContent(doc.HTML, "og:site_name"))
+}
+
+// twitterCardExtractor reads twitter:* meta tags.
+type twitterCardExtractor struct{}
+
+func (twitterCardExtractor) Name() string { return "twitter" }
+
+func (twitterCardExtractor) Extract(doc *Document, m *Metadata) {
+	setIfEmpty(&m.Title, metaContent(doc.HTML, "twitter:title"))
+	setIfEmpty(&m.Description, metaContent(doc.HTML, "twitter:description"))
+	setIfEmpty(&m.Image, resolveMaybeRelativeURL(doc.URL, firstNonEmpty(
+		metaContent(doc.HTML, "twitter:image"),
+		metaContent(doc.HTML, "twitter:image:src"),
+	)))
+}
+
+// htmlHeadExtractor falls back to <title> and <meta name=description>.
+type htmlHeadExtractor struct{}
+
+func (htmlHeadExtractor) Name() string { return "html" }
+
+func (htmlHeadExtractor) Extract(doc *Document, m *Metadata) {
+	setIfEmpty(&m.Title, strings.TrimSpace(doc.HTML.Find("title").First().Text()))
+	setIfEmpty(&m.Description, metaContent(doc.HTML, "description"))
+}
+
+// oEmbedDiscoveryExtractor finds the page's oEmbed endpoint from
+// <link rel="alternate" type="application/json+oembed">.
+type oEmbedDiscoveryExtractor struct{}
+
+func (oEmbedDiscoveryExtractor) Name() string { return "oembed-discovery" }
+
+func (oEmbedDiscoveryExtractor) Extract(doc *Document, m *Metadata) {
+	href := doc.HTML.Find("link[type='application/json+oembed']").FilterFunction(func(_ int, s *goquery.Selection) bool {
+		return hasRel(s, "alternate")
+	}).First().AttrOr("href", "")
+	setIfEmpty(&m.OEmbedURL, resolveMaybeRelativeURL(doc.URL, href))
+}
+
+// iconExtractor finds the site icon from <link rel=icon>, "shortcut icon" or
+// apple-touch-icon, in that order.
+type iconExtractor struct{}
+
+func (iconExtractor) Name() string { return "icon" }
+
+func (iconExtractor) Extract(doc *Document, m *Metadata) {
+	for _, rel := range []string{"icon", "apple-touch-icon"} {
+		href := doc.HTML.Find("link[rel]").FilterFunction(func(_ int, s *goquery.Selection) bool {
+			return hasRel(s, rel)
+		}).First().AttrOr("href", "")
+		if href != "" {
+			setIfEmpty(&m.Icon, resolveMaybeRelativeURL(doc.URL, href))
+			return
+		}
+	}
+}
+
+// hasRel reports whether the space-separated rel attribute contains rel
+// (case-insensitively), so "shortcut icon" matches "icon".
+func hasRel(s *goquery.Selection, rel string) bool {
+	for _, v := range strings.Fields(s.AttrOr("rel", "")) {
+		if strings.EqualFold(v, rel) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FetchPolicy controls how server-side fetches of user-supplied URLs are made.
+type FetchPolicy struct {
+	// RequestTimeout bounds a single HTTP request, including redirects.
+	RequestTimeout time.Duration
+	// TotalTimeout bounds one FetchMetadata call, including the fallback.
+	TotalTimeout   time.Duration
+	UserAgent      string
+	AcceptLanguage string
+	// FallbackReaderURL is prefixed to the target URL when the direct fetch
+	// is blocked or empty (e.g. "https://r.jina.ai/"). Empty disables it.
+	FallbackReaderURL string
+	// Proxy, if set, routes every fetch through an egress proxy, which then
+	// resolves hosts itself (see newGuardedTransport). ProxyPinAddress
+	// resolves and checks them here and tunnels to the checked address.
+	Proxy           *url.URL
+	ProxyPinAddress bool
+	// DomainOverrides replace headers for specific hosts. Keys match the
+	// host and its subdomains.
+	DomainOverrides map[string]DomainOverride
+
+	// Per-host throttling: HostRate requests per second with bursts of
+	// HostBurst, and at most HostConcurrency requests in flight. Zero
+	// disables the respective limit.
+	HostRate        float64
+	HostBurst       int
+	HostConcurrency int
+
+	// RespectRobots skips pages disallowed by the host's robots.txt for
+	// RobotsUserAgent. Robots files are cached for RobotsCacheTTL.
+	RespectRobots   bool
+	RobotsUserAgent string
+	RobotsCacheTTL  time.Duration
+}
+
+// DomainOverride holds per-domain request headers. Empty fields keep the
+// policy defaults.
+type DomainOverride struct {
+	UserAgent      string `json:"user_agent"`
+	AcceptLanguage string `json:"accept_language"`
+	Cookie         string `json:"cookie"`
+}
+
+// DefaultFetchPolicy is the policy used until SetFetchPolicy is called.
+func DefaultFetchPolicy() FetchPolicy {
+	return FetchPolicy{
+		RequestTimeout: 10 * time.Second,
+		TotalTimeout:   15 * time.Second,
+		// Some sites (e.g. behind Cloudflare) may block obvious bot UAs from cloud IPs.
+		// Use a browser-like UA to improve fetch success rates.
+		UserAgent:         "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/130.0.0.0 Safari/537.36",
+		AcceptLanguage:    "ja,en-US;q=0.9,en;q=0.8",
+		FallbackReaderURL: "https://r.jina.ai/",
+		HostRate:          1,
+		HostBurst:         3,
+		HostConcurrency:   2,
+		RobotsUserAgent:   "QuickLinks",
+		RobotsCacheTTL:    24 * time.Hour,
+	}
+}
+
+// State derived from the policy. Guarded by fetchPolicyMu.
+var (
+	fetchPolicyMu sync.RWMutex
+	fetchPolicy   FetchPolicy
+	// Transports shared by every fetch client so connections are pooled
+	// and the per-host limits are global.
+	fetchTransport  http.RoundTripper
+	readerTransport http.RoundTripper
+	robots          *robotsCache
+)
+
+func init() {
+	SetFetchPolicy(DefaultFetchPolicy())
+}
+
+// SetFetchPolicy replaces the fetch policy. Call it once at startup, before
+// any fetch runs.
+func SetFetchPolicy(p FetchPolicy) {
+	fetchPolicyMu.Lock()
+	defer fetchPolicyMu.Unlock()
+
+	overrides := make(map[string]DomainOverride, len(p.DomainOverrides))
+	for host, o := range p.DomainOverrides {
+		overrides[strings.TrimPrefix(strings.ToLower(strings.TrimSpace(host)), "www.")] = o
+	}
+	p.DomainOverrides = overrides
+	fetchPolicy = p
+
+	limiter := newHostLimiter(p.HostRate, p.HostBurst, p.HostConcurrency)
+	fetchTransport = &limitedTransport{base: newGuardedTransport(p.Proxy, p.ProxyPinAddress), limiter: limiter}
+	readerTransport = &limitedTransport{base: newReaderTransport(p.Proxy), limiter: limiter}
+	robots = nil
+	if p.RespectRobots {
+		robots = newRobotsCache(p.RobotsUserAgent, p.RobotsCacheTTL)
+	}
+}
+
+// robotsAllowed reports whether target may be fetched. It is always true
+// when RespectRobots is off.
+func robotsAllowed(ctx context.Context, client *http.Client, target string) bool {
+	fetchPolicyMu.RLock()
+	rc := robots
+	fetchPolicyMu.RUnlock()
+	if rc == nil {
+		return true
+	}
+	return rc.allowed(ctx, client, target)
+}
+
+func currentFetchPolicy() FetchPolicy {
+	fetchPolicyMu.RLock()
+	defer fetchPolicyMu.RUnlock()
+	return fetchPolicy
+}
+
+// override returns the DomainOverride for host, matching parent domains too
+// (an entry for "example.com" applies to "news.example.com").
+func (p FetchPolicy) override(host string) (DomainOverride, bool) {
+	host = strings.TrimPrefix(strings.ToLower(host), "www.")
+	for h := host; h != ""; {
+		if o, ok := p.DomainOverrides[h]; ok {
+			return o, true
+		}
+		i := strings.IndexByte(h, '.')
+		if i < 0 {
+			break
+		}
+		h = h[i+1:]
+	}
+	return DomainOverride{}, false
+}
+
+// applyBrowserHeaders sets the policy's request headers, with any override
+// for the request's host.
+func applyBrowserHeaders(req *http.Request) {
+	p := currentFetchPolicy()
+	ua, lang := p.UserAgent, p.AcceptLanguage
+	if o, ok := p.override(req.URL.Hostname()); ok {
+		ua = firstNonEmpty(o.UserAgent, ua)
+		lang = firstNonEmpty(o.AcceptLanguage, lang)
+		if o.Cookie != "" {
+			req.Header.Set("Cookie", o.Cookie)
+		}
+	}
+
+	if ua != "" {
+		req.Header.Set("User-Agent", ua)
+	}
+	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
+	if lang != "" {
+		req.Header.Set("Accept-Language", lang)
+	}
+	req.Header.Set("Cache-Control", "no-cache")
+	req.Header.Set("Pragma", "no-cache")
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// hostLimiter throttles requests per host with a token bucket (rate, burst)
+// and caps the number of requests in flight per host. A rate or burst of
+// zero disables the bucket.
+type hostLimiter struct {
+	rate        float64 // tokens per second; <= 0 disables the bucket
+	burst       float64
+	concurrency int // <= 0 disables the cap
+
+	mu    sync.Mutex
+	hosts map[string]*hostState
+}
+
+type hostState struct {
+	tokens   float64
+	last     time.Time
+	slots    chan struct{}
+	inFlight int
+}
+
+// hostLimiterPruneAt is the number of tracked hosts above which idle hosts
+// are forgotten.
+const hostLimiterPruneAt = 1024
+
+func newHostLimiter(rate float64, burst, concurrency int) *hostLimiter {
+	if burst < 1 {
+		rate = 0
+	}
+	return &hostLimiter{
+		rate:        rate,
+		burst:       float64(burst),
+		concurrency: concurrency,
+		hosts:       make(map[string]*hostState),
+	}
+}
+
+// acquire waits for a token and a concurrency slot for host. The returned
+// release must be called when the request is finished.
+func (l *hostLimiter) acquire(ctx context.Context, host string) (release func(), err error) {
+	host = strings.ToLower(host)
+
+	for {
+		wait, st := l.reserve(host)
+		if wait <= 0 {
+			if st.slots == nil {
+				return l.releaser(host, st), nil
+			}
+			select {
+			case st.slots <- struct{}{}:
+				return l.releaser(host, st), nil
+			case <-ctx.Done():
+				l.done(st)
+				return nil, ctx.Err()
+			}
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// reserve takes a token for host if one is available. Otherwise it returns
+// how long to wait for the next one.
+func (l *hostLimiter) reserve(host string) (time.Duration, *hostState) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	st, ok := l.hosts[host]
+	if !ok {
+		if len(l.hosts) >= hostLimiterPruneAt {
+			l.pruneLocked()
+		}
+		st = &hostState{tokens: l.burst, last: time.Now()}
+		if l.concurrency > 0 {
+			st.slots = make(chan struct{}, l.concurrency)
+		}
+		l.hosts[host] = st
+	}
+
+	if l.rate > 0 {
+		now := time.Now()
+		st.tokens = min(l.burst, st.tokens+now.Sub(st.last).Seconds()*l.rate)
+		st.last = now
+		if st.tokens < 1 {
+			return time.Duration((1 - st.tokens) / l.rate * float64(time.Second)), st
+		}
+		st.tokens--
+	}
+	st.inFlight++
+	return 0, st
+}
+
+func (l *hostLimiter) releaser(host string, st *hostState) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			if st.slots != nil {
+				<-st.slots
+			}
+			l.done(st)
+		})
+	}
+}
+
+func (l *hostLimiter) done(st *hostState) {
+	l.mu.Lock()
+	st.inFlight--
+	l.mu.Unlock()
+}
+
+// pruneLocked forgets hosts with no requests in flight and a full bucket,
+// which are indistinguishable from hosts never seen.
+func (l *hostLimiter) pruneLocked() {
+	now := time.Now()
+	for host, st := range l.hosts {
+		full := l.rate <= 0 || st.tokens+now.Sub(st.last).Seconds()*l.rate >= l.burst
+		if st.inFlight == 0 && full {
+			delete(l.hosts, host)
+		}
+	}
+}
+
+// limitedTransport applies a hostLimiter to every request, including each
+// redirect hop. The concurrency slot is held until the body is closed.
+type limitedTransport struct {
+	base    http.RoundTripper
+	limiter *hostLimiter
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := t.limiter.acquire(req.Context(), req.URL.Hostname())
+	if err != nil {
+		return nil, err
+	}
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	res.Body = &releasingBody{ReadCloser: res.Body, release: release}
+	return res, nil
+}
+
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestHostLimiterTokenBucket(t *testing.T) {
+	tests := []struct {
+		name  string
+		rate  float64
+		burst int
+		// immediate is how many of 10 back-to-back requests need not wait.
+		immediate int
+	}{
+		{"burst", 1, 3, 3},
+		{"single", 1, 1, 1},
+		{"rate disabled", 0, 3, 10},
+		{"burst disabled", 1, 0, 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newHostLimiter(tt.rate, tt.burst, 0)
+			got := 0
+			for range 10 {
+				if wait, _ := l.reserve("example.com"); wait <= 0 {
+					got++
+				} else if wait > time.Second {
+					t.Errorf("wait = %v, want at most 1/rate", wait)
+				}
+			}
+			if got != tt.immediate {
+				t.Errorf("%d immediate requests, want %d", got, tt.immediate)
+			}
+		})
+	}
+}
+
+func TestHostLimiterHostsAreIndependent(t *testing.T) {
+	l := newHostLimiter(1, 1, 0)
+	if wait, _ := l.reserve("example.com"); wait > 0 {
+		t.Fatalf("first request waits %v", wait)
+	}
+	if wait, _ := l.reserve("example.org"); wait > 0 {
+		t.Errorf("another host waits %v", wait)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := l.acquire(ctx, "EXAMPLE.com"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("same host in another case: err = %v, want a wait for the next token", err)
+	}
+}
+
+func TestHostLimiterConcurrency(t *testing.T) {
+	l := newHostLimiter(0, 0, 2)
+	ctx := context.Background()
+
+	first, err := l.acquire(ctx, "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := l.acquire(ctx, "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := l.acquire(ctx, "example.org")
+	if err != nil {
+		t.Fatalf("another host is capped: %v", err)
+	}
+	defer other()
+
+	short, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if _, err := l.acquire(short, "example.com"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("third request: err = %v, want to wait for a slot", err)
+	}
+
+	got := make(chan error, 1)
+	go func() {
+		release, err := l.acquire(ctx, "example.com")
+		if err == nil {
+			release()
+		}
+		got <- err
+	}()
+	first()
+	first() // releasing twice frees one slot only
+	select {
+	case err := <-got:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("waiting request not admitted after release")
+	}
+	second()
+
+	l.mu.Lock()
+	inFlight := l.hosts["example.com"].inFlight
+	l.mu.Unlock()
+	if inFlight != 0 {
+		t.Errorf("inFlight = %d after all releases, want 0", inFlight)
+	}
+}
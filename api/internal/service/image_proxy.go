@@ -0,0 +1,306 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	// Decoders for the accepted source formats.
+	_ "image/gif"
+	_ "image/png"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/lvncer/quicklinks/api/internal/storage"
+)
+
+// ThumbnailSize names one of the generated thumbnail widths.
+type ThumbnailSize string
+
+const (
+	ThumbnailSmall  ThumbnailSize = "s"
+	ThumbnailMedium ThumbnailSize = "m"
+	ThumbnailLarge  ThumbnailSize = "l"
+)
+
+// thumbnailWidths are the maximum widths of each size. Images are never
+// upscaled.
+var thumbnailWidths = map[ThumbnailSize]int{
+	ThumbnailSmall:  200,
+	ThumbnailMedium: 480,
+	ThumbnailLarge:  960,
+}
+
+// ParseThumbnailSize validates a size name.
+func ParseThumbnailSize(s string) (ThumbnailSize, bool) {
+	size := ThumbnailSize(s)
+	_, ok := thumbnailWidths[size]
+	return size, ok
+}
+
+var (
+	// ErrImageTooLarge is returned when the source image exceeds the byte or
+	// pixel cap.
+	ErrImageTooLarge = errors.New("image too large")
+	// ErrUnsupportedImage is returned when the source is not a JPEG, PNG,
+	// GIF or WebP image.
+	ErrUnsupportedImage = errors.New("unsupported image type")
+)
+
+// imageContentTypes are the accepted source types, as sniffed from the body.
+var imageContentTypes = map[string]struct{}{
+	"image/jpeg": {},
+	"image/png":  {},
+	"image/gif":  {},
+	"image/webp": {},
+}
+
+// maxImagePixels guards against decompression bombs (small files that
+// decode to huge bitmaps).
+const maxImagePixels = 40_000_000
+
+const (
+	// imageFailureTTL is how long a source image that could not be fetched
+	// or decoded is not tried again, so a broken og_image is not refetched
+	// on every request.
+	imageFailureTTL = 10 * time.Minute
+	// maxImageFailures caps the remembered failures.
+	maxImageFailures = 10_000
+)
+
+// Thumbnail is a generated, cached thumbnail.
+type Thumbnail struct {
+	Data        []byte
+	ContentType string
+	ETag        string
+}
+
+// ImageProxy fetches remote og_images once and serves resized copies from a
+// BlobStore, so clients never hotlink the original.
+type ImageProxy struct {
+	store    storage.BlobStore
+	maxBytes int64
+	group    singleflight.Group
+
+	mu       sync.Mutex
+	failures map[string]imageFailure // by thumbnail prefix
+}
+
+type imageFailure struct {
+	err     error
+	expires time.Time
+}
+
+func NewImageProxy(store storage.BlobStore, maxBytes int64) *ImageProxy {
+	return &ImageProxy{store: store, maxBytes: maxBytes, failures: map[string]imageFailure{}}
+}
+
+// Thumbnail returns the thumbnail of sourceURL at size, fetching and
+// resizing the source on first use. Thumbnails are keyed by the source URL,
+// so a link whose og_image changes gets fresh thumbnails.
+func (p *ImageProxy) Thumbnail(ctx context.Context, sourceURL string, size ThumbnailSize) (*Thumbnail, error) {
+	prefix := thumbnailPrefix(sourceURL)
+	key := prefix + string(size) + ".jpg"
+
+	t, err := p.read(ctx, key)
+	if err == nil || !errors.Is(err, storage.ErrBlobNotFound) {
+		return t, err
+	}
+	if err := p.recentFailure(prefix); err != nil {
+		return nil, err
+	}
+
+	// Concurrent requests for the same image share one fetch. The fetch is
+	// detached from the request so one cancelled client does not fail the rest.
+	_, err, _ = p.group.Do(prefix, func() (any, error) {
+		genCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 20*time.Second)
+		defer cancel()
+		return nil, p.generate(genCtx, sourceURL, prefix)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p.read(ctx, key)
+}
+
+// Delete removes the thumbnails of sourceURL, e.g. when the link that
+// referenced it is purged. Other links with the same image get new
+// thumbnails on their next request.
+func (p *ImageProxy) Delete(ctx context.Context, sourceURL string) error {
+	prefix := thumbnailPrefix(sourceURL)
+	p.mu.Lock()
+	delete(p.failures, prefix)
+	p.mu.Unlock()
+
+	var errs []error
+	for size := range thumbnailWidths {
+		if err := p.store.Delete(ctx, prefix+string(size)+".jpg"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// recentFailure returns the error of the last attempt to load the source
+// image under prefix if it failed within imageFailureTTL.
+func (p *ImageProxy) recentFailure(prefix string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	f, ok := p.failures[prefix]
+	if !ok {
+		return nil
+	}
+	if time.Now().After(f.expires) {
+		delete(p.failures, prefix)
+		return nil
+	}
+	return f.err
+}
+
+// recordFailure remembers that the source image under prefix failed with err.
+func (p *ImageProxy) recordFailure(prefix string, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+	if len(p.failures) >= maxImageFailures {
+		for k, f := range p.failures {
+			if now.After(f.expires) {
+				delete(p.failures, k)
+			}
+		}
+		if len(p.failures) >= maxImageFailures {
+			return
+		}
+	}
+	p.failures[prefix] = imageFailure{err: err, expires: now.Add(imageFailureTTL)}
+}
+
+func (p *ImageProxy) read(ctx context.Context, key string) (*Thumbnail, error) {
+	rc, err := p.store.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	return &Thumbnail{
+		Data:        data,
+		ContentType: "image/jpeg",
+		ETag:        `"` + hex.EncodeToString(sum[:16]) + `"`,
+	}, nil
+}
+
+// generate fetches sourceURL and stores every thumbnail size under prefix.
+// Failures to load the source are remembered for imageFailureTTL.
+func (p *ImageProxy) generate(ctx context.Context, sourceURL, prefix string) error {
+	src, err := p.load(ctx, sourceURL)
+	if err != nil {
+		p.recordFailure(prefix, err)
+		return err
+	}
+
+	for size, width := range thumbnailWidths {
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, resizeToWidth(src, width), &jpeg.Options{Quality: 82}); err != nil {
+			return err
+		}
+		if err := p.store.Put(ctx, prefix+string(size)+".jpg", &buf); err != nil {
+			return fmt.Errorf("store thumbnail: %w", err)
+		}
+	}
+	return nil
+}
+
+// load fetches and decodes sourceURL.
+func (p *ImageProxy) load(ctx context.Context, sourceURL string) (image.Image, error) {
+	body, err := p.fetch(ctx, sourceURL)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedImage, err)
+	}
+	if cfg.Width*cfg.Height > maxImagePixels {
+		return nil, ErrImageTooLarge
+	}
+	src, _, err := image.Decode(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedImage, err)
+	}
+	return src, nil
+}
+
+// fetch downloads sourceURL, enforcing the byte cap and the accepted types.
+func (p *ImageProxy) fetch(ctx context.Context, sourceURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", sourceURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	applyBrowserHeaders(req)
+	req.Header.Set("Accept", "image/webp,image/png,image/jpeg,image/gif;q=0.9,*/*;q=0.5")
+
+	client := newFetchClient(15 * time.Second)
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("image fetch returned %d", res.StatusCode)
+	}
+	if res.ContentLength > p.maxBytes {
+		return nil, ErrImageTooLarge
+	}
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, p.maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > p.maxBytes {
+		return nil, ErrImageTooLarge
+	}
+
+	// Trust the bytes, not the Content-Type header: many CDNs mislabel
+	// images. http.DetectContentType recognizes JPEG, PNG, GIF and WebP.
+	if _, ok := imageContentTypes[http.DetectContentType(body)]; !ok {
+		return nil, ErrUnsupportedImage
+	}
+	return body, nil
+}
+
+// resizeToWidth scales src down to at most width pixels wide, keeping the
+// aspect ratio, and flattens transparency onto white for JPEG output.
+func resizeToWidth(src image.Image, width int) image.Image {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w > width {
+		h = max(1, h*width/w)
+		w = width
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, b, draw.Over, nil)
+	return dst
+}
+
+// thumbnailPrefix is the blob key prefix for sourceURL's thumbnails.
+func thumbnailPrefix(sourceURL string) string {
+	sum := sha256.Sum256([]byte(sourceURL))
+	return "images/" + hex.EncodeToString(sum[:16]) + "/"
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lvncer/quicklinks/api/internal/storage"
+)
+
+// testPNG encodes a w×h PNG.
+func testPNG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for x := range w {
+		img.Set(x, h/2, color.RGBA{R: 200, A: 255})
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// gifBomb is a GIF header announcing a 10000×10000 image.
+func gifBomb() []byte {
+	b := []byte("GIF89a")
+	b = binary.LittleEndian.AppendUint16(b, 10000)
+	b = binary.LittleEndian.AppendUint16(b, 10000)
+	return append(b, 0, 0, 0)
+}
+
+func newTestImageProxy(t *testing.T, maxBytes int64) *ImageProxy {
+	t.Helper()
+	store, err := storage.NewLocalBlobStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewImageProxy(store, maxBytes)
+}
+
+func TestImageProxyThumbnail(t *testing.T) {
+	useUnguardedFetches(t)
+	photo := testPNG(t, 600, 300)
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		// Mislabeled: the body is sniffed, not the header trusted.
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write(photo)
+	}))
+	t.Cleanup(srv.Close)
+
+	p := newTestImageProxy(t, 1<<20)
+	ctx := context.Background()
+	source := srv.URL + "/photo"
+
+	widths := map[ThumbnailSize]int{ThumbnailSmall: 200, ThumbnailMedium: 480, ThumbnailLarge: 600}
+	etags := map[string]bool{}
+	for size, want := range widths {
+		thumb, err := p.Thumbnail(ctx, source, size)
+		if err != nil {
+			t.Fatalf("%s: %v", size, err)
+		}
+		if thumb.ContentType != "image/jpeg" {
+			t.Errorf("%s: ContentType = %q", size, thumb.ContentType)
+		}
+		cfg, err := jpeg.DecodeConfig(bytes.NewReader(thumb.Data))
+		if err != nil {
+			t.Fatalf("%s: %v", size, err)
+		}
+		// Scaled down to the width, never up, keeping the aspect ratio.
+		if cfg.Width != want || cfg.Height != want/2 {
+			t.Errorf("%s: %dx%d, want %dx%d", size, cfg.Width, cfg.Height, want, want/2)
+		}
+		etags[thumb.ETag] = true
+	}
+	if len(etags) != len(widths) {
+		t.Errorf("ETags = %v, want one per size", etags)
+	}
+	if n := hits.Load(); n != 1 {
+		t.Errorf("source fetched %d times, want once for all sizes", n)
+	}
+
+	// Served from the store with the same ETag, for conditional requests.
+	again, err := p.Thumbnail(ctx, source, ThumbnailSmall)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !etags[again.ETag] {
+		t.Errorf("ETag changed to %s", again.ETag)
+	}
+	if n := hits.Load(); n != 1 {
+		t.Errorf("source fetched %d times, want the stored thumbnail", n)
+	}
+}
+
+func TestImageProxyRejects(t *testing.T) {
+	useUnguardedFetches(t)
+	const maxBytes = 4 << 10
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		switch r.URL.Path {
+		case "/html":
+			w.Header().Set("Content-Type", "image/jpeg")
+			_, _ = w.Write([]byte("<!DOCTYPE html><html><body>Not an image</body></html>"))
+		case "/large":
+			// Content-Length announces the size.
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write(bytes.Repeat([]byte{0}, maxBytes+1))
+		case "/streamed":
+			// No Content-Length: the cap applies while reading.
+			w.Header().Set("Content-Type", "image/png")
+			for range 4 {
+				_, _ = w.Write(bytes.Repeat([]byte{0}, maxBytes/2))
+				w.(http.Flusher).Flush()
+			}
+		case "/bomb":
+			w.Header().Set("Content-Type", "image/gif")
+			_, _ = w.Write(gifBomb())
+		case "/truncated":
+			_, _ = w.Write(testPNG(t, 40, 40)[:60])
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	tests := []struct {
+		path string
+		want error
+	}{
+		{"/html", ErrUnsupportedImage},
+		{"/large", ErrImageTooLarge},
+		{"/streamed", ErrImageTooLarge},
+		{"/bomb", ErrImageTooLarge},
+		{"/truncated", ErrUnsupportedImage},
+		{"/missing", nil},
+	}
+	p := newTestImageProxy(t, maxBytes)
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			before := hits.Load()
+			_, err := p.Thumbnail(context.Background(), srv.URL+tt.path, ThumbnailMedium)
+			switch {
+			case err == nil:
+				t.Fatal("Thumbnail succeeded")
+			case tt.want != nil && !errors.Is(err, tt.want):
+				t.Errorf("err = %v, want %v", err, tt.want)
+			case tt.want == nil && !strings.Contains(err.Error(), "404"):
+				t.Errorf("err = %v, want the status", err)
+			}
+
+			// The failure is remembered rather than refetched.
+			_, again := p.Thumbnail(context.Background(), srv.URL+tt.path, ThumbnailSmall)
+			if again == nil || again.Error() != err.Error() {
+				t.Errorf("second attempt: err = %v, want %v", again, err)
+			}
+			if n := hits.Load() - before; n != 1 {
+				t.Errorf("source fetched %d times, want once", n)
+			}
+		})
+	}
+}
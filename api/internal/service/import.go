@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/lvncer/quicklinks/api/internal/repository"
+)
+
+const (
+	// importBatchSize is the number of links inserted per statement.
+	importBatchSize = 500
+	// importFetchSpacing staggers the metadata jobs of imported links, so an
+	// import of thousands of bookmarks does not delay fetches for links
+	// saved in the meantime.
+	importFetchSpacing = time.Second
+	// importMaxNote caps an imported note and description, like the
+	// description accepted by POST /api/links.
+	importMaxNote = 2000
+	// ArchivedTag is added to links that were archived (read) in the
+	// service they were imported from.
+	ArchivedTag = "archived"
+)
+
+// ImportedLink is one bookmark read from an import file.
+type ImportedLink struct {
+	URL   string
+	Title string
+	// Description is the page excerpt stored by the source, if any.
+	Description string
+	// Note is the user's own note.
+	Note string
+	Tags []string
+	// SavedAt is when the bookmark was originally added; zero if unknown.
+	SavedAt time.Time
+	// Archived marks items the user had archived (read) in a read-later
+	// service. Links have no read state, so it becomes ArchivedTag.
+	Archived bool
+}
+
+// ImportResult counts the outcome of an import.
+type ImportResult struct {
+	Inserted   int
+	Duplicates int
+	// Failed counts bookmarks with an invalid URL and those in batches that
+	// could not be inserted.
+	Failed int
+}
+
+// LinkImporter saves imported bookmarks in bulk. Metadata is not fetched
+// during the import; fetch_metadata jobs are queued for the new links
+// instead.
+type LinkImporter struct {
+	links    repository.LinkRepository
+	jobs     repository.JobRepository
+	archiver *Archiver
+	tags     TagNormalizer
+}
+
+func NewLinkImporter(links repository.LinkRepository, jobs repository.JobRepository, archiver *Archiver, tags TagNormalizer) *LinkImporter {
+	return &LinkImporter{links: links, jobs: jobs, archiver: archiver, tags: tags}
+}
+
+// Import saves items for userID. Links the user already has are skipped. It
+// returns an error only if ctx ends; the result then covers the batches
+// processed so far, with the rest counted as failed.
+func (im *LinkImporter) Import(ctx context.Context, userID string, items []ImportedLink) (ImportResult, error) {
+	var res ImportResult
+	inputs := make([]repository.CreateLinkInput, 0, len(items))
+	for _, item := range items {
+		in, ok := im.linkInput(item)
+		if !ok {
+			res.Failed++
+			continue
+		}
+		inputs = append(inputs, in)
+	}
+
+	fetchAt := time.Now()
+	for start := 0; start < len(inputs); start += importBatchSize {
+		if err := ctx.Err(); err != nil {
+			res.Failed += len(inputs) - start
+			return res, err
+		}
+		batch := inputs[start:min(start+importBatchSize, len(inputs))]
+
+		created, err := im.links.CreateLinksBulk(ctx, userID, batch)
+		if err != nil {
+			log.Printf("import: insert batch of %d link(s): %v", len(batch), err)
+			res.Failed += len(batch)
+			continue
+		}
+		res.Inserted += created.Inserted
+		res.Duplicates += created.Duplicates
+
+		var ids, archiveIDs []string
+		for i, id := range created.IDs {
+			if id == "" {
+				continue
+			}
+			ids = append(ids, id)
+			if im.archiver.Wants(userID, batch[i].Tags) {
+				archiveIDs = append(archiveIDs, id)
+			}
+		}
+		// The links are saved either way; a failed enqueue only leaves them
+		// without metadata (or a snapshot) until refreshed.
+		if err := im.jobs.EnqueueBulk(ctx, repository.JobKindFetchMetadata, ids, fetchAt, importFetchSpacing); err != nil {
+			log.Printf("import: enqueue metadata jobs: %v", err)
+		}
+		if err := im.jobs.EnqueueBulk(ctx, repository.JobKindArchiveSnapshot, archiveIDs, fetchAt, importFetchSpacing); err != nil {
+			log.Printf("import: enqueue snapshot jobs: %v", err)
+		}
+		fetchAt = fetchAt.Add(time.Duration(len(ids)) * importFetchSpacing)
+	}
+	return res, nil
+}
+
+// linkInput validates and normalizes one bookmark. It reports false for
+// URLs that cannot be saved.
+func (im *LinkImporter) linkInput(item ImportedLink) (repository.CreateLinkInput, bool) {
+	rawURL := strings.TrimSpace(item.URL)
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return repository.CreateLinkInput{}, false
+	}
+	canonicalURL, err := CanonicalizeURL(rawURL)
+	if err != nil {
+		return repository.CreateLinkInput{}, false
+	}
+
+	title := strings.TrimSpace(item.Title)
+	if title == "" {
+		// Like an extension save without a title; the metadata job fills it.
+		title = rawURL
+	}
+	tags := item.Tags
+	if item.Archived {
+		tags = append(tags[:len(tags):len(tags)], ArchivedTag)
+	}
+
+	savedAt := item.SavedAt
+	if savedAt.After(time.Now()) {
+		// A bogus date would pin the link to the top of the list.
+		savedAt = time.Time{}
+	}
+
+	return repository.CreateLinkInput{
+		URL:          rawURL,
+		CanonicalURL: canonicalURL,
+		Title:        title,
+		Description:  truncateRunes(strings.TrimSpace(item.Description), importMaxNote),
+		Domain:       strings.TrimPrefix(u.Host, "www."),
+		Note:         truncateRunes(strings.TrimSpace(item.Note), importMaxNote),
+		Tags:         im.tags.Normalize(tags),
+		SavedAt:      savedAt,
+	}, true
+}
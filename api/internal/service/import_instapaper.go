@@ -0,0 +1,68 @@
+package service
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+)
+
+// Instapaper's built-in folders.
+const (
+	instapaperUnread  = "Unread"
+	instapaperArchive = "Archive"
+	instapaperStarred = "Starred"
+)
+
+// instapaperImporter reads an Instapaper CSV export:
+//
+//	URL,Title,Selection,Folder,Timestamp,Tags
+//
+// Items in the Archive folder are marked Archived, Starred ones are tagged
+// "starred", and custom folders become tags. Tags (in newer exports) is a
+// JSON array. Selection, the text highlighted when saving, becomes the
+// description.
+type instapaperImporter struct{}
+
+func (instapaperImporter) Name() string { return "instapaper" }
+
+func (instapaperImporter) Parse(r io.Reader) ([]ImportedLink, error) {
+	t, err := readCSVTable(r, "url")
+	if err != nil {
+		return nil, err
+	}
+
+	links := make([]ImportedLink, 0, len(t.rows))
+	for _, row := range t.rows {
+		item := ImportedLink{
+			URL:         t.get(row, "url"),
+			Title:       t.get(row, "title"),
+			Description: t.get(row, "selection"),
+			SavedAt:     parseUnixTime(t.get(row, "timestamp")),
+		}
+		switch folder := t.get(row, "folder"); folder {
+		case "", instapaperUnread:
+		case instapaperArchive:
+			item.Archived = true
+		case instapaperStarred:
+			item.Tags = append(item.Tags, "starred")
+		default:
+			item.Tags = append(item.Tags, folder)
+		}
+		item.Tags = append(item.Tags, instapaperTags(t.get(row, "tags"))...)
+
+		links = append(links, item)
+	}
+	return links, nil
+}
+
+// instapaperTags parses the Tags column: a JSON array, or a comma-separated
+// list in hand-edited files.
+func instapaperTags(v string) []string {
+	if strings.HasPrefix(v, "[") {
+		var tags []string
+		if err := json.Unmarshal([]byte(v), &tags); err == nil {
+			return tags
+		}
+	}
+	return splitTags(v, ",")
+}
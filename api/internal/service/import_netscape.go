@@ -0,0 +1,162 @@
+package service
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// netscapeImporter reads a bookmarks.html export in the Netscape bookmark
+// file format, as written by Chrome, Firefox, Safari and Edge:
+//
+//	<DT><H3>Folder</H3>
+//	<DL><p>
+//	    <DT><A HREF="https://..." ADD_DATE="1700000000" TAGS="a,b">Title</A>
+//	    <DD>Description
+//	</DL><p>
+//
+// Every folder a bookmark is in becomes a tag, except the browsers' root
+// folders (bookmarks bar, other bookmarks). Firefox's TAGS are added too.
+// ADD_DATE becomes SavedAt and a <DD> after a bookmark becomes its Note.
+type netscapeImporter struct{}
+
+func (netscapeImporter) Name() string { return "bookmarks" }
+
+func (netscapeImporter) Parse(r io.Reader) ([]ImportedLink, error) {
+	z := html.NewTokenizer(r)
+
+	var (
+		links []ImportedLink
+		// folders is the folder name of every open <DL>; "" for the root
+		// list and for folders that are not turned into tags.
+		folders []string
+		// pending is the folder whose <H3> was read and whose <DL> has not
+		// started yet.
+		pending *string
+		// text collects the content of the current <H3>, <A> or <DD>.
+		text     strings.Builder
+		inFolder bool
+		inLink   bool
+		inNote   bool
+		skipped  bool // current <H3> is a root folder
+		current  ImportedLink
+		sawList  bool
+		// described is the index of the bookmark a <DD> describes, or -1
+		// when the last entry was a folder (Firefox writes folder
+		// descriptions as <DD> too) or a skipped bookmark.
+		described = -1
+	)
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			if err := z.Err(); err != io.EOF {
+				return nil, err
+			}
+			break
+		}
+
+		if inNote && tt != html.TextToken {
+			// A <DD> runs until the next tag.
+			if note := strings.TrimSpace(text.String()); note != "" && described >= 0 {
+				links[described].Note = note
+			}
+			inNote = false
+		}
+
+		switch tt {
+		case html.TextToken:
+			if inFolder || inLink || inNote {
+				text.Write(z.Text())
+			}
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			attrs := map[string]string{}
+			for hasAttr {
+				var k, v []byte
+				k, v, hasAttr = z.TagAttr()
+				attrs[string(k)] = string(v)
+			}
+
+			switch atom.Lookup(name) {
+			case atom.H3:
+				inFolder = true
+				described = -1
+				text.Reset()
+				// Chrome marks the bookmarks bar, Firefox the toolbar and
+				// "Other Bookmarks".
+				_, toolbar := attrs["personal_toolbar_folder"]
+				_, unfiled := attrs["unfiled_bookmarks_folder"]
+				skipped = toolbar || unfiled
+			case atom.A:
+				inLink = true
+				described = -1
+				text.Reset()
+				current = ImportedLink{
+					URL:     strings.TrimSpace(attrs["href"]),
+					Tags:    folderTags(folders),
+					SavedAt: parseUnixTime(attrs["add_date"]),
+				}
+				current.Tags = append(current.Tags, splitTags(attrs["tags"], ",")...)
+			case atom.Dd:
+				inNote = true
+				text.Reset()
+			case atom.Dl:
+				sawList = true
+				name := ""
+				if pending != nil {
+					name = *pending
+					pending = nil
+				}
+				folders = append(folders, name)
+			}
+
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			switch atom.Lookup(name) {
+			case atom.H3:
+				if inFolder {
+					name := strings.TrimSpace(text.String())
+					if skipped {
+						name = ""
+					}
+					pending = &name
+					inFolder = false
+				}
+			case atom.A:
+				if inLink {
+					current.Title = strings.TrimSpace(text.String())
+					// Firefox stores its smart folders as place: queries.
+					if current.URL != "" && !strings.HasPrefix(current.URL, "place:") {
+						links = append(links, current)
+						described = len(links) - 1
+					}
+					inLink = false
+				}
+			case atom.Dl:
+				if len(folders) > 0 {
+					folders = folders[:len(folders)-1]
+				}
+			}
+		}
+	}
+
+	if !sawList {
+		return nil, fmt.Errorf("%w: not a Netscape bookmark file", ErrInvalidImportFile)
+	}
+	return links, nil
+}
+
+// folderTags returns the names of the open folders that become tags.
+func folderTags(folders []string) []string {
+	tags := make([]string, 0, len(folders))
+	for _, f := range folders {
+		if f != "" {
+			tags = append(tags, f)
+		}
+	}
+	return tags
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// pinboardBookmark is one entry of a Pinboard JSON export.
+type pinboardBookmark struct {
+	Href        string `json:"href"`
+	Description string `json:"description"` // the title
+	Extended    string `json:"extended"`    // the user's notes
+	Time        string `json:"time"`
+	Tags        string `json:"tags"` // space-separated
+	ToRead      string `json:"toread"`
+}
+
+// pinboardImporter reads a Pinboard JSON export (an array of bookmarks, as
+// returned by /v1/posts/all?format=json). Bookmarks marked "to read" are
+// tagged "toread"; Pinboard has no archive.
+type pinboardImporter struct{}
+
+func (pinboardImporter) Name() string { return "pinboard" }
+
+func (pinboardImporter) Parse(r io.Reader) ([]ImportedLink, error) {
+	var bookmarks []pinboardBookmark
+	if err := json.NewDecoder(r).Decode(&bookmarks); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidImportFile, err)
+	}
+
+	links := make([]ImportedLink, 0, len(bookmarks))
+	for _, b := range bookmarks {
+		tags := strings.Fields(b.Tags)
+		if b.ToRead == "yes" {
+			tags = append(tags, "toread")
+		}
+		// time is RFC 3339, e.g. "2021-03-04T05:06:07Z".
+		savedAt, _ := time.Parse(time.RFC3339, b.Time)
+
+		links = append(links, ImportedLink{
+			URL:     b.Href,
+			Title:   b.Description,
+			Note:    b.Extended,
+			Tags:    tags,
+			SavedAt: savedAt,
+		})
+	}
+	return links, nil
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// pocketImporter reads a Pocket export, in either of its formats:
+//
+//   - HTML (ril_export.html): an "Unread" and a "Read Archive" list of
+//     <a href time_added tags="a,b">title</a>
+//   - CSV (part_000000.csv): title,url,time_added,tags,status with
+//     "|"-separated tags and status "unread" or "archive"
+//
+// Items in the archive are marked Archived.
+type pocketImporter struct{}
+
+func (pocketImporter) Name() string { return "pocket" }
+
+func (pocketImporter) Parse(r io.Reader) ([]ImportedLink, error) {
+	br := bufio.NewReader(r)
+	head, _ := br.Peek(512)
+	head = bytes.TrimLeft(bytes.TrimPrefix(head, []byte("\xef\xbb\xbf")), " \t\r\n")
+	if bytes.HasPrefix(head, []byte("<")) {
+		return parsePocketHTML(br)
+	}
+	return parsePocketCSV(br)
+}
+
+func parsePocketHTML(r io.Reader) ([]ImportedLink, error) {
+	z := html.NewTokenizer(r)
+
+	var (
+		links     []ImportedLink
+		text      strings.Builder
+		inHeading bool
+		inLink    bool
+		archived  bool
+		sawList   bool
+		current   ImportedLink
+	)
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			if err := z.Err(); err != io.EOF {
+				return nil, err
+			}
+			break
+		}
+
+		switch tt {
+		case html.TextToken:
+			if inHeading || inLink {
+				text.Write(z.Text())
+			}
+
+		case html.StartTagToken:
+			name, hasAttr := z.TagName()
+			attrs := map[string]string{}
+			for hasAttr {
+				var k, v []byte
+				k, v, hasAttr = z.TagAttr()
+				attrs[string(k)] = string(v)
+			}
+
+			switch atom.Lookup(name) {
+			case atom.H1:
+				inHeading = true
+				text.Reset()
+			case atom.Ul:
+				sawList = true
+			case atom.A:
+				inLink = true
+				text.Reset()
+				current = ImportedLink{
+					URL:      strings.TrimSpace(attrs["href"]),
+					Tags:     splitTags(attrs["tags"], ","),
+					SavedAt:  parseUnixTime(attrs["time_added"]),
+					Archived: archived,
+				}
+			}
+
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			switch atom.Lookup(name) {
+			case atom.H1:
+				if inHeading {
+					// "Unread" or "Read Archive".
+					archived = strings.Contains(strings.ToLower(text.String()), "archive")
+					inHeading = false
+				}
+			case atom.A:
+				if inLink {
+					current.Title = strings.TrimSpace(text.String())
+					if current.URL != "" {
+						links = append(links, current)
+					}
+					inLink = false
+				}
+			}
+		}
+	}
+
+	if !sawList {
+		return nil, fmt.Errorf("%w: not a Pocket HTML export", ErrInvalidImportFile)
+	}
+	return links, nil
+}
+
+func parsePocketCSV(r io.Reader) ([]ImportedLink, error) {
+	t, err := readCSVTable(r, "url")
+	if err != nil {
+		return nil, err
+	}
+
+	links := make([]ImportedLink, 0, len(t.rows))
+	for _, row := range t.rows {
+		links = append(links, ImportedLink{
+			URL:      t.get(row, "url"),
+			Title:    t.get(row, "title"),
+			Tags:     splitTags(t.get(row, "tags"), "|"),
+			SavedAt:  parseUnixTime(t.get(row, "time_added")),
+			Archived: strings.EqualFold(t.get(row, "status"), "archive"),
+		})
+	}
+	return links, nil
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"io"
+	"strings"
+	"time"
+)
+
+// raindropUnsorted is the collection of raindrops not filed anywhere.
+const raindropUnsorted = "Unsorted"
+
+// raindropImporter reads a Raindrop.io CSV export:
+//
+//	id,title,note,excerpt,url,folder,tags,created,cover,highlights,favorite
+//
+// The folder (collection) becomes a tag like the other tags, and favorites
+// are tagged "favorite". Raindrop has no archive.
+type raindropImporter struct{}
+
+func (raindropImporter) Name() string { return "raindrop" }
+
+func (raindropImporter) Parse(r io.Reader) ([]ImportedLink, error) {
+	t, err := readCSVTable(r, "url")
+	if err != nil {
+		return nil, err
+	}
+
+	links := make([]ImportedLink, 0, len(t.rows))
+	for _, row := range t.rows {
+		var tags []string
+		if folder := t.get(row, "folder"); folder != "" && folder != raindropUnsorted {
+			tags = append(tags, folder)
+		}
+		tags = append(tags, splitTags(t.get(row, "tags"), ",")...)
+		if strings.EqualFold(t.get(row, "favorite"), "true") {
+			tags = append(tags, "favorite")
+		}
+
+		// created is ISO 8601, e.g. "2023-04-01T12:34:56.789Z".
+		savedAt, _ := time.Parse(time.RFC3339, t.get(row, "created"))
+
+		links = append(links, ImportedLink{
+			URL:         t.get(row, "url"),
+			Title:       t.get(row, "title"),
+			Description: t.get(row, "excerpt"),
+			Note:        t.get(row, "note"),
+			Tags:        tags,
+			SavedAt:     savedAt,
+		})
+	}
+	return links, nil
+}
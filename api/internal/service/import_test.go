@@ -0,0 +1,262 @@
+package service
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// parseImportFixture parses testdata/import/name with the named importer.
+func parseImportFixture(t *testing.T, format, name string) []ImportedLink {
+	t.Helper()
+	f, err := os.Open(filepath.Join("testdata", "import", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	im := LookupImporter(format)
+	if im == nil {
+		t.Fatalf("no importer for %q", format)
+	}
+	links, err := im.Parse(f)
+	if err != nil {
+		t.Fatalf("parse %s: %v", name, err)
+	}
+	return links
+}
+
+// assertImportedLinks compares links field by field. Empty and nil tag
+// lists are equal.
+func assertImportedLinks(t *testing.T, got, want []ImportedLink) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("got %d links, want %d: %+v", len(got), len(want), got)
+		return
+	}
+	for i := range want {
+		g, w := got[i], want[i]
+		if len(g.Tags) == 0 {
+			g.Tags = nil
+		}
+		if !g.SavedAt.Equal(w.SavedAt) {
+			t.Errorf("links[%d] (%s): SavedAt = %v, want %v", i, w.URL, g.SavedAt, w.SavedAt)
+		}
+		g.SavedAt, w.SavedAt = time.Time{}, time.Time{}
+		if !reflect.DeepEqual(g, w) {
+			t.Errorf("links[%d]:\n got %+v\nwant %+v", i, g, w)
+		}
+	}
+}
+
+func TestNetscapeImporter(t *testing.T) {
+	got := parseImportFixture(t, "bookmarks", "bookmarks.html")
+	assertImportedLinks(t, got, []ImportedLink{
+		{
+			// Directly in the bookmarks bar, a root folder.
+			URL:     "https://go.dev/",
+			Title:   "The Go Programming Language",
+			SavedAt: time.Unix(1700000001, 0),
+		},
+		{
+			URL:     "https://developer.mozilla.org/",
+			Title:   "MDN Web Docs",
+			Note:    "Reference for HTML & CSS",
+			Tags:    []string{"Dev", "docs", "web"},
+			SavedAt: time.Unix(1700000003, 0),
+		},
+		{
+			// Nested folders each become a tag; the folder's own <DD> is
+			// not a note.
+			URL:     "https://pkg.go.dev/",
+			Title:   "Go Packages",
+			Tags:    []string{"Dev", "Go"},
+			SavedAt: time.Unix(1700000005, 0),
+		},
+		{
+			URL:     "https://github.com/",
+			Title:   "GitHub",
+			Tags:    []string{"Dev"},
+			SavedAt: time.Unix(1700000006, 0),
+		},
+		{
+			// The place: query before it is skipped, with its <DD>.
+			URL:     "https://example.com/other",
+			Title:   "Other",
+			SavedAt: time.Unix(1700000008, 0),
+		},
+		{
+			URL:   "https://example.org/root",
+			Title: "Root bookmark",
+		},
+	})
+}
+
+func TestPocketImporter(t *testing.T) {
+	want := []ImportedLink{
+		{
+			URL:     "https://example.com/a",
+			Title:   "Article A",
+			Tags:    []string{"go", "reading"},
+			SavedAt: time.Unix(1600000000, 0),
+		},
+		{
+			URL:     "https://example.com/b",
+			SavedAt: time.Unix(1600000100, 0),
+		},
+		{
+			URL:      "https://example.com/c",
+			Tags:     []string{"done"},
+			SavedAt:  time.Unix(1600000200, 0),
+			Archived: true,
+		},
+	}
+
+	t.Run("html", func(t *testing.T) {
+		html := append([]ImportedLink(nil), want...)
+		html[1].Title = "https://example.com/b"
+		html[2].Title = "Article C & more"
+		assertImportedLinks(t, parseImportFixture(t, "pocket", "pocket.html"), html)
+	})
+	t.Run("csv", func(t *testing.T) {
+		csv := append([]ImportedLink(nil), want...)
+		csv[1].Title = "Comma, Title"
+		csv[2].Title = "Article C"
+		assertImportedLinks(t, parseImportFixture(t, "pocket", "pocket.csv"), csv)
+	})
+}
+
+func TestInstapaperImporter(t *testing.T) {
+	got := parseImportFixture(t, "instapaper", "instapaper.csv")
+	assertImportedLinks(t, got, []ImportedLink{
+		{
+			URL:     "https://example.com/unread",
+			Title:   "Unread item",
+			SavedAt: time.Unix(1650000000, 0),
+		},
+		{
+			URL:         "https://example.com/archived",
+			Title:       "Archived item",
+			Description: "Highlighted text",
+			Tags:        []string{"go", "long read"},
+			SavedAt:     time.Unix(1650000100, 0),
+			Archived:    true,
+		},
+		{
+			URL:     "https://example.com/starred",
+			Title:   "Starred item",
+			Tags:    []string{"starred"},
+			SavedAt: time.Unix(1650000200, 0),
+		},
+		{
+			URL:     "https://example.com/custom",
+			Title:   "Custom folder item",
+			Tags:    []string{"Recipes", "dinner"},
+			SavedAt: time.Unix(1650000300, 0),
+		},
+	})
+}
+
+func TestInstapaperTags(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{`["a","b c"]`, []string{"a", "b c"}},
+		{`a, b`, []string{"a", "b"}},
+		{`[broken`, []string{"[broken"}},
+		{``, nil},
+	}
+	for _, tt := range tests {
+		if got := instapaperTags(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("instapaperTags(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestRaindropImporter(t *testing.T) {
+	got := parseImportFixture(t, "raindrop", "raindrop.csv")
+	assertImportedLinks(t, got, []ImportedLink{
+		{
+			// Unsorted is not a folder the user chose.
+			URL:         "https://example.com/unsorted",
+			Title:       "Unsorted item",
+			Description: "An excerpt",
+			SavedAt:     time.Date(2023, 4, 1, 12, 34, 56, 789e6, time.UTC),
+		},
+		{
+			URL:     "https://example.com/fav",
+			Title:   "Filed favorite",
+			Note:    "My note",
+			Tags:    []string{"Reading", "go", "tools", "favorite"},
+			SavedAt: time.Date(2023, 4, 2, 0, 0, 0, 0, time.UTC),
+		},
+	})
+}
+
+func TestPinboardImporter(t *testing.T) {
+	got := parseImportFixture(t, "pinboard", "pinboard.json")
+	assertImportedLinks(t, got, []ImportedLink{
+		{
+			URL:     "https://example.com/toread",
+			Title:   "To read",
+			Note:    "Later",
+			Tags:    []string{"go", "reading", "toread"},
+			SavedAt: time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC),
+		},
+		{
+			URL:     "https://example.com/read",
+			Title:   "Already read",
+			SavedAt: time.Date(2021, 3, 5, 0, 0, 0, 0, time.UTC),
+		},
+	})
+}
+
+func TestLinkImporterArchivedTag(t *testing.T) {
+	im := NewLinkImporter(nil, nil, nil, TagNormalizer{})
+	links := parseImportFixture(t, "pocket", "pocket.csv")
+
+	var tags [][]string
+	for _, item := range links {
+		in, ok := im.linkInput(item)
+		if !ok {
+			t.Fatalf("linkInput(%+v) rejected", item)
+		}
+		tags = append(tags, in.Tags)
+	}
+	want := [][]string{{"go", "reading"}, {}, {"done", ArchivedTag}}
+	for i := range want {
+		if len(tags[i]) != len(want[i]) || (len(want[i]) > 0 && !reflect.DeepEqual(tags[i], want[i])) {
+			t.Errorf("links[%d].Tags = %q, want %q", i, tags[i], want[i])
+		}
+	}
+	if links[2].Tags[len(links[2].Tags)-1] == ArchivedTag {
+		t.Error("linkInput modified the parsed tags")
+	}
+}
+
+func TestImportersRejectOtherFormats(t *testing.T) {
+	tests := []struct {
+		format string
+		body   string
+	}{
+		{"bookmarks", "<html><body><p>Not bookmarks</p></body></html>"},
+		{"pocket", "<html><body><h1>Unread</h1></body></html>"},
+		{"pocket", "title,link\nA,https://example.com/\n"},
+		{"instapaper", ""},
+		{"raindrop", "id,title,link\n1,A,https://example.com/\n"},
+		{"pinboard", `{"href":"https://example.com/"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			links, err := LookupImporter(tt.format).Parse(strings.NewReader(tt.body))
+			if !errors.Is(err, ErrInvalidImportFile) {
+				t.Errorf("got %+v, %v, want ErrInvalidImportFile", links, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidImportFile is returned when an import file is not in the
+// importer's format.
+var ErrInvalidImportFile = errors.New("invalid import file")
+
+// Importer parses the export file of a browser or read-later service into
+// bookmarks for LinkImporter.
+type Importer interface {
+	// Name identifies the format in POST /api/import/:format.
+	Name() string
+	// Parse reads an export. Errors caused by the content wrap
+	// ErrInvalidImportFile.
+	Parse(r io.Reader) ([]ImportedLink, error)
+}
+
+// importers are the formats accepted by LookupImporter.
+var importers = []Importer{
+	netscapeImporter{},
+	pocketImporter{},
+	raindropImporter{},
+	instapaperImporter{},
+	pinboardImporter{},
+}
+
+// RegisterImporter adds an import format. It is not safe for concurrent use
+// and should be called from init functions only.
+func RegisterImporter(im Importer) {
+	importers = append(importers, im)
+}
+
+// LookupImporter returns the importer for the named format, or nil.
+func LookupImporter(name string) Importer {
+	for _, im := range importers {
+		if im.Name() == name {
+			return im
+		}
+	}
+	return nil
+}
+
+// ImporterNames lists the registered formats.
+func ImporterNames() []string {
+	names := make([]string, len(importers))
+	for i, im := range importers {
+		names[i] = im.Name()
+	}
+	return names
+}
+
+// csvTable is a CSV export with a header row.
+type csvTable struct {
+	columns map[string]int // lowercased header -> index
+	rows    [][]string
+}
+
+// readCSVTable reads a CSV file whose first row names the columns. Every
+// column in required must be present.
+func readCSVTable(r io.Reader, required ...string) (*csvTable, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	cr := csv.NewReader(bytes.NewReader(data))
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImportFile, err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%w: empty file", ErrInvalidImportFile)
+	}
+
+	t := &csvTable{columns: make(map[string]int, len(records[0])), rows: records[1:]}
+	for i, name := range records[0] {
+		t.columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range required {
+		if _, ok := t.columns[name]; !ok {
+			return nil, fmt.Errorf("%w: missing %q column", ErrInvalidImportFile, name)
+		}
+	}
+	return t, nil
+}
+
+// get returns the named column of row, or "" if either is missing.
+func (t *csvTable) get(row []string, name string) string {
+	i, ok := t.columns[name]
+	if !ok || i >= len(row) {
+		return ""
+	}
+	return strings.TrimSpace(row[i])
+}
+
+// splitTags splits a tag list on sep, dropping empty entries.
+func splitTags(s, sep string) []string {
+	var tags []string
+	for _, t := range strings.Split(s, sep) {
+		if t = strings.TrimSpace(t); t != "" {
+			tags = append(tags, t)
+		}
+	}
+	return tags
+}
+
+// parseUnixTime parses a timestamp in Unix seconds, or in milliseconds or
+// microseconds as some exporters write. It returns the zero time for missing
+// or invalid values.
+func parseUnixTime(v string) time.Time {
+	n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+	if err != nil || n <= 0 {
+		return time.Time{}
+	}
+	switch {
+	case n > 1e14:
+		return time.UnixMicro(n)
+	case n > 1e11:
+		return time.UnixMilli(n)
+	default:
+		return time.Unix(n, 0)
+	}
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/lvncer/quicklinks/api/internal/repository"
+)
+
+// LinkChecker checks whether saved URLs still lead to their page and records
+// the outcome in the link's check history.
+type LinkChecker struct {
+	links  repository.LinkRepository
+	checks repository.LinkCheckRepository
+}
+
+func NewLinkChecker(links repository.LinkRepository, checks repository.LinkCheckRepository) *LinkChecker {
+	return &LinkChecker{links: links, checks: checks}
+}
+
+// Check requests the link's URL and records the result. A check cut short by
+// ctx is not recorded.
+func (c *LinkChecker) Check(ctx context.Context, linkID string) (repository.LinkHealth, error) {
+	l, err := c.links.FindLinkByID(ctx, linkID)
+	if err != nil {
+		return "", err
+	}
+
+	res := checkURL(ctx, l.URL)
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	if err := c.checks.RecordLinkCheck(ctx, linkID, res); err != nil {
+		return "", err
+	}
+	return res.Health, nil
+}
+
+// checkURL requests target with HEAD and classifies the response. Servers
+// that reject or mishandle HEAD get a second chance with GET.
+func checkURL(ctx context.Context, target string) repository.LinkCheckInput {
+	policy := currentFetchPolicy()
+	client := newFetchClient(policy.RequestTimeout)
+	ctx, cancel := context.WithTimeout(ctx, policy.TotalTimeout)
+	defer cancel()
+
+	res := repository.LinkCheckInput{CheckedAt: time.Now()}
+	if !robotsAllowed(ctx, client, target) {
+		res.Health = repository.HealthUnknown
+		res.Error = "disallowed by robots.txt"
+		return res
+	}
+
+	status, finalURL, err := probeURL(ctx, client, http.MethodHead, target)
+	if err != nil || status >= 400 {
+		status, finalURL, err = probeURL(ctx, client, http.MethodGet, target)
+	}
+	if err != nil {
+		res.Health = errorHealth(err)
+		res.Error = err.Error()
+		return res
+	}
+
+	res.StatusCode = status
+	res.FinalURL = finalURL
+	res.Health = statusHealth(target, status, finalURL)
+	return res
+}
+
+// probeURL sends one request and returns the final status and URL after
+// redirects. The body is not read.
+func probeURL(ctx context.Context, client *http.Client, method, target string) (int, string, error) {
+	req, err := http.NewRequestWithContext(ctx, method, target, nil)
+	if err != nil {
+		return 0, "", err
+	}
+	applyBrowserHeaders(req)
+
+	res, err := client.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	res.Body.Close()
+	return res.StatusCode, res.Request.URL.String(), nil
+}
+
+// errorHealth classifies a request that got no response. Timeouts and
+// blocked addresses say nothing about the page itself.
+func errorHealth(err error) repository.LinkHealth {
+	var netErr net.Error
+	if errors.Is(err, ErrBlockedAddress) || errors.Is(err, context.DeadlineExceeded) ||
+		(errors.As(err, &netErr) && netErr.Timeout()) {
+		return repository.HealthUnknown
+	}
+	return repository.HealthBroken
+}
+
+// statusHealth classifies a response to a request for target.
+func statusHealth(target string, status int, finalURL string) repository.LinkHealth {
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden || status == http.StatusTooManyRequests:
+		// Login walls, bot protection and rate limits.
+		return repository.HealthUnknown
+	case status >= 400:
+		return repository.HealthBroken
+	case !sameLocation(target, finalURL):
+		return repository.HealthRedirected
+	default:
+		return repository.HealthOK
+	}
+}
+
+// sameLocation reports whether a and b address the same page, ignoring the
+// scheme (http to https upgrades), a "www." prefix and whatever
+// CanonicalizeURL drops.
+func sameLocation(a, b string) bool {
+	key := func(raw string) string {
+		c, err := CanonicalizeURL(raw)
+		if err != nil {
+			return raw
+		}
+		if _, rest, ok := strings.Cut(c, "://"); ok {
+			c = rest
+		}
+		return strings.TrimPrefix(c, "www.")
+	}
+	return key(a) == key(b)
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/lvncer/quicklinks/api/internal/repository"
+)
+
+// useTestSite sends fetches for http://site.test to plain and for
+// https://site.test to secure (certificates unchecked).
+func useTestSite(t *testing.T, plain, secure *httptest.Server) {
+	t.Helper()
+	dialer := &net.Dialer{}
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			if _, port, _ := net.SplitHostPort(addr); port == "443" {
+				return dialer.DialContext(ctx, network, secure.Listener.Addr().String())
+			}
+			return dialer.DialContext(ctx, network, plain.Listener.Addr().String())
+		},
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}
+	fetchPolicyMu.Lock()
+	saved := fetchTransport
+	fetchTransport = transport
+	fetchPolicyMu.Unlock()
+	t.Cleanup(func() {
+		fetchPolicyMu.Lock()
+		fetchTransport = saved
+		fetchPolicyMu.Unlock()
+		transport.CloseIdleConnections()
+	})
+}
+
+func TestCheckURL(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		methods []string
+	)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		methods = append(methods, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+		switch r.URL.Path {
+		case "/ok":
+		case "/no-head":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			}
+		case "/upgrade":
+			if r.TLS == nil {
+				http.Redirect(w, r, "https://site.test/upgrade", http.StatusMovedPermanently)
+			}
+		case "/moved":
+			http.Redirect(w, r, "/elsewhere", http.StatusFound)
+		case "/elsewhere":
+		case "/forbidden":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	plain := httptest.NewServer(handler)
+	t.Cleanup(plain.Close)
+	secure := httptest.NewTLSServer(handler)
+	t.Cleanup(secure.Close)
+	useTestSite(t, plain, secure)
+
+	tests := []struct {
+		path    string
+		health  repository.LinkHealth
+		status  int
+		final   string
+		methods []string
+	}{
+		{"/ok", repository.HealthOK, 200, "http://site.test/ok", []string{"HEAD /ok"}},
+		{
+			// HEAD is rejected; GET decides.
+			"/no-head", repository.HealthOK, 200, "http://site.test/no-head",
+			[]string{"HEAD /no-head", "GET /no-head"},
+		},
+		{
+			// An http to https upgrade of the same page is not a move.
+			"/upgrade", repository.HealthOK, 200, "https://site.test/upgrade",
+			[]string{"HEAD /upgrade", "HEAD /upgrade"},
+		},
+		{
+			"/moved", repository.HealthRedirected, 200, "http://site.test/elsewhere",
+			[]string{"HEAD /moved", "HEAD /elsewhere"},
+		},
+		{
+			// Bot protection says nothing about the page.
+			"/forbidden", repository.HealthUnknown, 403, "http://site.test/forbidden",
+			[]string{"HEAD /forbidden", "GET /forbidden"},
+		},
+		{
+			"/gone", repository.HealthBroken, 404, "http://site.test/gone",
+			[]string{"HEAD /gone", "GET /gone"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			mu.Lock()
+			methods = nil
+			mu.Unlock()
+
+			got := checkURL(context.Background(), "http://site.test"+tt.path)
+			if got.Health != tt.health || got.StatusCode != tt.status || got.FinalURL != tt.final || got.Error != "" {
+				t.Errorf("checkURL = %+v, want health %s, status %d, final URL %s", got, tt.health, tt.status, tt.final)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if len(methods) != len(tt.methods) {
+				t.Fatalf("requests = %q, want %q", methods, tt.methods)
+			}
+			for i := range methods {
+				if methods[i] != tt.methods[i] {
+					t.Errorf("requests = %q, want %q", methods, tt.methods)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestCheckURLErrors(t *testing.T) {
+	// The real transport refuses loopback addresses: nothing is known about
+	// the page.
+	got := checkURL(context.Background(), "http://127.0.0.1:9/")
+	if got.Health != repository.HealthUnknown || got.StatusCode != 0 || got.Error == "" {
+		t.Errorf("blocked address: checkURL = %+v, want unknown with an error", got)
+	}
+
+	// A connection refused by the host means the page is gone.
+	srv := httptest.NewServer(http.NotFoundHandler())
+	target := srv.URL + "/"
+	srv.Close()
+	useUnguardedFetches(t)
+	got = checkURL(context.Background(), target)
+	if got.Health != repository.HealthBroken || got.Error == "" {
+		t.Errorf("refused connection: checkURL = %+v, want broken with an error", got)
+	}
+}
+
+func TestSameLocation(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"https://example.com/a", "https://example.com/a", true},
+		{"http://example.com/a", "https://example.com/a", true},
+		{"https://example.com/a", "https://www.example.com/a", true},
+		{"https://example.com/a?utm_source=feed", "https://example.com/a", true},
+		{"https://Example.com/a#top", "https://example.com/a", true},
+		{"https://example.com/a", "https://example.com/b", false},
+		{"https://example.com/a", "https://example.org/a", false},
+		{"https://example.com/a", "https://example.com/login?next=/a", false},
+	}
+	for _, tt := range tests {
+		if got := sameLocation(tt.a, tt.b); got != tt.want {
+			t.Errorf("sameLocation(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestStatusHealth(t *testing.T) {
+	const target = "https://example.com/a"
+	tests := []struct {
+		status int
+		final  string
+		want   repository.LinkHealth
+	}{
+		{200, target, repository.HealthOK},
+		{204, "http://www.example.com/a", repository.HealthOK},
+		{200, "https://example.com/", repository.HealthRedirected},
+		{401, target, repository.HealthUnknown},
+		{403, target, repository.HealthUnknown},
+		{429, target, repository.HealthUnknown},
+		{404, target, repository.HealthBroken},
+		{410, "https://example.com/gone", repository.HealthBroken},
+		{503, target, repository.HealthBroken},
+	}
+	for _, tt := range tests {
+		if got := statusHealth(target, tt.status, tt.final); got != tt.want {
+			t.Errorf("statusHealth(%d, %s) = %s, want %s", tt.status, tt.final, got, tt.want)
+		}
+	}
+}
@@ -1,67 +1,152 @@
 package service
 
 import (
-	"bytes"
 	"context"
 	"io"
 	"log"
+	"mime"
 	"net/http"
 	"net/url"
-	"regexp"
 	"strings"
-	"time"
 
-	"github.com/PuerkitoBio/goquery"
+	"github.com/lvncer/quicklinks/api/internal/model"
 )
 
+// SourceRobots is the Metadata.Source of a page skipped because its
+// robots.txt disallows fetching it.
+const SourceRobots = "robots"
+
+// maxPageBytes caps the page body read for metadata.
+const maxPageBytes = 2 << 20
+
+// FetchedPage is an HTML page downloaded while fetching metadata, kept so
+// the Archiver can snapshot it without downloading it again.
+type FetchedPage struct {
+	// URL is the final URL, after redirects.
+	URL *url.URL
+	// Body is the complete page, decoded to UTF-8.
+	Body []byte
+}
+
 type Metadata struct {
-	Title       string
-	Description string
-	Image       string
-	Source      string
-	Blocked     bool
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Image       string `json:"image,omitempty"`
+	SiteName    string `json:"site_name,omitempty"`
+	Icon        string `json:"icon,omitempty"`
+	// OEmbedURL is the oEmbed endpoint advertised by the page, if any.
+	OEmbedURL string `json:"oembed_url,omitempty"`
+	// Structured holds JSON-LD (schema.org) fields.
+	Structured model.StructuredData `json:"structured"`
+	// Embed is the oEmbed response for rich providers, if any.
+	Embed *model.Embed `json:"embed,omitempty"`
+	// Content is the readable main text of the page, if it has any.
+	Content *Content `json:"content,omitempty"`
+	// Source is where the metadata came from: "direct", "jina" (fallback
+	// reader), "oembed", or SourceRobots when the page was skipped.
+	Source  string `json:"source,omitempty"`
+	Blocked bool   `json:"blocked,omitempty"`
+	// Cached is set when the metadata was served from the MetadataCache.
+	Cached bool `json:"-"`
+	// HasContent is set on cached metadata of a page that had Content; the
+	// cache leaves the Content itself out.
+	HasContent bool `json:"has_content,omitempty"`
+	// Page is the directly fetched page, if it was a complete HTML document
+	// with a 2xx status. It is never cached.
+	Page *FetchedPage `json:"-"`
 }
 
 // FetchMetadata scrapes the URL to find OGP title, description, and image.
-func FetchMetadata(targetURL string) (*Metadata, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+// The fetch stops when ctx is done or the policy's total timeout elapses.
+func FetchMetadata(ctx context.Context, targetURL string) (*Metadata, error) {
+	policy := currentFetchPolicy()
+	ctx, cancel := context.WithTimeout(ctx, policy.TotalTimeout)
 	defer cancel()
 
-	client := &http.Client{
-		Timeout: 10 * time.Second,
+	client := newFetchClient(policy.RequestTimeout)
+
+	// Pages disallowed by robots.txt are not fetched at all (not even via the
+	// fallback reader). Source tells clients why the metadata is missing.
+	if !robotsAllowed(ctx, client, targetURL) {
+		return &Metadata{Source: SourceRobots}, nil
 	}
 
 	meta, status, err := fetchAndParse(ctx, client, targetURL)
 	if err != nil {
+		// Video and social sites often refuse scrapers outright; a known
+		// oEmbed provider can still describe the page.
+		if embed := fetchEmbed(ctx, client, targetURL, ""); embed != nil {
+			meta := &Metadata{Source: "oembed"}
+			applyEmbed(meta, embed)
+			meta.Blocked = sanitizeMetadata(meta, false)
+			return meta, nil
+		}
 		return nil, err
 	}
 	directChallenge := looksLikeBotChallenge(meta.Title)
+	embed := fetchEmbed(ctx, client, targetURL, meta.OEmbedURL)
+
+	// oEmbed covers the essentials for rich providers, so a blocked page
+	// does not need the proxy fallback.
+	if embed != nil && embed.Title != "" {
+		if directChallenge {
+			meta.Title = ""
+			meta.Description = ""
+		}
+		applyEmbed(meta, embed)
+		meta.Source = "direct"
+		meta.Blocked = sanitizeMetadata(meta, directChallenge)
+		return meta, nil
+	}
 
 	// If direct fetch likely hit bot protection (or no useful tags), try a proxy fetch.
 	//
 	// Note: Some sites behind Cloudflare may return "Just a moment..." pages to cloud IPs
 	// (even with a browser UA), resulting in empty OG tags.
-	if status != 200 || directChallenge || (meta.Image == "" && meta.Title == "" && meta.Description == "") {
-		jinaURL := "https://r.jina.ai/" + targetURL
-		fb, _, fbErr := fetchAndParse(ctx, client, jinaURL)
+	needsFallback := status != 200 || directChallenge || (meta.Image == "" && meta.Title == "" && meta.Description == "")
+	if needsFallback && policy.FallbackReaderURL != "" {
+		readerURL := policy.FallbackReaderURL + targetURL
+		fb, _, fbErr := fetchAndParse(ctx, newReaderClient(policy.RequestTimeout), readerURL)
 		if fbErr != nil {
-			log.Printf("failed to fetch metadata via jina proxy: %v (target=%s)", fbErr, targetURL)
+			log.Printf("failed to fetch metadata via fallback reader: %v (target=%s)", fbErr, targetURL)
+			applyEmbed(meta, embed)
 			meta.Source = "direct"
 			meta.Blocked = sanitizeMetadata(meta, directChallenge)
 			return meta, nil
 		}
 		fallbackChallenge := looksLikeBotChallenge(fb.Title)
 		merged := mergePreferExisting(meta, fb)
-		merged.Source = "jina"
+		applyEmbed(merged, embed)
+		merged.Source = "jina" // kept for any configured reader, for compatibility
 		merged.Blocked = sanitizeMetadata(merged, directChallenge || fallbackChallenge)
 		return merged, nil
 	}
 
+	applyEmbed(meta, embed)
 	meta.Source = "direct"
-	meta.Blocked = sanitizeMetadata(meta, false)
+	meta.Blocked = sanitizeMetadata(meta, directChallenge)
 	return meta, nil
 }
 
+// fetchEmbed fetches oEmbed data for targetURL from the endpoint the page
+// advertised, falling back to the built-in provider list. Failures are
+// logged and yield nil; oEmbed only ever supplements the page metadata.
+func fetchEmbed(ctx context.Context, client *http.Client, targetURL, discovered string) *model.Embed {
+	endpoint := discovered
+	if endpoint == "" {
+		endpoint = lookupOEmbedEndpoint(targetURL)
+	}
+	if endpoint == "" {
+		return nil
+	}
+	embed, err := fetchOEmbed(ctx, client, endpoint)
+	if err != nil {
+		log.Printf("failed to fetch oembed: %v (target=%s)", err, targetURL)
+		return nil
+	}
+	return embed
+}
+
 func looksLikeBotChallenge(title string) bool {
 	t := strings.ToLower(strings.TrimSpace(title))
 	return strings.Contains(t, "just a moment") || strings.Contains(t, "attention required") || strings.Contains(t, "cloudflare")
@@ -86,7 +171,16 @@ func mergePreferExisting(primary, fallback *Metadata) *Metadata {
 		Title:       primaryTitle,
 		Description: primaryDesc,
 		Image:       primary.Image,
+		SiteName:    firstNonEmpty(primary.SiteName, fallback.SiteName),
+		Icon:        firstNonEmpty(primary.Icon, fallback.Icon),
+		OEmbedURL:   firstNonEmpty(primary.OEmbedURL, fallback.OEmbedURL),
+		Structured:  primary.Structured,
+		Content:     primary.Content,
 		Source:      primary.Source,
+		Page:        primary.Page,
+	}
+	if out.Content == nil {
+		out.Content = fallback.Content
 	}
 	if out.Title == "" {
 		out.Title = fallbackTitle
@@ -150,74 +244,34 @@ func fetchAndParse(ctx context.Context, client *http.Client, target string) (*Me
 	}
 	defer res.Body.Close()
 
-	body, err := io.ReadAll(io.LimitReader(res.Body, 2<<20)) // 2MB cap
+	// One byte over the cap tells a truncated body from one that fits.
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxPageBytes+1))
 	if err != nil {
 		return nil, res.StatusCode, err
 	}
-
-	m := &Metadata{}
-
-	// Try HTML OG tags first.
-	if doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body)); err == nil {
-		// Helpers: sites sometimes use `property` or `name`.
-		getMeta := func(key string) string {
-			keyEsc := strings.ReplaceAll(key, "'", "\\'")
-			if v := strings.TrimSpace(doc.Find("meta[property='"+keyEsc+"']").AttrOr("content", "")); v != "" {
-				return v
-			}
-			if v := strings.TrimSpace(doc.Find("meta[name='"+keyEsc+"']").AttrOr("content", "")); v != "" {
-				return v
-			}
-			return ""
-		}
-
-		// Title
-		m.Title = firstNonEmpty(
-			getMeta("og:title"),
-			getMeta("twitter:title"),
-			strings.TrimSpace(doc.Find("title").Text()),
-		)
-		// Description
-		m.Description = firstNonEmpty(
-			getMeta("og:description"),
-			getMeta("twitter:description"),
-			getMeta("description"),
-		)
-		// Image
-		m.Image = firstNonEmpty(
-			getMeta("og:image"),
-			getMeta("og:image:url"),
-			getMeta("twitter:image"),
-			getMeta("twitter:image:src"),
-		)
-
-		// Resolve relative image URLs if any.
-		if m.Image != "" {
-			m.Image = resolveMaybeRelativeURL(target, m.Image)
-		}
+	truncated := len(body) > maxPageBytes
+	if truncated {
+		body = body[:maxPageBytes]
 	}
 
-	// If still missing image, try extracting from jina's Markdown/plain content.
-	if m.Image == "" {
-		text := string(body)
-		m.Title = firstNonEmpty(m.Title, parseJinaTitle(text))
-		m.Image = firstNonEmpty(m.Image, extractFirstImageURL(text))
-	}
+	// Shift_JIS / EUC-JP pages are still common; extractors expect UTF-8.
+	contentType := res.Header.Get("Content-Type")
+	body = decodeToUTF8(body, contentType)
 
+	m := ExtractMetadata(target, body)
+	// Error and bot-challenge pages have text, but it is not the page's content.
+	if res.StatusCode < 200 || res.StatusCode > 299 || looksLikeBotChallenge(m.Title) {
+		m.Content = nil
+	} else if !truncated && isHTMLMediaType(contentType) {
+		m.Page = &FetchedPage{URL: res.Request.URL, Body: body}
+	}
 	return m, res.StatusCode, nil
 }
 
-func applyBrowserHeaders(req *http.Request) {
-	// Some sites (e.g. behind Cloudflare) may block obvious bot UAs from cloud IPs.
-	// Use a browser-like UA to improve fetch success rates.
-	req.Header.Set(
-		"User-Agent",
-		"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/130.0.0.0 Safari/537.36",
-	)
-	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
-	req.Header.Set("Accept-Language", "ja,en-US;q=0.9,en;q=0.8")
-	req.Header.Set("Cache-Control", "no-cache")
-	req.Header.Set("Pragma", "no-cache")
+// isHTMLMediaType reports whether contentType is an HTML or XHTML type.
+func isHTMLMediaType(contentType string) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	return mediaType == "text/html" || mediaType == "application/xhtml+xml"
 }
 
 func firstNonEmpty(values ...string) string {
@@ -251,28 +305,3 @@ func resolveMaybeRelativeURL(baseStr, u string) string {
 	}
 	return base.ResolveReference(parsed).String()
 }
-
-func parseJinaTitle(text string) string {
-	for _, line := range strings.Split(text, "\n") {
-		line = strings.TrimSpace(line)
-		if strings.HasPrefix(line, "Title:") {
-			return strings.TrimSpace(strings.TrimPrefix(line, "Title:"))
-		}
-	}
-	return ""
-}
-
-var (
-	reMarkdownImage = regexp.MustCompile(`!\[[^\]]*\]\((https?://[^)\s]+)\)`)
-	reImageURL      = regexp.MustCompile(`https?://[^\s)]+?\.(?:png|jpe?g|webp)(?:\?[^\s)]*)?`)
-)
-
-func extractFirstImageURL(text string) string {
-	if m := reMarkdownImage.FindStringSubmatch(text); len(m) == 2 {
-		return strings.TrimRight(m[1], ")")
-	}
-	if m := reImageURL.FindString(text); m != "" {
-		return strings.TrimRight(m, ")")
-	}
-	return ""
-}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/lvncer/quicklinks/api/internal/repository"
+)
+
+// MetadataCache reads and writes fetched metadata shared across users, so a
+// popular page is scraped once per TTL rather than once per save or preview.
+// Blocked, robots-disallowed and empty results are cached for negativeTTL.
+// Fetch errors are not cached. The readable text (Metadata.Content) is not
+// cached either: it is stored per link, and would make every entry large.
+// Metadata.HasContent records that the page had some.
+type MetadataCache struct {
+	repo        repository.MetadataCacheRepository
+	ttl         time.Duration
+	negativeTTL time.Duration
+}
+
+// NewMetadataCache returns a cache backed by repo. A ttl of zero disables
+// caching; a negativeTTL of zero disables caching of negative results.
+func NewMetadataCache(repo repository.MetadataCacheRepository, ttl, negativeTTL time.Duration) *MetadataCache {
+	return &MetadataCache{repo: repo, ttl: ttl, negativeTTL: negativeTTL}
+}
+
+func (c *MetadataCache) enabled() bool {
+	return c != nil && c.ttl > 0
+}
+
+// Lookup returns the cached metadata for targetURL, or nil on a miss. Cache
+// errors are logged and treated as misses.
+func (c *MetadataCache) Lookup(ctx context.Context, targetURL string) *Metadata {
+	key, ok := c.key(targetURL)
+	if !ok {
+		return nil
+	}
+	entry, err := c.repo.GetCachedMetadata(ctx, key)
+	if err != nil {
+		if !errors.Is(err, repository.ErrMetadataCacheMiss) {
+			log.Printf("failed to read metadata cache: %v (url=%s)", err, key)
+		}
+		return nil
+	}
+
+	b, err := json.Marshal(entry.Data)
+	if err != nil {
+		return nil
+	}
+	var meta Metadata
+	if err := json.Unmarshal(b, &meta); err != nil {
+		log.Printf("malformed metadata cache entry: %v (url=%s)", err, key)
+		return nil
+	}
+	// Entries written before Content was left out may still carry it.
+	meta.Content = nil
+	meta.Cached = true
+	return &meta
+}
+
+// Fetch returns the cached metadata for targetURL, or fetches and caches it.
+// With useCached false the cache is not read, but a fresh result still
+// replaces the stored one.
+func (c *MetadataCache) Fetch(ctx context.Context, targetURL string, useCached bool) (*Metadata, error) {
+	if useCached {
+		if meta := c.Lookup(ctx, targetURL); meta != nil {
+			return meta, nil
+		}
+	}
+
+	meta, err := FetchMetadata(ctx, targetURL)
+	if err != nil {
+		return nil, err
+	}
+	c.store(ctx, targetURL, meta)
+	return meta, nil
+}
+
+// store caches meta for targetURL. Failures are logged; the cache only ever
+// saves work.
+func (c *MetadataCache) store(ctx context.Context, targetURL string, meta *Metadata) {
+	key, ok := c.key(targetURL)
+	if !ok {
+		return
+	}
+	negative := isNegativeMetadata(meta)
+	ttl := c.ttl
+	if negative {
+		ttl = c.negativeTTL
+	}
+	if ttl <= 0 {
+		return
+	}
+
+	entry := *meta
+	entry.HasContent = meta.Content != nil
+	entry.Content = nil
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	var data map[string]any
+	if err := json.Unmarshal(b, &data); err != nil {
+		return
+	}
+	err = c.repo.PutCachedMetadata(ctx, repository.MetadataCacheEntry{
+		CanonicalURL: key,
+		Data:         data,
+		Negative:     negative,
+		ExpiresAt:    time.Now().Add(ttl),
+	})
+	if err != nil {
+		log.Printf("failed to write metadata cache: %v (url=%s)", err, key)
+	}
+}
+
+// key returns the cache key for targetURL. ok is false when caching is
+// disabled or the URL cannot be canonicalized.
+func (c *MetadataCache) key(targetURL string) (string, bool) {
+	if !c.enabled() {
+		return "", false
+	}
+	key, err := CanonicalizeURL(targetURL)
+	if err != nil {
+		return "", false
+	}
+	return key, true
+}
+
+// isNegativeMetadata reports whether meta is a blocked, skipped or empty
+// result, worth retrying sooner than a successful one.
+func isNegativeMetadata(meta *Metadata) bool {
+	if meta.Blocked || meta.Source == SourceRobots {
+		return true
+	}
+	return meta.Title == "" && meta.Description == "" && meta.Image == "" && meta.Embed == nil
+}
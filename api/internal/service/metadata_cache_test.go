@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lvncer/quicklinks/api/internal/repository"
+)
+
+// memoryMetadataCache is an in-memory repository.MetadataCacheRepository.
+type memoryMetadataCache struct {
+	entries map[string]repository.MetadataCacheEntry
+}
+
+func (m *memoryMetadataCache) GetCachedMetadata(_ context.Context, canonicalURL string) (*repository.MetadataCacheEntry, error) {
+	e, ok := m.entries[canonicalURL]
+	if !ok || time.Now().After(e.ExpiresAt) {
+		return nil, repository.ErrMetadataCacheMiss
+	}
+	return &e, nil
+}
+
+func (m *memoryMetadataCache) PutCachedMetadata(_ context.Context, entry repository.MetadataCacheEntry) error {
+	if m.entries == nil {
+		m.entries = make(map[string]repository.MetadataCacheEntry)
+	}
+	m.entries[entry.CanonicalURL] = entry
+	return nil
+}
+
+func (m *memoryMetadataCache) PurgeExpiredMetadata(context.Context, time.Time) (int, error) {
+	return 0, nil
+}
+
+func TestMetadataCacheLeavesOutContent(t *testing.T) {
+	repo := &memoryMetadataCache{}
+	cache := NewMetadataCache(repo, time.Hour, time.Minute)
+	ctx := context.Background()
+	const pageURL = "https://example.com/article"
+
+	meta := &Metadata{
+		Title:   "Title",
+		Source:  "direct",
+		Content: &Content{Text: "long readable text", WordCount: 3, ReadingMinutes: 1},
+	}
+	cache.store(ctx, pageURL, meta)
+
+	if meta.Content == nil {
+		t.Fatal("store cleared Content on the caller's Metadata")
+	}
+	if len(repo.entries) != 1 {
+		t.Fatalf("entries = %d, want 1", len(repo.entries))
+	}
+	for key, e := range repo.entries {
+		if _, ok := e.Data["content"]; ok {
+			t.Errorf("entry %s stores content: %v", key, e.Data["content"])
+		}
+		if e.Negative {
+			t.Errorf("entry %s is negative", key)
+		}
+	}
+
+	got := cache.Lookup(ctx, pageURL)
+	if got == nil || got.Title != "Title" || !got.Cached {
+		t.Fatalf("Lookup = %+v", got)
+	}
+	if got.Content != nil {
+		t.Errorf("Content = %+v, want nil", got.Content)
+	}
+}
+
+func TestMetadataCacheDropsStoredContent(t *testing.T) {
+	const pageURL = "https://example.com/old"
+	key, err := CanonicalizeURL(pageURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// An entry written while Content was still cached.
+	repo := &memoryMetadataCache{entries: map[string]repository.MetadataCacheEntry{
+		key: {
+			CanonicalURL: key,
+			Data:         map[string]any{"title": "Old", "content": map[string]any{"text": "stale"}},
+			ExpiresAt:    time.Now().Add(time.Hour),
+		},
+	}}
+	got := NewMetadataCache(repo, time.Hour, time.Minute).Lookup(context.Background(), pageURL)
+	if got == nil || got.Title != "Old" {
+		t.Fatalf("Lookup = %+v", got)
+	}
+	if got.Content != nil {
+		t.Errorf("Content = %+v, want nil", got.Content)
+	}
+}
+
+func TestMetadataCacheFetchUsesContext(t *testing.T) {
+	repo := &memoryMetadataCache{}
+	cache := NewMetadataCache(repo, time.Hour, time.Minute)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	meta, err := cache.Fetch(ctx, "https://example.com/", false)
+	if err == nil {
+		t.Fatalf("Fetch = %+v, want an error from the canceled context", meta)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("Fetch took %v after cancellation", d)
+	}
+	if len(repo.entries) != 0 {
+		t.Errorf("cached %d entries from a failed fetch", len(repo.entries))
+	}
+}
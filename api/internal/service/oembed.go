@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/lvncer/quicklinks/api/internal/model"
+)
+
+// OEmbedProvider is a known oEmbed provider. Pages on these hosts are looked
+// up even when the page itself does not advertise an endpoint (or cannot be
+// fetched at all, which is common for video and social sites).
+type OEmbedProvider struct {
+	Name string
+	// Hosts are matched exactly, after stripping a leading "www." or "m.".
+	Hosts []string
+	// PathPrefixes restrict matching to content pages. Empty matches any path.
+	PathPrefixes []string
+	// Endpoint is the JSON oEmbed endpoint.
+	Endpoint string
+	// FrameHosts are other domains (and their subdomains) the provider's
+	// embed iframes may load from. Hosts are always allowed.
+	FrameHosts []string
+}
+
+// oEmbedProviders is the built-in provider list.
+var oEmbedProviders = []OEmbedProvider{
+	{
+		Name:       "YouTube",
+		Hosts:      []string{"youtube.com", "youtu.be"},
+		Endpoint:   "https://www.youtube.com/oembed",
+		FrameHosts: []string{"youtube-nocookie.com"},
+	},
+	{
+		Name:     "Vimeo",
+		Hosts:    []string{"vimeo.com", "player.vimeo.com"},
+		Endpoint: "https://vimeo.com/api/oembed.json",
+	},
+	{
+		Name:     "X",
+		Hosts:    []string{"twitter.com", "x.com"},
+		Endpoint: "https://publish.twitter.com/oembed",
+	},
+	{
+		Name:     "SoundCloud",
+		Hosts:    []string{"soundcloud.com"},
+		Endpoint: "https://soundcloud.com/oembed",
+	},
+	{
+		Name:     "Spotify",
+		Hosts:    []string{"open.spotify.com"},
+		Endpoint: "https://open.spotify.com/oembed",
+	},
+	{
+		Name:         "TikTok",
+		Hosts:        []string{"tiktok.com"},
+		PathPrefixes: []string{"/@"},
+		Endpoint:     "https://www.tiktok.com/oembed",
+	},
+	{
+		Name:         "Flickr",
+		Hosts:        []string{"flickr.com", "flic.kr"},
+		PathPrefixes: []string{"/photos/", "/p/"},
+		Endpoint:     "https://www.flickr.com/services/oembed/",
+	},
+	{
+		Name:     "Speaker Deck",
+		Hosts:    []string{"speakerdeck.com"},
+		Endpoint: "https://speakerdeck.com/oembed.json",
+	},
+}
+
+// RegisterOEmbedProvider adds p to the built-in provider list. Like
+// RegisterExtractor, it should be called from init functions only.
+func RegisterOEmbedProvider(p OEmbedProvider) {
+	oEmbedProviders = append(oEmbedProviders, p)
+}
+
+// lookupOEmbedEndpoint returns the oEmbed request URL for pageURL from the
+// built-in provider list, or "" if no provider matches.
+func lookupOEmbedEndpoint(pageURL string) string {
+	u, err := url.Parse(pageURL)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	host := strings.ToLower(u.Hostname())
+	host = strings.TrimPrefix(host, "www.")
+	host = strings.TrimPrefix(host, "m.")
+
+	for _, p := range oEmbedProviders {
+		if !containsString(p.Hosts, host) || !hasAnyPrefix(u.Path, p.PathPrefixes) {
+			continue
+		}
+		endpoint, err := url.Parse(p.Endpoint)
+		if err != nil {
+			continue
+		}
+		q := endpoint.Query()
+		q.Set("url", pageURL)
+		q.Set("format", "json")
+		endpoint.RawQuery = q.Encode()
+		return endpoint.String()
+	}
+	return ""
+}
+
+// oEmbedResponse is the JSON oEmbed response. Providers are loose about
+// number types (width "100%" or "640"), so numbers are decoded leniently.
+type oEmbedResponse struct {
+	Type         string `json:"type"`
+	Title        string `json:"title"`
+	AuthorName   string `json:"author_name"`
+	ProviderName string `json:"provider_name"`
+	ThumbnailURL string `json:"thumbnail_url"`
+	HTML         string `json:"html"`
+	Width        any    `json:"width"`
+	Height       any    `json:"height"`
+	Duration     any    `json:"duration"` // non-standard; Vimeo and others
+}
+
+// fetchOEmbed requests an oEmbed endpoint and converts the response.
+// Embed HTML is sanitized before it is returned.
+func fetchOEmbed(ctx context.Context, client *http.Client, endpoint string) (*model.Embed, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, errors.New("invalid oembed endpoint")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	applyBrowserHeaders(req)
+	req.Header.Set("Accept", "application/json")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("oembed endpoint returned %d", res.StatusCode)
+	}
+
+	var r oEmbedResponse
+	dec := json.NewDecoder(io.LimitReader(res.Body, 1<<20)) // 1MB cap
+	dec.UseNumber()
+	if err := dec.Decode(&r); err != nil {
+		return nil, fmt.Errorf("decode oembed: %w", err)
+	}
+
+	e := &model.Embed{
+		Type:         strings.TrimSpace(r.Type),
+		ProviderName: strings.TrimSpace(r.ProviderName),
+		AuthorName:   strings.TrimSpace(r.AuthorName),
+		Title:        strings.TrimSpace(r.Title),
+		ThumbnailURL: resolveMaybeRelativeURL(endpoint, r.ThumbnailURL),
+		Duration:     oEmbedInt(r.Duration),
+		Width:        oEmbedInt(r.Width),
+		Height:       oEmbedInt(r.Height),
+		HTML:         SanitizeEmbedHTML(r.HTML, endpoint),
+	}
+	if !isHTTPURL(e.ThumbnailURL) {
+		e.ThumbnailURL = ""
+	}
+	if e.IsZero() {
+		return nil, errors.New("empty oembed response")
+	}
+	return e, nil
+}
+
+// applyEmbed fills metadata fields the page itself did not provide.
+func applyEmbed(m *Metadata, e *model.Embed) {
+	if e == nil {
+		return
+	}
+	m.Embed = e
+	setIfEmpty(&m.Title, e.Title)
+	setIfEmpty(&m.Image, e.ThumbnailURL)
+	setIfEmpty(&m.SiteName, e.ProviderName)
+	setIfEmpty(&m.Structured.Author, e.AuthorName)
+}
+
+// oEmbedInt reads a positive integer from a JSON number or numeric string.
+// Anything else (e.g. "100%") yields 0.
+func oEmbedInt(v any) int {
+	var s string
+	switch n := v.(type) {
+	case json.Number:
+		s = n.String()
+	case string:
+		s = strings.TrimSpace(n)
+	default:
+		return 0
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f <= 0 {
+		return 0
+	}
+	return int(f)
+}
+
+func isHTTPURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	if len(prefixes) == 0 {
+		return true
+	}
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
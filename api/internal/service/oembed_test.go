@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestLookupOEmbedEndpoint(t *testing.T) {
+	tests := []struct {
+		pageURL  string
+		endpoint string // "" when no provider matches
+	}{
+		{"https://www.youtube.com/watch?v=abc", "https://www.youtube.com/oembed"},
+		{"https://m.youtube.com/watch?v=abc", "https://www.youtube.com/oembed"},
+		{"https://youtu.be/abc", "https://www.youtube.com/oembed"},
+		{"https://player.vimeo.com/video/1", "https://vimeo.com/api/oembed.json"},
+		{"https://x.com/user/status/1", "https://publish.twitter.com/oembed"},
+		{"https://www.tiktok.com/@user/video/1", "https://www.tiktok.com/oembed"},
+		{"https://www.tiktok.com/explore", ""}, // outside PathPrefixes
+		{"https://www.flickr.com/photos/user/1", "https://www.flickr.com/services/oembed/"},
+		{"https://music.youtube.com/watch?v=abc", ""}, // hosts match exactly
+		{"https://example.com/watch?v=abc", ""},
+		{"not a url", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pageURL, func(t *testing.T) {
+			got := lookupOEmbedEndpoint(tt.pageURL)
+			if tt.endpoint == "" {
+				if got != "" {
+					t.Errorf("got %q, want no match", got)
+				}
+				return
+			}
+			u, err := url.Parse(got)
+			if err != nil {
+				t.Fatalf("got %q: %v", got, err)
+			}
+			if base := u.Scheme + "://" + u.Host + u.Path; base != tt.endpoint {
+				t.Errorf("endpoint = %q, want %q", base, tt.endpoint)
+			}
+			if q := u.Query(); q.Get("url") != tt.pageURL || q.Get("format") != "json" {
+				t.Errorf("query = %q, want url and format=json", u.RawQuery)
+			}
+		})
+	}
+}
+
+// newOEmbedProvider starts a stand-in oEmbed provider answering every
+// request with status and body, in which HOST is replaced by its own host.
+func newOEmbedProvider(t *testing.T, status int, body string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Accept"); got != "application/json" {
+			t.Errorf("Accept = %q, want application/json", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(strings.ReplaceAll(body, "HOST", r.Host)))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestFetchOEmbed(t *testing.T) {
+	srv := newOEmbedProvider(t, http.StatusOK, `{
+		"type": "video",
+		"title": " A Video ",
+		"author_name": "Someone",
+		"provider_name": "Stand-in",
+		"thumbnail_url": "/thumbs/1.jpg",
+		"width": "640",
+		"height": 360.0,
+		"duration": "100%",
+		"html": "<iframe src=\"https://HOST/embed/1\" allow=\"camera\"></iframe><iframe src=\"https://tracker.example.net/x\"></iframe><script>alert(1)</script>"
+	}`)
+	host := strings.TrimPrefix(srv.URL, "http://")
+
+	e, err := fetchOEmbed(context.Background(), srv.Client(), srv.URL+"/oembed?url=x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Type != "video" || e.Title != "A Video" || e.AuthorName != "Someone" || e.ProviderName != "Stand-in" {
+		t.Errorf("fields = %+v", e)
+	}
+	if e.ThumbnailURL != srv.URL+"/thumbs/1.jpg" {
+		t.Errorf("ThumbnailURL = %q, want it resolved against the endpoint", e.ThumbnailURL)
+	}
+	if e.Width != 640 || e.Height != 360 || e.Duration != 0 {
+		t.Errorf("Width = %d, Height = %d, Duration = %d", e.Width, e.Height, e.Duration)
+	}
+	want := `<iframe src="https://` + host + `/embed/1" sandbox="` + embedFrameSandbox + `"></iframe>`
+	if e.HTML != want {
+		t.Errorf("HTML = %q, want %q", e.HTML, want)
+	}
+}
+
+func TestFetchOEmbedErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{"status", http.StatusNotFound, `{"type":"video","title":"x"}`},
+		{"malformed", http.StatusOK, `{"type":`},
+		{"empty", http.StatusOK, `{"version":"1.0"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newOEmbedProvider(t, tt.status, tt.body)
+			if e, err := fetchOEmbed(context.Background(), srv.Client(), srv.URL+"/oembed"); err == nil {
+				t.Errorf("got %+v, want an error", e)
+			}
+		})
+	}
+
+	for _, endpoint := range []string{"ftp://example.com/oembed", "/oembed", "::"} {
+		if _, err := fetchOEmbed(context.Background(), http.DefaultClient, endpoint); err == nil {
+			t.Errorf("fetchOEmbed(%q) succeeded, want invalid endpoint", endpoint)
+		}
+	}
+}
+
+func TestFetchEmbedPrefersDiscoveredEndpoint(t *testing.T) {
+	srv := newOEmbedProvider(t, http.StatusOK, `{"type":"rich","title":"Discovered"}`)
+
+	// The page is on a known provider's host, but the advertised endpoint wins.
+	e := fetchEmbed(context.Background(), srv.Client(), "https://www.youtube.com/watch?v=abc", srv.URL+"/oembed")
+	if e == nil || e.Title != "Discovered" {
+		t.Fatalf("embed = %+v", e)
+	}
+
+	var m Metadata
+	applyEmbed(&m, e)
+	if m.Title != "Discovered" || m.Embed != e {
+		t.Errorf("applyEmbed: %+v", m)
+	}
+}
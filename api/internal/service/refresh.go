@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/lvncer/quicklinks/api/internal/model"
+	"github.com/lvncer/quicklinks/api/internal/repository"
+)
+
+// MetadataRefresher fetches metadata for a saved link and stores the result,
+// including the fetch bookkeeping used to schedule re-scrapes.
+type MetadataRefresher struct {
+	links    repository.LinkRepository
+	contents repository.ContentRepository
+	sites    *SiteResolver
+	cache    *MetadataCache
+}
+
+func NewMetadataRefresher(links repository.LinkRepository, contents repository.ContentRepository, sites *SiteResolver, cache *MetadataCache) *MetadataRefresher {
+	return &MetadataRefresher{links: links, contents: contents, sites: sites, cache: cache}
+}
+
+// Refresh fetches metadata for the link with the given id. The link must
+// already be authorized by the caller. If the fetch itself fails, the failure
+// is recorded on the link and the fetch error is returned. Unless overwrite is
+// set, a cached result for the same canonical URL is used instead of fetching.
+func (r *MetadataRefresher) Refresh(ctx context.Context, linkID string, overwrite bool) (*model.Link, error) {
+	updated, _, err := r.RefreshPage(ctx, linkID, overwrite)
+	return updated, err
+}
+
+// RefreshPage is Refresh, also returning the page it downloaded so it can be
+// archived without fetching it again. The page is nil if the metadata came
+// from the cache or the page was not a complete HTML document.
+func (r *MetadataRefresher) RefreshPage(ctx context.Context, linkID string, overwrite bool) (*model.Link, *FetchedPage, error) {
+	l, err := r.links.FindLinkByID(ctx, linkID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res := repository.FetchResult{FetchedAt: time.Now()}
+	meta, fetchErr := r.cache.Fetch(ctx, l.URL, !overwrite)
+	if fetchErr == nil && meta.HasContent && !r.contentFromCache(ctx, linkID) {
+		// No link has the page's content to copy; fetch it after all.
+		meta, fetchErr = r.cache.Fetch(ctx, l.URL, false)
+	}
+	if fetchErr != nil {
+		res.Err = fetchErr
+	} else {
+		res.Title = meta.Title
+		res.Description = meta.Description
+		res.OGImage = meta.Image
+		res.Source = meta.Source
+		res.Blocked = meta.Blocked
+		res.Cached = meta.Cached
+		res.Structured = meta.Structured
+		res.Embed = meta.Embed
+	}
+
+	updated, err := r.links.ApplyFetchResult(ctx, linkID, res, overwrite)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Content is only ever replaced by newer content, never cleared, so the
+	// text survives the page going away.
+	if r.contents != nil && fetchErr == nil && meta.Content != nil {
+		err := r.contents.PutLinkContent(ctx, linkID, repository.LinkContentInput{
+			Text:           meta.Content.Text,
+			WordCount:      meta.Content.WordCount,
+			ReadingMinutes: meta.Content.ReadingMinutes,
+			ExtractedAt:    res.FetchedAt,
+		})
+		if err != nil {
+			log.Printf("failed to store content for link %s: %v", linkID, err)
+		}
+	}
+
+	// The site identity is supplementary; failing to resolve it does not
+	// fail the refresh.
+	if r.sites != nil && updated.Domain != "" {
+		site, err := r.sites.AttachLink(ctx, linkID, updated.Domain, meta)
+		if err != nil {
+			log.Printf("failed to resolve site for %s: %v", updated.Domain, err)
+		} else {
+			updated.SiteID = site.ID
+			updated.Site = site
+		}
+	}
+	if fetchErr != nil {
+		return updated, nil, fetchErr
+	}
+	return updated, meta.Page, nil
+}
+
+// contentFromCache makes sure a link whose metadata came from the cache has
+// the page's content, which the cache leaves out, by copying it from another
+// link to the same page. It reports false if no link has it.
+func (r *MetadataRefresher) contentFromCache(ctx context.Context, linkID string) bool {
+	if r.contents == nil {
+		return true
+	}
+	_, err := r.contents.GetLinkContent(ctx, linkID)
+	if !errors.Is(err, repository.ErrContentNotFound) {
+		// The link has content already (or the lookup failed; fetching would
+		// not help with that).
+		return true
+	}
+	err = r.contents.CopyLinkContent(ctx, linkID)
+	if errors.Is(err, repository.ErrContentNotFound) {
+		return false
+	}
+	if err != nil {
+		log.Printf("failed to copy content for link %s: %v", linkID, err)
+	}
+	return true
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lvncer/quicklinks/api/internal/model"
+	"github.com/lvncer/quicklinks/api/internal/repository"
+)
+
+// memoryLinks implements the parts of repository.LinkRepository used by
+// MetadataRefresher.
+type memoryLinks struct {
+	repository.LinkRepository
+	links map[string]*model.Link
+}
+
+func (m *memoryLinks) FindLinkByID(_ context.Context, id string) (*model.Link, error) {
+	l, ok := m.links[id]
+	if !ok {
+		return nil, repository.ErrLinkNotFound
+	}
+	return l, nil
+}
+
+func (m *memoryLinks) ApplyFetchResult(ctx context.Context, id string, res repository.FetchResult, _ bool) (*model.Link, error) {
+	l, err := m.FindLinkByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if res.Err == nil && res.Title != "" {
+		l.Title = res.Title
+	}
+	return l, nil
+}
+
+// memoryContents is an in-memory repository.ContentRepository. Links are
+// matched for CopyLinkContent by canonicalizing their URLs.
+type memoryContents struct {
+	links    *memoryLinks
+	contents map[string]repository.LinkContentInput
+}
+
+func (m *memoryContents) GetLinkContent(_ context.Context, linkID string) (*model.LinkContent, error) {
+	c, ok := m.contents[linkID]
+	if !ok {
+		return nil, repository.ErrContentNotFound
+	}
+	return &model.LinkContent{LinkID: linkID, Text: c.Text, WordCount: c.WordCount, ReadingMinutes: c.ReadingMinutes, ExtractedAt: c.ExtractedAt}, nil
+}
+
+func (m *memoryContents) PutLinkContent(_ context.Context, linkID string, input repository.LinkContentInput) error {
+	m.contents[linkID] = input
+	return nil
+}
+
+func (m *memoryContents) CopyLinkContent(ctx context.Context, linkID string) error {
+	l, err := m.links.FindLinkByID(ctx, linkID)
+	if err != nil {
+		return err
+	}
+	canonical, _ := CanonicalizeURL(l.URL)
+	for id, c := range m.contents {
+		other := m.links.links[id]
+		if id == linkID || other == nil {
+			continue
+		}
+		if oc, _ := CanonicalizeURL(other.URL); oc == canonical {
+			return m.PutLinkContent(ctx, linkID, c)
+		}
+	}
+	return repository.ErrContentNotFound
+}
+
+// useUnguardedFetches lets fetches reach local test servers.
+func useUnguardedFetches(t *testing.T) {
+	t.Helper()
+	fetchPolicyMu.Lock()
+	saved := fetchTransport
+	fetchTransport = http.DefaultTransport.(*http.Transport).Clone()
+	fetchPolicyMu.Unlock()
+	t.Cleanup(func() {
+		fetchPolicyMu.Lock()
+		fetchTransport = saved
+		fetchPolicyMu.Unlock()
+	})
+}
+
+func TestRefreshGivesCachedLinksContent(t *testing.T) {
+	useUnguardedFetches(t)
+	page := readExtractFixture(t, "article.html")
+	var fetches atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/story" {
+			http.NotFound(w, r)
+			return
+		}
+		fetches.Add(1)
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write(page)
+	}))
+	t.Cleanup(srv.Close)
+
+	links := &memoryLinks{links: map[string]*model.Link{}}
+	contents := &memoryContents{links: links, contents: map[string]repository.LinkContentInput{}}
+	cache := NewMetadataCache(&memoryMetadataCache{}, time.Hour, time.Minute)
+	r := NewMetadataRefresher(links, contents, nil, cache)
+	ctx := context.Background()
+
+	// save adds a link to the page, as POST /api/links does, and runs its
+	// fetch_metadata job.
+	save := func(id string) {
+		t.Helper()
+		links.links[id] = &model.Link{ID: id, URL: srv.URL + "/story?utm_source=x"}
+		if _, err := r.Refresh(ctx, id, false); err != nil {
+			t.Fatalf("refresh %s: %v", id, err)
+		}
+		if _, err := contents.GetLinkContent(ctx, id); err != nil {
+			t.Errorf("link %s has no content: %v", id, err)
+		}
+	}
+
+	save("first")
+	save("second") // cache hit: content copied from the first link
+	if n := fetches.Load(); n != 1 {
+		t.Errorf("page fetched %d times, want once", n)
+	}
+	if contents.contents["second"].Text != contents.contents["first"].Text {
+		t.Error("second link's content differs from the first's")
+	}
+
+	// With no link left to copy from, the page is fetched despite the hit.
+	delete(links.links, "first")
+	delete(links.links, "second")
+	delete(contents.contents, "first")
+	delete(contents.contents, "second")
+	save("third")
+	if n := fetches.Load(); n != 2 {
+		t.Errorf("page fetched %d times, want twice", n)
+	}
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// robotsRule is one Allow/Disallow line.
+type robotsRule struct {
+	allow bool
+	// length is the pattern length, used to pick the most specific rule.
+	length int
+	re     *regexp.Regexp
+}
+
+// newRobotsRule compiles a robots.txt path pattern, supporting "*" and a
+// trailing "$" anchor. Empty patterns match nothing.
+func newRobotsRule(allow bool, pattern string) (robotsRule, bool) {
+	if pattern == "" {
+		return robotsRule{}, false
+	}
+	expr := "^" + strings.ReplaceAll(regexp.QuoteMeta(strings.TrimSuffix(pattern, "$")), `\*`, ".*")
+	if strings.HasSuffix(pattern, "$") {
+		expr += "$"
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return robotsRule{}, false
+	}
+	return robotsRule{allow: allow, length: len(pattern), re: re}, true
+}
+
+// robotsRules are the rules that apply to our user agent. A nil value
+// allows everything.
+type robotsRules []robotsRule
+
+// robotsDisallowAll is used while a host's robots.txt is unreachable.
+var robotsDisallowAll = robotsRules{{allow: false, length: 1, re: regexp.MustCompile("^/")}}
+
+// allowed reports whether path (including the query) may be fetched. The
+// longest matching pattern wins; on a tie, Allow wins (RFC 9309).
+func (r robotsRules) allowed(path string) bool {
+	best, allow := -1, true
+	for _, rule := range r {
+		if !rule.re.MatchString(path) {
+			continue
+		}
+		if rule.length > best || (rule.length == best && rule.allow) {
+			best, allow = rule.length, rule.allow
+		}
+	}
+	return allow
+}
+
+// robotsProductToken returns the lowercased product token of a user agent
+// ("QuickLinks/1.0 (+https://...)" -> "quicklinks").
+func robotsProductToken(agent string) string {
+	token, _, _ := strings.Cut(strings.TrimSpace(agent), "/")
+	if i := strings.IndexFunc(token, unicode.IsSpace); i >= 0 {
+		token = token[:i]
+	}
+	return strings.ToLower(token)
+}
+
+// parseRobots extracts the rules for agent from a robots.txt body. The
+// group whose User-agent matches agent's product token (case-insensitive)
+// is used if present, otherwise the "*" group.
+func parseRobots(body []byte, agent string) robotsRules {
+	agent = robotsProductToken(agent)
+
+	var (
+		specific, wildcard robotsRules
+		haveSpecific       bool
+		groupAgents        []string
+		inRules            bool // the current group has started listing rules
+		groupRules         robotsRules
+	)
+	flush := func() {
+		for _, a := range groupAgents {
+			switch {
+			case a == "*":
+				wildcard = append(wildcard, groupRules...)
+			case a != "" && a == agent:
+				specific = append(specific, groupRules...)
+				haveSpecific = true
+			}
+		}
+		groupAgents, groupRules, inRules = nil, nil, false
+	}
+
+	sc := bufio.NewScanner(bytes.NewReader(body))
+	for sc.Scan() {
+		line := sc.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "user-agent":
+			if inRules {
+				flush()
+			}
+			groupAgents = append(groupAgents, robotsProductToken(value))
+		case "allow", "disallow":
+			inRules = true
+			if rule, ok := newRobotsRule(key == "allow", value); ok {
+				groupRules = append(groupRules, rule)
+			}
+		}
+	}
+	flush()
+
+	if haveSpecific {
+		return specific
+	}
+	return wildcard
+}
+
+// robotsCache caches parsed robots.txt files per scheme and host.
+type robotsCache struct {
+	agent string
+	ttl   time.Duration
+
+	mu      sync.Mutex
+	entries map[string]robotsEntry
+}
+
+type robotsEntry struct {
+	rules   robotsRules
+	expires time.Time
+}
+
+// robotsErrorTTL is how long an unreachable robots.txt (5xx or a network
+// error) is treated as disallow-all before it is fetched again.
+const robotsErrorTTL = 10 * time.Minute
+
+// robotsCachePruneAt is the number of cached hosts above which expired
+// entries are dropped.
+const robotsCachePruneAt = 1024
+
+func newRobotsCache(agent string, ttl time.Duration) *robotsCache {
+	return &robotsCache{agent: agent, ttl: ttl, entries: make(map[string]robotsEntry)}
+}
+
+// allowed reports whether target may be fetched under its host's robots.txt.
+// Missing robots files (4xx) allow everything; unreachable ones (5xx or a
+// network error) disallow everything, as RFC 9309 §2.3.1.4 requires.
+func (c *robotsCache) allowed(ctx context.Context, client *http.Client, target string) bool {
+	u, err := url.Parse(target)
+	if err != nil || u.Host == "" {
+		return true
+	}
+	key := u.Scheme + "://" + strings.ToLower(u.Host)
+
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	c.mu.Unlock()
+	if !ok || time.Now().After(e.expires) {
+		e = c.fetch(ctx, client, key)
+		if ctx.Err() != nil {
+			// A canceled request says nothing about the host.
+			return e.rules.allowed(robotsPath(u))
+		}
+		c.mu.Lock()
+		if len(c.entries) >= robotsCachePruneAt {
+			now := time.Now()
+			for k, v := range c.entries {
+				if now.After(v.expires) {
+					delete(c.entries, k)
+				}
+			}
+		}
+		c.entries[key] = e
+		c.mu.Unlock()
+	}
+	return e.rules.allowed(robotsPath(u))
+}
+
+// robotsPath is the part of u matched against robots.txt patterns.
+func robotsPath(u *url.URL) string {
+	path := u.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	if u.RawQuery != "" {
+		path += "?" + u.RawQuery
+	}
+	return path
+}
+
+func (c *robotsCache) fetch(ctx context.Context, client *http.Client, origin string) robotsEntry {
+	unreachable := robotsEntry{rules: robotsDisallowAll, expires: time.Now().Add(robotsErrorTTL)}
+	req, err := http.NewRequestWithContext(ctx, "GET", origin+"/robots.txt", nil)
+	if err != nil {
+		return unreachable
+	}
+	// The rules are evaluated for c.agent, so the request identifies as it
+	// rather than as the browser the page fetches look like.
+	req.Header.Set("User-Agent", c.agent)
+	req.Header.Set("Accept", "text/plain")
+
+	res, err := client.Do(req)
+	if err != nil {
+		if errors.Is(err, ErrBlockedAddress) {
+			// The page itself is refused by the same check, with a clearer
+			// error than a robots denial.
+			return robotsEntry{expires: time.Now().Add(robotsErrorTTL)}
+		}
+		log.Printf("failed to fetch robots.txt: %v (origin=%s)", err, origin)
+		return unreachable
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode >= 200 && res.StatusCode < 300:
+		body, err := io.ReadAll(io.LimitReader(res.Body, 512<<10)) // RFC 9309 minimum is 500KiB
+		if err != nil {
+			return unreachable
+		}
+		return robotsEntry{rules: parseRobots(body, c.agent), expires: time.Now().Add(c.ttl)}
+	case res.StatusCode >= 400 && res.StatusCode < 500:
+		// No robots.txt: everything is allowed.
+		return robotsEntry{expires: time.Now().Add(c.ttl)}
+	default:
+		// 5xx (and anything unexpected): the host is unreachable.
+		log.Printf("robots.txt unavailable: status %d (origin=%s)", res.StatusCode, origin)
+		return unreachable
+	}
+}
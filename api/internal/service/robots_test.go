@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseRobots(t *testing.T) {
+	tests := []struct {
+		name  string
+		body  string
+		agent string
+		paths map[string]bool
+	}{
+		{
+			name: "longest match wins",
+			body: "User-agent: *\nDisallow: /private\nAllow: /private/public\n",
+			paths: map[string]bool{
+				"/":                    true,
+				"/private":             false,
+				"/private/x":           false,
+				"/private/public/page": true,
+			},
+		},
+		{
+			name: "allow wins a tie",
+			body: "User-agent: *\nDisallow: /page\nAllow: /page\n",
+			paths: map[string]bool{
+				"/page": true,
+			},
+		},
+		{
+			name: "end anchor",
+			body: "User-agent: *\nDisallow: /*.pdf$\n",
+			paths: map[string]bool{
+				"/doc.pdf":      false,
+				"/a/b/doc.pdf":  false,
+				"/doc.pdf?dl=1": true,
+				"/doc.pdf/view": true,
+				"/doc.html":     true,
+			},
+		},
+		{
+			name: "wildcard",
+			body: "User-agent: *\nDisallow: /*/edit\nDisallow: /search?q=*\n",
+			paths: map[string]bool{
+				"/wiki/edit":   false,
+				"/wiki/page":   true,
+				"/search?q=go": false,
+				"/search":      true,
+				"/edit":        true,
+			},
+		},
+		{
+			name: "empty disallow allows everything",
+			body: "User-agent: *\nDisallow:\n",
+			paths: map[string]bool{
+				"/anything": true,
+			},
+		},
+		{
+			name: "specific group replaces the wildcard group",
+			body: "User-agent: *\nDisallow: /\n\nUser-agent: QuickLinks\nDisallow: /drafts\n",
+			paths: map[string]bool{
+				"/":       true,
+				"/drafts": false,
+			},
+		},
+		{
+			name:  "group matches the product token case-insensitively",
+			body:  "User-agent: quicklinks/2.0\nDisallow: /\n",
+			agent: "QuickLinks/1.0 (+https://example.com/bot)",
+			paths: map[string]bool{
+				"/": false,
+			},
+		},
+		{
+			name: "grouped user agents share rules",
+			body: "User-agent: OtherBot\nUser-agent: QuickLinks\nDisallow: /shared\n",
+			paths: map[string]bool{
+				"/shared": false,
+			},
+		},
+		{
+			name: "other agents' groups do not apply",
+			body: "User-agent: Quick\nDisallow: /\n\nUser-agent: QuickLinksExtra\nDisallow: /\n\nUser-agent: *\nDisallow: /tmp\n",
+			paths: map[string]bool{
+				"/":    true,
+				"/tmp": false,
+			},
+		},
+		{
+			name: "empty user agent matches nothing",
+			body: "User-agent:\nDisallow: /\n",
+			paths: map[string]bool{
+				"/": true,
+			},
+		},
+		{
+			name: "comments and unknown lines are ignored",
+			body: "# robots\nUser-agent: * # everyone\nCrawl-delay: 5\nDisallow: /tmp # scratch\nSitemap: https://example.com/sitemap.xml\n",
+			paths: map[string]bool{
+				"/tmp/x": false,
+				"/tmpx":  false,
+				"/other": true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := tt.agent
+			if agent == "" {
+				agent = "QuickLinks"
+			}
+			rules := parseRobots([]byte(tt.body), agent)
+			for path, want := range tt.paths {
+				if got := rules.allowed(path); got != want {
+					t.Errorf("allowed(%q) = %v, want %v", path, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRobotsCacheFetch(t *testing.T) {
+	var gotAgent string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAgent = r.UserAgent()
+		_, _ = w.Write([]byte("User-agent: QuickLinks\nDisallow: /private\n"))
+	}))
+	t.Cleanup(srv.Close)
+
+	c := newRobotsCache("QuickLinks", time.Hour)
+	ctx := context.Background()
+	if c.allowed(ctx, srv.Client(), srv.URL+"/private/page") {
+		t.Error("/private/page allowed")
+	}
+	if !c.allowed(ctx, srv.Client(), srv.URL+"/public") {
+		t.Error("/public disallowed")
+	}
+	if gotAgent != "QuickLinks" {
+		t.Errorf("robots.txt fetched as %q, want the robots user agent", gotAgent)
+	}
+}
+
+func TestRobotsCacheUnreachable(t *testing.T) {
+	tests := []struct {
+		status int
+		want   bool
+	}{
+		{http.StatusNotFound, true},
+		{http.StatusForbidden, true},
+		{http.StatusInternalServerError, false},
+		{http.StatusServiceUnavailable, false},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			t.Cleanup(srv.Close)
+
+			c := newRobotsCache("QuickLinks", time.Hour)
+			if got := c.allowed(context.Background(), srv.Client(), srv.URL+"/page"); got != tt.want {
+				t.Errorf("allowed = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("network error", func(t *testing.T) {
+		srv := httptest.NewServer(http.NotFoundHandler())
+		origin := srv.URL
+		srv.Close()
+
+		c := newRobotsCache("QuickLinks", time.Hour)
+		if c.allowed(context.Background(), http.DefaultClient, origin+"/page") {
+			t.Error("allowed while robots.txt is unreachable")
+		}
+	})
+}
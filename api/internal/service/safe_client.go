@@ -0,0 +1,318 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ErrBlockedAddress is returned when a fetch would connect to a loopback,
+// private, link-local or otherwise non-public address.
+var ErrBlockedAddress = errors.New("address not allowed")
+
+// maxFetchRedirects caps redirect hops for server-side fetches.
+const maxFetchRedirects = 5
+
+// blockedPrefixes are special-purpose ranges not covered by the netip
+// helpers used in isPublicAddr.
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // "this network"
+	netip.MustParsePrefix("100.64.0.0/10"),   // carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments
+	netip.MustParsePrefix("192.0.2.0/24"),    // TEST-NET-1
+	netip.MustParsePrefix("198.18.0.0/15"),   // benchmarking
+	netip.MustParsePrefix("198.51.100.0/24"), // TEST-NET-2
+	netip.MustParsePrefix("203.0.113.0/24"),  // TEST-NET-3
+	netip.MustParsePrefix("240.0.0.0/4"),     // reserved, incl. broadcast
+	netip.MustParsePrefix("64:ff9b::/96"),    // NAT64 (embeds IPv4)
+	netip.MustParsePrefix("2001:db8::/32"),   // documentation
+}
+
+// isPublicAddr reports whether ip is a globally routable unicast address.
+func isPublicAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsValid() || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, p := range blockedPrefixes {
+		if p.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// guardedDialer resolves hosts itself and only connects to allowed (public)
+// addresses. Because the check happens at dial time, it applies to every
+// redirect hop and cannot be bypassed by DNS rebinding between a check and
+// the connect.
+//
+// With proxy set (address pinning, see newGuardedTransport), the dialer
+// connects to the proxy instead and opens a CONNECT tunnel to the checked IP
+// address, so the proxy never resolves the user-supplied host itself.
+type guardedDialer struct {
+	dialer *net.Dialer
+	proxy  *url.URL
+	// lookup and allowed resolve and check hosts; they are replaced in tests.
+	lookup  func(ctx context.Context, host string) ([]netip.Addr, error)
+	allowed func(netip.Addr) bool
+}
+
+// resolve resolves host and checks that every address is allowed. A host
+// that mixes public and internal records is rejected outright.
+func (d *guardedDialer) resolve(ctx context.Context, host string) ([]netip.Addr, error) {
+	var ips []netip.Addr
+	if ip, err := netip.ParseAddr(host); err == nil {
+		ips = []netip.Addr{ip}
+	} else {
+		ips, err = d.lookup(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(ips) == 0 {
+		return nil, fmt.Errorf("no addresses for %s", host)
+	}
+	for _, ip := range ips {
+		if d.allowed(ip) {
+			continue
+		}
+		if ip.String() == host {
+			return nil, fmt.Errorf("%w: %s", ErrBlockedAddress, ip.Unmap())
+		}
+		return nil, fmt.Errorf("%w: %s resolves to %s", ErrBlockedAddress, host, ip.Unmap())
+	}
+	return ips, nil
+}
+
+func (d *guardedDialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	ips, err := d.resolve(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastErr error
+	for _, ip := range ips {
+		target := net.JoinHostPort(ip.Unmap().String(), port)
+		var conn net.Conn
+		if d.proxy != nil {
+			conn, err = d.dialTunnel(ctx, target)
+		} else {
+			conn, err = d.dialer.DialContext(ctx, network, target)
+		}
+		if err == nil {
+			return conn, nil
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// dialTunnel connects to the proxy and asks it to CONNECT to target, an
+// already checked ip:port.
+func (d *guardedDialer) dialTunnel(ctx context.Context, target string) (net.Conn, error) {
+	proxyAddr := d.proxy.Host
+	if d.proxy.Port() == "" {
+		port := "80"
+		if d.proxy.Scheme == "https" {
+			port = "443"
+		}
+		proxyAddr = net.JoinHostPort(d.proxy.Hostname(), port)
+	}
+	conn, err := d.dialer.DialContext(ctx, "tcp", proxyAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Abort the handshake when ctx ends.
+	stop := context.AfterFunc(ctx, func() { conn.SetDeadline(time.Unix(1, 0)) })
+	tunnel, err := d.connect(ctx, conn, target)
+	if !stop() {
+		conn.Close()
+		return nil, ctx.Err()
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return tunnel, nil
+}
+
+// connect performs the (TLS and) CONNECT handshake with the proxy on conn.
+func (d *guardedDialer) connect(ctx context.Context, conn net.Conn, target string) (net.Conn, error) {
+	if d.proxy.Scheme == "https" {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: d.proxy.Hostname()})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			return nil, err
+		}
+		conn = tlsConn
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: target},
+		Host:   target,
+		Header: http.Header{},
+	}
+	if u := d.proxy.User; u != nil {
+		password, _ := u.Password()
+		req.Header.Set("Proxy-Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(u.Username()+":"+password)))
+	}
+	if err := req.Write(conn); err != nil {
+		return nil, err
+	}
+	br := bufio.NewReader(conn)
+	res, err := http.ReadResponse(br, req)
+	if err != nil {
+		return nil, err
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("proxy refused CONNECT to %s: %s", target, res.Status)
+	}
+	if br.Buffered() > 0 {
+		return nil, errors.New("proxy sent data before the tunnel was established")
+	}
+	return conn, nil
+}
+
+// newGuardedTransport builds the transport for user-supplied URLs, which
+// only connects to public addresses.
+//
+// With a proxy, requests go to it the usual way (absolute-form for http,
+// CONNECT to the hostname for https) and the proxy resolves the host: egress
+// proxies often exist because the server has no DNS of its own or may only
+// CONNECT to port 443. Only IP-literal hosts are checked here, so protection
+// against internal hostnames rests on the proxy's ACL. With pinAddress, the
+// host is resolved and checked here instead and the proxy is asked to
+// CONNECT to the checked IP address (see guardedDialer), which needs local
+// DNS and a proxy that allows CONNECT to any port.
+func newGuardedTransport(proxy *url.URL, pinAddress bool) *http.Transport {
+	d := &guardedDialer{
+		dialer: &net.Dialer{Timeout: 5 * time.Second, KeepAlive: 30 * time.Second},
+		lookup: func(ctx context.Context, host string) ([]netip.Addr, error) {
+			return net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+		},
+		allowed: isPublicAddr,
+	}
+	switch {
+	case proxy == nil:
+		return d.transport()
+	case pinAddress:
+		d.proxy = proxy
+		return d.transport()
+	default:
+		return d.proxiedTransport(proxy)
+	}
+}
+
+// transport returns an http.Transport that dials through d.
+func (d *guardedDialer) transport() *http.Transport {
+	return &http.Transport{
+		DialContext:           d.DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   5 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+}
+
+// proxiedTransport returns an http.Transport that sends every request
+// through proxy, refusing hosts that are (or a proxy may read as) IP
+// addresses that are not allowed. The proxy itself is operator configuration
+// and is not checked.
+func (d *guardedDialer) proxiedTransport(proxy *url.URL) *http.Transport {
+	t := d.transport()
+	t.DialContext = d.dialer.DialContext
+	t.Proxy = func(req *http.Request) (*url.URL, error) {
+		host := req.URL.Hostname()
+		if ip, err := netip.ParseAddr(host); err == nil {
+			if !d.allowed(ip) {
+				return nil, fmt.Errorf("%w: %s", ErrBlockedAddress, ip.Unmap())
+			}
+		} else if isNumericHost(host) {
+			return nil, fmt.Errorf("%w: numeric host %s", ErrBlockedAddress, host)
+		}
+		return proxy, nil
+	}
+	return t
+}
+
+// isNumericHost reports whether host ends in a numeric label, like the
+// shorthand IPv4 forms "2130706433" or "0x7f.1" that a resolver may accept as
+// an address. No top-level domain is numeric.
+func isNumericHost(host string) bool {
+	host = strings.TrimSuffix(host, ".")
+	label := host[strings.LastIndexByte(host, '.')+1:]
+	if label == "" {
+		return false
+	}
+	if strings.HasPrefix(label, "0x") || strings.HasPrefix(label, "0X") {
+		label = label[2:]
+		return strings.Trim(label, "0123456789abcdefABCDEF") == ""
+	}
+	return strings.Trim(label, "0123456789") == ""
+}
+
+// newReaderTransport builds the transport for the fallback reader. The reader
+// URL is operator configuration (it may be a self-hosted service on a
+// private network), so it is not address-checked.
+func newReaderTransport(proxy *url.URL) *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.Proxy = nil
+	if proxy != nil {
+		t.Proxy = http.ProxyURL(proxy)
+	}
+	return t
+}
+
+// newFetchClient returns the http.Client used for all server-side fetches of
+// user-supplied URLs: public addresses only, at most maxFetchRedirects
+// redirects, http(s) only.
+func newFetchClient(timeout time.Duration) *http.Client {
+	fetchPolicyMu.RLock()
+	defer fetchPolicyMu.RUnlock()
+	return &http.Client{
+		Timeout:       timeout,
+		Transport:     fetchTransport,
+		CheckRedirect: checkFetchRedirect,
+	}
+}
+
+// newReaderClient returns the http.Client for the fallback reader.
+func newReaderClient(timeout time.Duration) *http.Client {
+	fetchPolicyMu.RLock()
+	defer fetchPolicyMu.RUnlock()
+	return &http.Client{
+		Timeout:       timeout,
+		Transport:     readerTransport,
+		CheckRedirect: checkFetchRedirect,
+	}
+}
+
+func checkFetchRedirect(req *http.Request, via []*http.Request) error {
+	// via holds the original request and every redirect followed so far.
+	if len(via) > maxFetchRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxFetchRedirects)
+	}
+	if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+		return fmt.Errorf("%w: redirect to %s scheme", ErrBlockedAddress, req.URL.Scheme)
+	}
+	return nil
+}
@@ -0,0 +1,411 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestIsPublicAddr(t *testing.T) {
+	tests := []struct {
+		addr   string
+		public bool
+	}{
+		{"127.0.0.1", false},
+		{"127.8.8.8", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"172.31.255.254", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false}, // cloud metadata
+		{"fe80::1", false},
+		{"fc00::1", false},
+		{"fd12:3456::1", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"::", false},
+		{"224.0.0.1", false},
+		{"ff02::1", false},
+		{"255.255.255.255", false},
+		{"::ffff:127.0.0.1", false},
+		{"::ffff:10.0.0.1", false},
+		{"64:ff9b::a00:1", false}, // NAT64 of 10.0.0.1
+		{"8.8.8.8", true},
+		{"172.32.0.1", true},
+		{"2606:4700:4700::1111", true},
+		{"::ffff:8.8.8.8", true},
+	}
+	for _, tt := range tests {
+		if got := isPublicAddr(netip.MustParseAddr(tt.addr)); got != tt.public {
+			t.Errorf("isPublicAddr(%s) = %v, want %v", tt.addr, got, tt.public)
+		}
+	}
+}
+
+// countingServer starts a local listener that counts its requests.
+func countingServer(t *testing.T, h http.HandlerFunc) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		if h != nil {
+			h(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &hits
+}
+
+func guardedClient(d *guardedDialer) *http.Client {
+	return &http.Client{
+		Timeout:       5 * time.Second,
+		Transport:     d.transport(),
+		CheckRedirect: checkFetchRedirect,
+	}
+}
+
+// testDialer returns a guardedDialer that resolves names with hosts and
+// treats the addresses in public as public (as if they were on the
+// internet), in addition to isPublicAddr.
+func testDialer(hosts map[string]string, public ...string) *guardedDialer {
+	return &guardedDialer{
+		dialer: &net.Dialer{Timeout: time.Second},
+		lookup: func(_ context.Context, host string) ([]netip.Addr, error) {
+			addr, ok := hosts[host]
+			if !ok {
+				return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+			}
+			return []netip.Addr{netip.MustParseAddr(addr)}, nil
+		},
+		allowed: func(ip netip.Addr) bool {
+			for _, p := range public {
+				if ip == netip.MustParseAddr(p) {
+					return true
+				}
+			}
+			return isPublicAddr(ip)
+		},
+	}
+}
+
+func get(client *http.Client, target string) error {
+	res, err := client.Get(target)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("status %d", res.StatusCode)
+	}
+	return nil
+}
+
+func TestGuardedClientRefusesInternalTargets(t *testing.T) {
+	srv, hits := countingServer(t, nil)
+	port := srv.Listener.Addr().(*net.TCPAddr).Port
+
+	client := guardedClient(testDialer(map[string]string{
+		"localhost":       "127.0.0.1",
+		"metadata.test":   "169.254.169.254",
+		"intranet.test":   "192.168.0.10",
+		"rebound.test":    "10.0.0.5",
+		"ula.test":        "fd00::1",
+		"link-local.test": "fe80::1",
+	}))
+
+	targets := []string{
+		srv.URL, // 127.0.0.1
+		"http://localhost:" + strconv.Itoa(port) + "/",
+		"http://[::1]:" + strconv.Itoa(port) + "/",
+		"http://10.0.0.1/",
+		"http://172.16.5.4/",
+		"http://192.168.1.1/",
+		"http://169.254.169.254/latest/meta-data/",
+		"http://[fe80::1]/",
+		"http://[fd00::1]/",
+		"http://[::ffff:127.0.0.1]:" + strconv.Itoa(port) + "/",
+		"http://metadata.test/",
+		"http://intranet.test/",
+		"http://rebound.test/",
+		"http://ula.test/",
+		"http://link-local.test/",
+	}
+	for _, target := range targets {
+		t.Run(target, func(t *testing.T) {
+			err := get(client, target)
+			if !errors.Is(err, ErrBlockedAddress) {
+				t.Errorf("err = %v, want ErrBlockedAddress", err)
+			}
+		})
+	}
+	if n := hits.Load(); n != 0 {
+		t.Errorf("internal server got %d request(s)", n)
+	}
+}
+
+func TestGuardedClientRefusesMixedRecords(t *testing.T) {
+	d := testDialer(nil, "127.0.0.1")
+	d.lookup = func(context.Context, string) ([]netip.Addr, error) {
+		return []netip.Addr{netip.MustParseAddr("127.0.0.1"), netip.MustParseAddr("10.0.0.1")}, nil
+	}
+	srv, hits := countingServer(t, nil)
+	port := srv.Listener.Addr().(*net.TCPAddr).Port
+
+	err := get(guardedClient(d), "http://mixed.test:"+strconv.Itoa(port)+"/")
+	if !errors.Is(err, ErrBlockedAddress) {
+		t.Errorf("err = %v, want ErrBlockedAddress", err)
+	}
+	if hits.Load() != 0 {
+		t.Error("connected although one record is internal")
+	}
+}
+
+func TestGuardedClientRefusesRedirectIntoPrivateAddress(t *testing.T) {
+	// internal stands for a service on the private network.
+	internal, internalHits := countingServer(t, nil)
+	internalPort := strconv.Itoa(internal.Listener.Addr().(*net.TCPAddr).Port)
+
+	targets := []string{
+		"http://localhost:" + internalPort + "/admin", // localhost resolves to 127.0.0.2 below
+		"http://10.0.0.1/",
+		"http://169.254.169.254/latest/meta-data/",
+		"http://[fd00::1]/",
+		"ftp://example.com/",
+	}
+	// public stands for a host on the internet that redirects inward.
+	public, _ := countingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, r.URL.Query().Get("to"), http.StatusFound)
+	})
+
+	client := guardedClient(testDialer(map[string]string{"localhost": "127.0.0.2"}, "127.0.0.1"))
+	for _, to := range targets {
+		t.Run(to, func(t *testing.T) {
+			err := get(client, public.URL+"/?to="+url.QueryEscape(to))
+			if !errors.Is(err, ErrBlockedAddress) {
+				t.Errorf("err = %v, want ErrBlockedAddress", err)
+			}
+		})
+	}
+	if n := internalHits.Load(); n != 0 {
+		t.Errorf("internal server got %d request(s)", n)
+	}
+}
+
+func TestGuardedClientRedirectCap(t *testing.T) {
+	// /chain/n redirects n more times before answering.
+	srv, hits := countingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		n, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/chain/"))
+		if n > 0 {
+			http.Redirect(w, r, "/chain/"+strconv.Itoa(n-1), http.StatusFound)
+		}
+	})
+	client := guardedClient(testDialer(nil, "127.0.0.1"))
+
+	if err := get(client, srv.URL+"/chain/"+strconv.Itoa(maxFetchRedirects)); err != nil {
+		t.Errorf("%d redirects: %v", maxFetchRedirects, err)
+	}
+	if n := hits.Load(); n != maxFetchRedirects+1 {
+		t.Errorf("requests = %d, want %d", n, maxFetchRedirects+1)
+	}
+
+	hits.Store(0)
+	err := get(client, srv.URL+"/chain/"+strconv.Itoa(maxFetchRedirects+1))
+	if err == nil || !strings.Contains(err.Error(), "stopped after") {
+		t.Errorf("%d redirects: err = %v, want the cap", maxFetchRedirects+1, err)
+	}
+	if n := hits.Load(); n != maxFetchRedirects+1 {
+		t.Errorf("requests = %d, want %d", n, maxFetchRedirects+1)
+	}
+}
+
+// connectProxy is a minimal CONNECT proxy recording the requested targets.
+type connectProxy struct {
+	mu      sync.Mutex
+	targets []string
+}
+
+func (p *connectProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodConnect {
+		http.Error(w, "CONNECT only", http.StatusMethodNotAllowed)
+		return
+	}
+	p.mu.Lock()
+	p.targets = append(p.targets, r.Host)
+	p.mu.Unlock()
+
+	upstream, err := net.Dial("tcp", r.Host)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	conn, buf, err := w.(http.Hijacker).Hijack()
+	if err != nil {
+		upstream.Close()
+		return
+	}
+	_, _ = io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\n")
+	go func() {
+		_, _ = io.Copy(upstream, buf)
+		upstream.Close()
+	}()
+	_, _ = io.Copy(conn, upstream)
+	conn.Close()
+}
+
+func (p *connectProxy) Targets() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.targets...)
+}
+
+func TestGuardedClientProxyPinsCheckedAddress(t *testing.T) {
+	origin, _ := countingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, r.Host)
+	})
+	originPort := strconv.Itoa(origin.Listener.Addr().(*net.TCPAddr).Port)
+
+	proxy := &connectProxy{}
+	proxySrv := httptest.NewServer(proxy)
+	t.Cleanup(proxySrv.Close)
+	proxyURL, _ := url.Parse(proxySrv.URL)
+
+	d := testDialer(map[string]string{
+		"public.test":   "127.0.0.1",
+		"internal.test": "10.0.0.1",
+	}, "127.0.0.1")
+	d.proxy = proxyURL
+	client := guardedClient(d)
+
+	res, err := client.Get("http://public.test:" + originPort + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(res.Body)
+	res.Body.Close()
+	if string(body) != "public.test:"+originPort {
+		t.Errorf("origin saw Host %q", body)
+	}
+	// The proxy is asked for the checked IP, never the name, so it cannot
+	// resolve the name to a different (internal) address.
+	if got, want := proxy.Targets(), []string{"127.0.0.1:" + originPort}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("CONNECT targets = %q, want %q", got, want)
+	}
+
+	for _, target := range []string{"http://internal.test/", "http://192.168.0.1/"} {
+		if err := get(client, target); !errors.Is(err, ErrBlockedAddress) {
+			t.Errorf("%s: err = %v, want ErrBlockedAddress", target, err)
+		}
+	}
+	if n := len(proxy.Targets()); n != 1 {
+		t.Errorf("proxy got %d CONNECTs, want internal targets refused before it", n)
+	}
+}
+
+func TestGuardedClientProxyResolvesHostnames(t *testing.T) {
+	// A forward proxy answering http requests itself and refusing CONNECT.
+	var (
+		mu      sync.Mutex
+		targets []string
+	)
+	proxySrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		targets = append(targets, r.Method+" "+r.Host)
+		mu.Unlock()
+		if r.Method == http.MethodConnect {
+			http.Error(w, "denied", http.StatusForbidden)
+			return
+		}
+		_, _ = io.WriteString(w, "via proxy")
+	}))
+	t.Cleanup(proxySrv.Close)
+	proxyURL, _ := url.Parse(proxySrv.URL)
+
+	// No names resolve locally: the proxy does that.
+	d := testDialer(nil)
+	client := &http.Client{
+		Timeout:       5 * time.Second,
+		Transport:     d.proxiedTransport(proxyURL),
+		CheckRedirect: checkFetchRedirect,
+	}
+
+	for _, target := range []string{"http://name.test/page", "http://93.184.216.34/"} {
+		if err := get(client, target); err != nil {
+			t.Errorf("%s: %v", target, err)
+		}
+	}
+	if err := get(client, "https://name.test/"); err == nil {
+		t.Error("https through a proxy refusing CONNECT succeeded")
+	}
+	want := []string{"GET name.test", "GET 93.184.216.34", "CONNECT name.test:443"}
+	mu.Lock()
+	got := append([]string(nil), targets...)
+	mu.Unlock()
+	if !slices.Equal(got, want) {
+		t.Errorf("proxy requests = %q, want %q", got, want)
+	}
+
+	// Internal addresses written as IPs are refused before the proxy.
+	for _, target := range []string{"http://10.0.0.1/", "http://[::1]:8080/", "http://2130706433/", "http://0x7f.1/", "https://127.1./"} {
+		if err := get(client, target); !errors.Is(err, ErrBlockedAddress) {
+			t.Errorf("%s: err = %v, want ErrBlockedAddress", target, err)
+		}
+	}
+	mu.Lock()
+	n := len(targets)
+	mu.Unlock()
+	if n != len(want) {
+		t.Errorf("proxy got %d requests, want IP-literal internal targets refused before it", n-len(want))
+	}
+}
+
+func TestIsNumericHost(t *testing.T) {
+	tests := []struct {
+		host string
+		want bool
+	}{
+		{"2130706433", true},
+		{"127.1", true},
+		{"0x7f.1", true},
+		{"example.0x1F", true},
+		{"example.com", false},
+		{"example.com.", false},
+		{"1.example", false},
+		{"0xample.com", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := isNumericHost(tt.host); got != tt.want {
+			t.Errorf("isNumericHost(%q) = %v, want %v", tt.host, got, tt.want)
+		}
+	}
+}
+
+func TestGuardedClientProxyRefusal(t *testing.T) {
+	proxySrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "denied", http.StatusForbidden)
+	}))
+	t.Cleanup(proxySrv.Close)
+	proxyURL, _ := url.Parse(proxySrv.URL)
+
+	d := testDialer(nil, "127.0.0.1")
+	d.proxy = proxyURL
+	err := get(guardedClient(d), "http://127.0.0.1:9/")
+	if err == nil || !strings.Contains(err.Error(), "proxy refused CONNECT") {
+		t.Errorf("err = %v, want the proxy refusal", err)
+	}
+}
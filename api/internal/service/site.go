@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/lvncer/quicklinks/api/internal/model"
+	"github.com/lvncer/quicklinks/api/internal/repository"
+)
+
+// SiteResolver resolves a domain's name and favicon and caches them in the
+// sites table, so each domain is fetched at most once per TTL.
+type SiteResolver struct {
+	sites repository.SiteRepository
+	ttl   time.Duration
+}
+
+func NewSiteResolver(sites repository.SiteRepository, ttl time.Duration) *SiteResolver {
+	return &SiteResolver{sites: sites, ttl: ttl}
+}
+
+// Resolve returns the site for domain, resolving it when it is missing or
+// older than the TTL. page is metadata already fetched from a page on the
+// domain (may be nil); its og:site_name and icon are used before anything
+// else is fetched.
+func (r *SiteResolver) Resolve(ctx context.Context, domain string, page *Metadata) (*model.Site, error) {
+	existing, err := r.sites.GetSite(ctx, domain)
+	if err != nil && err != repository.ErrSiteNotFound {
+		return nil, err
+	}
+	if existing != nil && existing.FetchedAt != nil && time.Since(*existing.FetchedAt) < r.ttl {
+		return existing, nil
+	}
+
+	identity := FetchSiteIdentity(ctx, domain, page)
+	return r.sites.UpsertSite(ctx, repository.SiteInput{
+		Domain:    domain,
+		Name:      identity.SiteName,
+		IconURL:   identity.Icon,
+		FetchedAt: time.Now(),
+	})
+}
+
+// AttachLink resolves the site for domain and links linkID to it.
+func (r *SiteResolver) AttachLink(ctx context.Context, linkID, domain string, page *Metadata) (*model.Site, error) {
+	s, err := r.Resolve(ctx, domain, page)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.sites.AttachLink(ctx, linkID, s.ID); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// FetchSiteIdentity determines a domain's name and icon. Sources, in order:
+// the given page's og:site_name and <link rel=icon>/apple-touch-icon, the
+// same on the domain's home page, and finally /favicon.ico. Only SiteName
+// and Icon are set on the result; fields that cannot be found stay empty.
+func FetchSiteIdentity(ctx context.Context, domain string, page *Metadata) *Metadata {
+	policy := currentFetchPolicy()
+	ctx, cancel := context.WithTimeout(ctx, policy.TotalTimeout)
+	defer cancel()
+
+	client := newFetchClient(policy.RequestTimeout)
+
+	// Icons that are not http(s) URLs (e.g. inline data: icons) cannot be
+	// stored as a link, so they do not stop the lookup.
+	httpIcon := func(icon string) string {
+		if isHTTPURL(icon) {
+			return icon
+		}
+		return ""
+	}
+
+	out := &Metadata{}
+	if page != nil {
+		out.SiteName = page.SiteName
+		out.Icon = httpIcon(page.Icon)
+	}
+
+	home := "https://" + domain + "/"
+	if (out.SiteName == "" || out.Icon == "") && robotsAllowed(ctx, client, home) {
+		meta, status, err := fetchAndParse(ctx, client, home)
+		if err != nil {
+			log.Printf("failed to fetch site home page: %v (domain=%s)", err, domain)
+		} else if status == http.StatusOK && !looksLikeBotChallenge(meta.Title) {
+			setIfEmpty(&out.SiteName, meta.SiteName)
+			setIfEmpty(&out.Icon, httpIcon(meta.Icon))
+		}
+	}
+
+	if out.Icon == "" {
+		favicon := home + "favicon.ico"
+		if robotsAllowed(ctx, client, favicon) && faviconExists(ctx, client, favicon) {
+			out.Icon = favicon
+		}
+	}
+	return out
+}
+
+// faviconExists reports whether target serves an image. Some servers answer
+// unknown paths with an HTML page and status 200, so the content type is
+// checked as well.
+func faviconExists(ctx context.Context, client *http.Client, target string) bool {
+	req, err := http.NewRequestWithContext(ctx, "GET", target, nil)
+	if err != nil {
+		return false
+	}
+	applyBrowserHeaders(req)
+	req.Header.Set("Accept", "image/*")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return false
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 64<<10))
+
+	if res.StatusCode != http.StatusOK {
+		return false
+	}
+	mediaType, _, _ := mime.ParseMediaType(res.Header.Get("Content-Type"))
+	return mediaType == "" || mediaType == "application/octet-stream" || strings.HasPrefix(mediaType, "image/")
+}
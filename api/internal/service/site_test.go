@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lvncer/quicklinks/api/internal/model"
+	"github.com/lvncer/quicklinks/api/internal/repository"
+)
+
+// siteResponses are what siteServer answers for the home page and
+// /favicon.ico.
+type siteResponses struct {
+	home        string // empty: 404
+	homeStatus  int
+	faviconType string // empty: 404
+}
+
+// siteServer serves https://site.test (see useTestSite), recording the paths
+// requested.
+type siteServer struct {
+	siteResponses
+
+	mu    sync.Mutex
+	paths []string
+}
+
+func (s *siteServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.paths = append(s.paths, r.URL.Path)
+	s.mu.Unlock()
+	switch {
+	case r.URL.Path == "/" && s.home != "":
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if s.homeStatus != 0 {
+			w.WriteHeader(s.homeStatus)
+		}
+		_, _ = io.WriteString(w, s.home)
+	case r.URL.Path == "/favicon.ico" && s.faviconType != "":
+		w.Header().Set("Content-Type", s.faviconType)
+		_, _ = w.Write([]byte("\x00\x00\x01\x00"))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *siteServer) Paths() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.paths...)
+}
+
+func TestFetchSiteIdentity(t *testing.T) {
+	const homeWithIcon = `<html><head><title>Home</title>
+<meta property="og:site_name" content="Home Site">
+<link rel="icon" href="/static/icon.png"></head><body></body></html>`
+	const homeWithName = `<html><head><title>Home</title>
+<meta property="og:site_name" content="Home Site"></head><body></body></html>`
+	const challenge = `<html><head><title>Just a moment...</title>
+<meta property="og:site_name" content="Challenge"><link rel="icon" href="/cf.png"></head></html>`
+
+	tests := []struct {
+		name      string
+		page      *Metadata
+		server    siteResponses
+		wantName  string
+		wantIcon  string
+		wantPaths []string
+	}{
+		{
+			name:      "page has both",
+			page:      &Metadata{SiteName: "Page Site", Icon: "https://site.test/page.png"},
+			server:    siteResponses{home: homeWithIcon, faviconType: "image/x-icon"},
+			wantName:  "Page Site",
+			wantIcon:  "https://site.test/page.png",
+			wantPaths: nil,
+		},
+		{
+			// The home page fills in only what the page lacks.
+			name:      "home page icon",
+			page:      &Metadata{SiteName: "Page Site"},
+			server:    siteResponses{home: homeWithIcon},
+			wantName:  "Page Site",
+			wantIcon:  "https://site.test/static/icon.png",
+			wantPaths: []string{"/"},
+		},
+		{
+			name:      "favicon.ico",
+			server:    siteResponses{home: homeWithName, faviconType: "image/vnd.microsoft.icon"},
+			wantName:  "Home Site",
+			wantIcon:  "https://site.test/favicon.ico",
+			wantPaths: []string{"/", "/favicon.ico"},
+		},
+		{
+			// An inline icon cannot be stored, so the lookup goes on.
+			name:      "data icon on the page",
+			page:      &Metadata{SiteName: "Page Site", Icon: "data:image/png;base64,AAAA"},
+			server:    siteResponses{faviconType: "image/png"},
+			wantName:  "Page Site",
+			wantIcon:  "https://site.test/favicon.ico",
+			wantPaths: []string{"/", "/favicon.ico"},
+		},
+		{
+			name:      "bot challenge on the home page",
+			server:    siteResponses{home: challenge, homeStatus: http.StatusServiceUnavailable, faviconType: "image/x-icon"},
+			wantIcon:  "https://site.test/favicon.ico",
+			wantPaths: []string{"/", "/favicon.ico"},
+		},
+		{
+			name:      "challenge with status 200",
+			server:    siteResponses{home: challenge},
+			wantPaths: []string{"/", "/favicon.ico"},
+		},
+		{
+			// Soft 404s answer every path with an HTML page.
+			name:      "favicon.ico is an HTML page",
+			server:    siteResponses{home: homeWithName, faviconType: "text/html"},
+			wantName:  "Home Site",
+			wantPaths: []string{"/", "/favicon.ico"},
+		},
+		{
+			name:      "nothing found",
+			wantPaths: []string{"/", "/favicon.ico"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := &siteServer{siteResponses: tt.server}
+			plain := httptest.NewServer(http.NotFoundHandler())
+			t.Cleanup(plain.Close)
+			secure := httptest.NewTLSServer(srv)
+			t.Cleanup(secure.Close)
+			useTestSite(t, plain, secure)
+
+			got := FetchSiteIdentity(context.Background(), "site.test", tt.page)
+			if got.SiteName != tt.wantName || got.Icon != tt.wantIcon {
+				t.Errorf("got name %q, icon %q, want %q, %q", got.SiteName, got.Icon, tt.wantName, tt.wantIcon)
+			}
+			paths := srv.Paths()
+			if len(paths) != len(tt.wantPaths) {
+				t.Fatalf("requested %q, want %q", paths, tt.wantPaths)
+			}
+			for i := range paths {
+				if paths[i] != tt.wantPaths[i] {
+					t.Errorf("requested %q, want %q", paths, tt.wantPaths)
+					break
+				}
+			}
+		})
+	}
+}
+
+// memorySites is an in-memory repository.SiteRepository.
+type memorySites struct {
+	repository.SiteRepository
+	sites map[string]*model.Site
+}
+
+func (m *memorySites) GetSite(_ context.Context, domain string) (*model.Site, error) {
+	s, ok := m.sites[domain]
+	if !ok {
+		return nil, repository.ErrSiteNotFound
+	}
+	return s, nil
+}
+
+func (m *memorySites) UpsertSite(_ context.Context, input repository.SiteInput) (*model.Site, error) {
+	s := m.sites[input.Domain]
+	if s == nil {
+		s = &model.Site{ID: "site-" + input.Domain, Domain: input.Domain}
+		m.sites[input.Domain] = s
+	}
+	setIfEmpty(&input.Name, s.Name)
+	setIfEmpty(&input.IconURL, s.IconURL)
+	s.Name, s.IconURL = input.Name, input.IconURL
+	s.FetchedAt = &input.FetchedAt
+	return s, nil
+}
+
+func TestSiteResolverTTL(t *testing.T) {
+	srv := &siteServer{siteResponses: siteResponses{faviconType: "image/x-icon"}}
+	plain := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(plain.Close)
+	secure := httptest.NewTLSServer(srv)
+	t.Cleanup(secure.Close)
+	useTestSite(t, plain, secure)
+
+	fresh := time.Now().Add(-time.Hour)
+	stale := time.Now().Add(-48 * time.Hour)
+	sites := &memorySites{sites: map[string]*model.Site{
+		"site.test": {ID: "site-1", Domain: "site.test", Name: "Cached", FetchedAt: &fresh},
+	}}
+	r := NewSiteResolver(sites, 24*time.Hour)
+	ctx := context.Background()
+
+	got, err := r.Resolve(ctx, "site.test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "Cached" || got.IconURL != "" || len(srv.Paths()) != 0 {
+		t.Errorf("fresh site: got %+v after %q, want the cached site without fetching", got, srv.Paths())
+	}
+
+	// A stale site is refreshed; the name it had is kept when none is found.
+	sites.sites["site.test"].FetchedAt = &stale
+	got, err = r.Resolve(ctx, "site.test", &Metadata{SiteName: "Page Site"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "Page Site" || got.IconURL != "https://site.test/favicon.ico" || got.FetchedAt == nil || !got.FetchedAt.After(stale) {
+		t.Errorf("stale site: got %+v", got)
+	}
+}
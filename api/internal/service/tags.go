@@ -0,0 +1,50 @@
+package service
+
+import (
+	"strings"
+)
+
+// TagNormalizer canonicalizes user-supplied tags so that "Go", " go" and "#go"
+// are stored as the same tag.
+type TagNormalizer struct {
+	// MaxLength caps each tag in runes (0 = unlimited).
+	MaxLength int
+	// MaxCount caps the number of tags per link (0 = unlimited).
+	MaxCount int
+}
+
+// Normalize trims, strips a leading '#', case-folds, truncates and
+// de-duplicates tags, keeping the first occurrence order. Empty tags are
+// dropped. It never returns nil.
+func (n TagNormalizer) Normalize(tags []string) []string {
+	out := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	for _, t := range tags {
+		t = n.NormalizeOne(t)
+		if t == "" {
+			continue
+		}
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+		out = append(out, t)
+		if n.MaxCount > 0 && len(out) >= n.MaxCount {
+			break
+		}
+	}
+	return out
+}
+
+// NormalizeOne normalizes a single tag. It returns "" if nothing is left.
+func (n TagNormalizer) NormalizeOne(tag string) string {
+	t := strings.TrimSpace(tag)
+	t = strings.TrimPrefix(t, "#")
+	t = strings.ToLower(strings.TrimSpace(t))
+	if n.MaxLength > 0 {
+		if r := []rune(t); len(r) > n.MaxLength {
+			t = strings.TrimSpace(string(r[:n.MaxLength]))
+		}
+	}
+	return t
+}
@@ -0,0 +1,20 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrBlobNotFound is returned when no blob exists for a key.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore stores opaque binary objects by key. Keys are slash-separated
+// paths such as "images/<hash>/m.jpg".
+type BlobStore interface {
+	// Get opens the blob for key. The caller must close the reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Put stores r under key, replacing any existing blob atomically.
+	Put(ctx context.Context, key string, r io.Reader) error
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,51 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/lvncer/quicklinks/api/internal/repository"
+)
+
+// JobSweeper periodically deletes completed jobs older than the retention
+// period, so the jobs table does not grow with every save and refetch.
+type JobSweeper struct {
+	jobs      repository.JobRepository
+	retention time.Duration
+	interval  time.Duration
+}
+
+func NewJobSweeper(jobs repository.JobRepository, retention, interval time.Duration) *JobSweeper {
+	return &JobSweeper{jobs: jobs, retention: retention, interval: interval}
+}
+
+// Run sweeps once immediately and then on every interval until ctx is done.
+func (s *JobSweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.sweep(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *JobSweeper) sweep(ctx context.Context) {
+	sweepCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	n, err := s.jobs.PurgeDoneBefore(sweepCtx, time.Now().Add(-s.retention))
+	if err != nil {
+		log.Printf("job sweeper: %v", err)
+		return
+	}
+	if n > 0 {
+		log.Printf("job sweeper: purged %d done job(s)", n)
+	}
+}
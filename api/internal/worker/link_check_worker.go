@@ -0,0 +1,100 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/lvncer/quicklinks/api/internal/repository"
+	"github.com/lvncer/quicklinks/api/internal/service"
+)
+
+const (
+	// linkCheckPollInterval is how often the worker looks for links due for
+	// a check.
+	linkCheckPollInterval = 10 * time.Minute
+	linkCheckBatchSize    = 200
+	// linkCheckConcurrency bounds checks in flight; the fetch policy's
+	// per-host limits still apply.
+	linkCheckConcurrency = 4
+)
+
+// LinkCheckWorker periodically checks saved links whose last check is older
+// than the interval (never-checked links first) and records their health.
+type LinkCheckWorker struct {
+	checks   repository.LinkCheckRepository
+	checker  *service.LinkChecker
+	interval time.Duration
+}
+
+func NewLinkCheckWorker(checks repository.LinkCheckRepository, checker *service.LinkChecker, interval time.Duration) *LinkCheckWorker {
+	return &LinkCheckWorker{checks: checks, checker: checker, interval: interval}
+}
+
+// Run checks once immediately and then on every poll until ctx is done.
+func (w *LinkCheckWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(linkCheckPollInterval)
+	defer ticker.Stop()
+
+	for {
+		w.checkDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *LinkCheckWorker) checkDue(ctx context.Context) {
+	listCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	ids, err := w.checks.ListLinkCheckCandidates(listCtx, time.Now().Add(-w.interval), linkCheckBatchSize)
+	cancel()
+	if err != nil {
+		log.Printf("link checker: %v", err)
+		return
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	queue := make(chan string)
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		broken int
+	)
+	for i := 0; i < linkCheckConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range queue {
+				health, err := w.checker.Check(ctx, id)
+				if err != nil {
+					if !errors.Is(err, repository.ErrLinkNotFound) && ctx.Err() == nil {
+						log.Printf("link checker: check %s: %v", id, err)
+					}
+					continue
+				}
+				if health == repository.HealthBroken {
+					mu.Lock()
+					broken++
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			break
+		}
+		queue <- id
+	}
+	close(queue)
+	wg.Wait()
+
+	log.Printf("link checker: checked %d link(s), %d broken", len(ids), broken)
+}
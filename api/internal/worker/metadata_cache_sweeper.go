@@ -0,0 +1,50 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/lvncer/quicklinks/api/internal/repository"
+)
+
+// MetadataCacheSweeper periodically deletes expired metadata cache entries.
+// Expired entries are never served, so sweeping only reclaims space.
+type MetadataCacheSweeper struct {
+	repo     repository.MetadataCacheRepository
+	interval time.Duration
+}
+
+func NewMetadataCacheSweeper(repo repository.MetadataCacheRepository, interval time.Duration) *MetadataCacheSweeper {
+	return &MetadataCacheSweeper{repo: repo, interval: interval}
+}
+
+// Run sweeps once immediately and then on every interval until ctx is done.
+func (s *MetadataCacheSweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.sweep(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *MetadataCacheSweeper) sweep(ctx context.Context) {
+	sweepCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	n, err := s.repo.PurgeExpiredMetadata(sweepCtx, time.Now())
+	if err != nil {
+		log.Printf("metadata cache sweeper: %v", err)
+		return
+	}
+	if n > 0 {
+		log.Printf("metadata cache sweeper: purged %d expired entries", n)
+	}
+}
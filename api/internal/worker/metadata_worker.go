@@ -0,0 +1,166 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/lvncer/quicklinks/api/internal/repository"
+	"github.com/lvncer/quicklinks/api/internal/service"
+)
+
+const (
+	// jobStaleAfter is how long a running job may go without finishing
+	// before another worker reclaims it. It must exceed the fetch timeout.
+	jobStaleAfter = 5 * time.Minute
+	// retryBaseDelay is doubled for every failed attempt, up to retryMaxDelay.
+	retryBaseDelay = 30 * time.Second
+	retryMaxDelay  = time.Hour
+)
+
+// MetadataWorker runs fetch_metadata and refresh_metadata jobs: it scrapes the
+// link's URL and stores title, description and og_image. It also runs
+// archive_snapshot jobs, from the page a metadata job just downloaded when
+// the link has one queued.
+type MetadataWorker struct {
+	jobs         repository.JobRepository
+	refresher    *service.MetadataRefresher
+	archiver     *service.Archiver
+	concurrency  int
+	maxAttempts  int
+	pollInterval time.Duration
+}
+
+func NewMetadataWorker(jobs repository.JobRepository, refresher *service.MetadataRefresher, archiver *service.Archiver, concurrency, maxAttempts int, pollInterval time.Duration) *MetadataWorker {
+	return &MetadataWorker{
+		jobs:         jobs,
+		refresher:    refresher,
+		archiver:     archiver,
+		concurrency:  concurrency,
+		maxAttempts:  maxAttempts,
+		pollInterval: pollInterval,
+	}
+}
+
+// Run starts the worker pool and blocks until ctx is done and all in-flight
+// jobs have returned.
+func (w *MetadataWorker) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < w.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.loop(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
+func (w *MetadataWorker) loop(ctx context.Context) {
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		job, err := w.jobs.Claim(ctx, jobStaleAfter)
+		if err != nil {
+			log.Printf("metadata worker: claim: %v", err)
+		}
+		if job == nil {
+			// Nothing to do (or the claim failed); wait before polling again.
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(w.pollInterval):
+			}
+			continue
+		}
+
+		w.process(ctx, job)
+	}
+}
+
+func (w *MetadataWorker) process(ctx context.Context, job *repository.Job) {
+	// Let a claimed job finish and record its outcome even during shutdown;
+	// otherwise it stays "running" until it is reclaimed as stale.
+	ctx = context.WithoutCancel(ctx)
+	w.finish(ctx, job, w.handle(ctx, job))
+}
+
+// finish records the outcome of a job, scheduling a retry on failure.
+func (w *MetadataWorker) finish(ctx context.Context, job *repository.Job, err error) {
+	if err == nil {
+		if err := w.jobs.Complete(ctx, job.ID); err != nil {
+			log.Printf("metadata worker: complete job %s: %v", job.ID, err)
+		}
+		return
+	}
+
+	var retryAt *time.Time
+	// A URL that points at a blocked address will not change on retry, nor
+	// will a page that cannot be archived.
+	if job.Attempts < w.maxAttempts && !errors.Is(err, service.ErrBlockedAddress) && !errors.Is(err, service.ErrNotArchivable) {
+		t := time.Now().Add(retryDelay(job.Attempts))
+		retryAt = &t
+	}
+	log.Printf("metadata worker: job %s (link %s) attempt %d failed: %v", job.ID, job.LinkID, job.Attempts, err)
+	if err := w.jobs.Fail(ctx, job.ID, err, retryAt); err != nil {
+		log.Printf("metadata worker: record failure of job %s: %v", job.ID, err)
+	}
+}
+
+func (w *MetadataWorker) handle(ctx context.Context, job *repository.Job) error {
+	var overwrite bool
+	switch job.Kind {
+	case repository.JobKindFetchMetadata:
+	case repository.JobKindRefreshMetadata:
+		overwrite = true
+	case repository.JobKindArchiveSnapshot:
+		err := w.archiver.Archive(ctx, job.LinkID)
+		if errors.Is(err, repository.ErrLinkNotFound) {
+			return nil
+		}
+		return err
+	default:
+		return fmt.Errorf("unknown job kind %q", job.Kind)
+	}
+
+	_, page, err := w.refresher.RefreshPage(ctx, job.LinkID, overwrite)
+	if errors.Is(err, repository.ErrLinkNotFound) {
+		// Purged before we got to it; nothing left to do.
+		return nil
+	}
+	if err == nil && page != nil {
+		w.archivePage(ctx, job.LinkID, page)
+	}
+	return err
+}
+
+// archivePage runs the link's queued archive_snapshot job, if any, on the
+// page the metadata job downloaded.
+func (w *MetadataWorker) archivePage(ctx context.Context, linkID string, page *service.FetchedPage) {
+	job, err := w.jobs.ClaimForLink(ctx, repository.JobKindArchiveSnapshot, linkID)
+	if err != nil {
+		log.Printf("metadata worker: claim snapshot job of link %s: %v", linkID, err)
+		return
+	}
+	if job == nil {
+		return
+	}
+	w.finish(ctx, job, w.archiver.ArchivePage(ctx, linkID, page))
+}
+
+// retryDelay returns the backoff before retrying after the given attempt.
+func retryDelay(attempt int) time.Duration {
+	d := retryBaseDelay
+	for i := 1; i < attempt && d < retryMaxDelay; i++ {
+		d *= 2
+	}
+	if d > retryMaxDelay {
+		d = retryMaxDelay
+	}
+	return d
+}
@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/lvncer/quicklinks/api/internal/repository"
+)
+
+const (
+	// refetchBaseDelay is the wait after the first failed fetch; it doubles
+	// with every further consecutive failure.
+	refetchBaseDelay = time.Hour
+	// refetchMaxFailures stops re-scraping a link after this many
+	// consecutive blocked/empty/failed fetches (about 5 days of backoff).
+	refetchMaxFailures = 8
+	refetchBatchSize   = 100
+)
+
+// RefetchScheduler periodically queues fetch_metadata jobs for links whose
+// last fetch was blocked, empty or failed, with exponential backoff.
+type RefetchScheduler struct {
+	links    repository.LinkRepository
+	jobs     repository.JobRepository
+	interval time.Duration
+}
+
+func NewRefetchScheduler(links repository.LinkRepository, jobs repository.JobRepository, interval time.Duration) *RefetchScheduler {
+	return &RefetchScheduler{links: links, jobs: jobs, interval: interval}
+}
+
+// Run schedules once immediately and then on every interval until ctx is done.
+func (s *RefetchScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.schedule(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *RefetchScheduler) schedule(ctx context.Context) {
+	scheduleCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	ids, err := s.links.ListRefetchCandidates(scheduleCtx, refetchBaseDelay, refetchMaxFailures, refetchBatchSize)
+	if err != nil {
+		log.Printf("refetch scheduler: %v", err)
+		return
+	}
+	for _, id := range ids {
+		if err := s.jobs.Enqueue(scheduleCtx, repository.JobKindFetchMetadata, id); err != nil {
+			log.Printf("refetch scheduler: enqueue %s: %v", id, err)
+			return
+		}
+	}
+	if len(ids) > 0 {
+		log.Printf("refetch scheduler: queued %d link(s)", len(ids))
+	}
+}
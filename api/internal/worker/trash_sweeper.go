@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/lvncer/quicklinks/api/internal/repository"
+	"github.com/lvncer/quicklinks/api/internal/service"
+)
+
+// TrashSweeper periodically hard-deletes links that have been in the trash
+// longer than the retention period, along with their snapshots and image
+// thumbnails.
+type TrashSweeper struct {
+	repo      repository.LinkRepository
+	archiver  *service.Archiver
+	images    *service.ImageProxy
+	retention time.Duration
+	interval  time.Duration
+}
+
+func NewTrashSweeper(repo repository.LinkRepository, archiver *service.Archiver, images *service.ImageProxy, retention, interval time.Duration) *TrashSweeper {
+	return &TrashSweeper{repo: repo, archiver: archiver, images: images, retention: retention, interval: interval}
+}
+
+// Run sweeps once immediately and then on every interval until ctx is done.
+func (s *TrashSweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.sweep(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *TrashSweeper) sweep(ctx context.Context) {
+	sweepCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	purged, err := s.repo.PurgeDeletedBefore(sweepCtx, time.Now().Add(-s.retention))
+	if err != nil {
+		log.Printf("trash sweeper: %v", err)
+		return
+	}
+	if len(purged) > 0 {
+		log.Printf("trash sweeper: purged %d link(s)", len(purged))
+	}
+	for _, l := range purged {
+		if s.archiver != nil {
+			if err := s.archiver.Delete(sweepCtx, l.ID); err != nil {
+				log.Printf("trash sweeper: delete snapshot of %s: %v", l.ID, err)
+			}
+		}
+		if s.images != nil && l.OGImage != "" {
+			if err := s.images.Delete(sweepCtx, l.OGImage); err != nil {
+				log.Printf("trash sweeper: delete thumbnails of %s: %v", l.ID, err)
+			}
+		}
+	}
+}